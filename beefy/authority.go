@@ -0,0 +1,114 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package beefy implements the verification of BEEFY signed commitments against the ECDSA authorities of a validator
+// set, and a verifier that follows authority set changes using the MMR leaves committed to by BEEFY.
+package beefy
+
+import (
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Authority is the compressed secp256k1 public key of a BEEFY authority
+type Authority [33]byte
+
+// NewAuthority creates an Authority from a compressed public key
+func NewAuthority(b []byte) (Authority, error) {
+	var a Authority
+	if len(b) != len(a) {
+		return Authority{}, ErrInvalidAuthority.WithMsg("expected %d bytes, got %d", len(a), len(b))
+	}
+	copy(a[:], b)
+	return a, nil
+}
+
+// Address returns the Ethereum address of the authority, which is what the authority set root commits to
+func (a Authority) Address() ([20]byte, error) {
+	pub, err := crypto.DecompressPubkey(a[:])
+	if err != nil {
+		return [20]byte{}, ErrInvalidAuthority.Wrap(err)
+	}
+	return crypto.PubkeyToAddress(*pub), nil
+}
+
+// AuthoritySet is a BEEFY validator set with the public keys of its authorities
+type AuthoritySet struct {
+	ID          uint64
+	Authorities []Authority
+}
+
+// NewAuthoritySet creates an AuthoritySet for the set announced in a MMR leaf, checking that the given authorities
+// match the length and the root of that set
+func NewAuthoritySet(set types.BeefyNextAuthoritySet, authorities []Authority) (AuthoritySet, error) {
+	s := AuthoritySet{ID: uint64(set.ID), Authorities: authorities}
+
+	if len(authorities) != int(set.Len) {
+		return AuthoritySet{}, ErrAuthoritySetMismatch.WithMsg("expected %d authorities, got %d", set.Len,
+			len(authorities))
+	}
+
+	root, err := s.Root()
+	if err != nil {
+		return AuthoritySet{}, err
+	}
+
+	if root != set.Root {
+		return AuthoritySet{}, ErrAuthoritySetMismatch.WithMsg("expected root %s, got %s", set.Root.Hex(), root.Hex())
+	}
+
+	return s, nil
+}
+
+// Root returns the root of the binary merkle tree of the authority addresses, as stored in BeefyNextAuthoritySet
+func (s AuthoritySet) Root() (types.H256, error) {
+	leaves := make([][]byte, len(s.Authorities))
+	for i, a := range s.Authorities {
+		addr, err := a.Address()
+		if err != nil {
+			return types.H256{}, err
+		}
+		leaves[i] = addr[:]
+	}
+	return MerkleRoot(leaves), nil
+}
+
+// MerkleRoot returns the root of a binary merkle tree with keccak256 hashed leaves. Nodes without a sibling are
+// promoted to the next layer, the root of an empty tree is the zero hash.
+func MerkleRoot(leaves [][]byte) types.H256 {
+	if len(leaves) == 0 {
+		return types.H256{}
+	}
+
+	layer := make([]types.H256, len(leaves))
+	for i, l := range leaves {
+		layer[i] = types.NewH256(crypto.Keccak256(l))
+	}
+
+	for len(layer) > 1 {
+		next := make([]types.H256, 0, (len(layer)+1)/2)
+		for i := 0; i < len(layer); i += 2 {
+			if i+1 == len(layer) {
+				next = append(next, layer[i])
+				continue
+			}
+			next = append(next, types.NewH256(crypto.Keccak256(layer[i][:], layer[i+1][:])))
+		}
+		layer = next
+	}
+
+	return layer[0]
+}
@@ -0,0 +1,92 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package beefy
+
+import (
+	"crypto/ecdsa"
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+// testKeys returns n deterministic secp256k1 keys
+func testKeys(t *testing.T, n int) ([]*ecdsa.PrivateKey, []Authority) {
+	keys := make([]*ecdsa.PrivateKey, n)
+	authorities := make([]Authority, n)
+	for i := range keys {
+		seed := make([]byte, 32)
+		seed[31] = byte(i + 1)
+		key, err := crypto.ToECDSA(seed)
+		assert.NoError(t, err)
+		keys[i] = key
+		copy(authorities[i][:], crypto.CompressPubkey(&key.PublicKey))
+	}
+	return keys, authorities
+}
+
+func TestAuthority_Address(t *testing.T) {
+	// BEEFY keys of //Alice and //Bob
+	alice, err := NewAuthority(codec.MustHexDecodeString(
+		"0x020a1091341fe5664bfa1782d5e04779689068c916b04cb365ec3153755684d9a1"))
+	assert.NoError(t, err)
+	addr, err := alice.Address()
+	assert.NoError(t, err)
+	assert.Equal(t, "0xe04cc55ebee1cbce552f250e85c57b70b2e2625b", codec.HexEncodeToString(addr[:]))
+
+	bob, err := NewAuthority(codec.MustHexDecodeString(
+		"0x0390084fdbf27d2b79d26a4f13f0ccd982cb755a661969143c37cbc49ef5b91f27"))
+	assert.NoError(t, err)
+	addr, err = bob.Address()
+	assert.NoError(t, err)
+	assert.Equal(t, "0x25451a4de12dccc2d166922fa938e900fcc4ed24", codec.HexEncodeToString(addr[:]))
+
+	_, err = NewAuthority([]byte{0x02, 0x01})
+	assert.ErrorIs(t, err, ErrInvalidAuthority)
+
+	_, err = Authority{0x05}.Address()
+	assert.ErrorIs(t, err, ErrInvalidAuthority)
+}
+
+func TestMerkleRoot(t *testing.T) {
+	a, b, c := []byte{0x01}, []byte{0x02}, []byte{0x03}
+	ha, hb, hc := crypto.Keccak256(a), crypto.Keccak256(b), crypto.Keccak256(c)
+
+	assert.Equal(t, types.H256{}, MerkleRoot(nil))
+	assert.Equal(t, types.NewH256(ha), MerkleRoot([][]byte{a}))
+	assert.Equal(t, types.NewH256(crypto.Keccak256(ha, hb)), MerkleRoot([][]byte{a, b}))
+	// the third leaf has no sibling and is promoted
+	assert.Equal(t, types.NewH256(crypto.Keccak256(crypto.Keccak256(ha, hb), hc)), MerkleRoot([][]byte{a, b, c}))
+}
+
+func TestNewAuthoritySet(t *testing.T) {
+	_, authorities := testKeys(t, 3)
+	root, err := AuthoritySet{Authorities: authorities}.Root()
+	assert.NoError(t, err)
+
+	set, err := NewAuthoritySet(types.BeefyNextAuthoritySet{ID: 4, Len: 3, Root: root}, authorities)
+	assert.NoError(t, err)
+	assert.Equal(t, AuthoritySet{ID: 4, Authorities: authorities}, set)
+
+	_, err = NewAuthoritySet(types.BeefyNextAuthoritySet{ID: 4, Len: 4, Root: root}, authorities)
+	assert.ErrorIs(t, err, ErrAuthoritySetMismatch)
+
+	_, err = NewAuthoritySet(types.BeefyNextAuthoritySet{ID: 4, Len: 3, Root: types.H256{1}}, authorities)
+	assert.ErrorIs(t, err, ErrAuthoritySetMismatch)
+}
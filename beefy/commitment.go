@@ -0,0 +1,108 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package beefy
+
+import (
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Verification is the result of the verification of a signed commitment
+type Verification struct {
+	// Signers are the indices of the authorities with a valid signature
+	Signers []int
+	// Invalid are the indices of the authorities with a signature that does not recover to their public key
+	Invalid []int
+}
+
+// Threshold returns the number of signatures that are required for a commitment of a set with the given number of
+// authorities, which is more than 2/3
+func Threshold(authorities int) int {
+	if authorities == 0 {
+		return 0
+	}
+	return authorities - (authorities-1)/3
+}
+
+// CommitmentHash returns the keccak256 hash of the SCALE encoded commitment, which is what the authorities sign
+func CommitmentHash(c types.Commitment) (types.H256, error) {
+	enc, err := codec.Encode(c)
+	if err != nil {
+		return types.H256{}, err
+	}
+	return types.NewH256(crypto.Keccak256(enc)), nil
+}
+
+// VerifySignedCommitment verifies the signatures of sc against the authorities of set. Signatures that don't recover
+// to the public key of the authority at their index are reported as invalid and not counted, an error is returned if
+// less than the threshold of the set signed the commitment.
+func VerifySignedCommitment(sc types.SignedCommitment, set AuthoritySet) (Verification, error) {
+	if sc.Commitment.ValidatorSetID != set.ID {
+		return Verification{}, ErrValidatorSetIDMismatch.WithMsg("expected %d, got %d", set.ID,
+			sc.Commitment.ValidatorSetID)
+	}
+
+	if len(sc.Signatures) != len(set.Authorities) {
+		return Verification{}, ErrSignatureCountMismatch.WithMsg("expected %d, got %d", len(set.Authorities),
+			len(sc.Signatures))
+	}
+
+	hash, err := CommitmentHash(sc.Commitment)
+	if err != nil {
+		return Verification{}, err
+	}
+
+	var res Verification
+	for i, s := range sc.Signatures {
+		ok, sig := s.Unwrap()
+		if !ok {
+			continue
+		}
+
+		signer, err := recoverSigner(hash, sig)
+		if err != nil || signer != set.Authorities[i] {
+			res.Invalid = append(res.Invalid, i)
+			continue
+		}
+
+		res.Signers = append(res.Signers, i)
+	}
+
+	if threshold := Threshold(len(set.Authorities)); len(res.Signers) < threshold {
+		return res, ErrNotEnoughSignatures.WithMsg("expected %d, got %d", threshold, len(res.Signers))
+	}
+
+	return res, nil
+}
+
+// recoverSigner returns the compressed public key that created sig. The recovery ID is accepted both as 0/1 and in
+// the Ethereum 27/28 form.
+func recoverSigner(hash types.H256, sig types.BeefySignature) (Authority, error) {
+	if sig[64] >= 27 {
+		sig[64] -= 27
+	}
+
+	pub, err := crypto.SigToPub(hash[:], sig[:])
+	if err != nil {
+		return Authority{}, err
+	}
+
+	var a Authority
+	copy(a[:], crypto.CompressPubkey(pub))
+	return a, nil
+}
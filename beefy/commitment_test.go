@@ -0,0 +1,120 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package beefy
+
+import (
+	"crypto/ecdsa"
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+// sign returns c signed by the keys at the given indices
+func sign(t *testing.T, c types.Commitment, keys []*ecdsa.PrivateKey, signers ...int) types.SignedCommitment {
+	hash, err := CommitmentHash(c)
+	assert.NoError(t, err)
+
+	sc := types.SignedCommitment{Commitment: c, Signatures: make([]types.OptionBeefySignature, len(keys))}
+	for _, i := range signers {
+		sig, err := crypto.Sign(hash[:], keys[i])
+		assert.NoError(t, err)
+		var s types.BeefySignature
+		copy(s[:], sig)
+		sc.Signatures[i].SetSome(s)
+	}
+	return sc
+}
+
+func TestThreshold(t *testing.T) {
+	assert.Equal(t, 0, Threshold(0))
+	assert.Equal(t, 1, Threshold(1))
+	assert.Equal(t, 2, Threshold(2))
+	assert.Equal(t, 3, Threshold(3))
+	assert.Equal(t, 3, Threshold(4))
+	assert.Equal(t, 67, Threshold(100))
+}
+
+func TestVerifySignedCommitment(t *testing.T) {
+	keys, authorities := testKeys(t, 4)
+	set := AuthoritySet{ID: 2, Authorities: authorities}
+	c := types.Commitment{
+		Payload:        []types.PayloadItem{{ID: MMRRootID, Data: make([]byte, 32)}},
+		BlockNumber:    100,
+		ValidatorSetID: 2,
+	}
+
+	res, err := VerifySignedCommitment(sign(t, c, keys, 0, 1, 3), set)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 3}, res.Signers)
+	assert.Empty(t, res.Invalid)
+
+	// the signatures survive a SCALE round trip, which packs them by a bitfield
+	var decoded types.SignedCommitment
+	enc, err := codec.Encode(sign(t, c, keys, 0, 2, 3))
+	assert.NoError(t, err)
+	assert.NoError(t, codec.Decode(enc, &decoded))
+	res, err = VerifySignedCommitment(decoded, set)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 2, 3}, res.Signers)
+
+	// recovery IDs in the Ethereum form
+	sc := sign(t, c, keys, 0, 1, 2)
+	for i := range sc.Signatures[:3] {
+		_, sig := sc.Signatures[i].Unwrap()
+		sig[64] += 27
+		sc.Signatures[i].SetSome(sig)
+	}
+	res, err = VerifySignedCommitment(sc, set)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2}, res.Signers)
+}
+
+func TestVerifySignedCommitment_Invalid(t *testing.T) {
+	keys, authorities := testKeys(t, 4)
+	set := AuthoritySet{ID: 2, Authorities: authorities}
+	c := types.Commitment{BlockNumber: 100, ValidatorSetID: 2}
+
+	res, err := VerifySignedCommitment(sign(t, c, keys, 0, 1), set)
+	assert.ErrorIs(t, err, ErrNotEnoughSignatures)
+	assert.Equal(t, []int{0, 1}, res.Signers)
+
+	// signatures shifted into the wrong slots
+	sc := sign(t, c, keys, 0, 1, 2)
+	sc.Signatures = append([]types.OptionBeefySignature{sc.Signatures[0], {}}, sc.Signatures[1:3]...)
+	res, err = VerifySignedCommitment(sc, set)
+	assert.ErrorIs(t, err, ErrNotEnoughSignatures)
+	assert.Equal(t, []int{0}, res.Signers)
+	assert.Equal(t, []int{2, 3}, res.Invalid)
+
+	// signed a different commitment
+	sc = sign(t, c, keys, 0, 1, 2, 3)
+	sc.Commitment.BlockNumber++
+	res, err = VerifySignedCommitment(sc, set)
+	assert.ErrorIs(t, err, ErrNotEnoughSignatures)
+	assert.Empty(t, res.Signers)
+	assert.Equal(t, []int{0, 1, 2, 3}, res.Invalid)
+
+	_, err = VerifySignedCommitment(sign(t, c, keys[:3], 0, 1, 2), set)
+	assert.ErrorIs(t, err, ErrSignatureCountMismatch)
+
+	c.ValidatorSetID = 3
+	_, err = VerifySignedCommitment(sign(t, c, keys, 0, 1, 2), set)
+	assert.ErrorIs(t, err, ErrValidatorSetIDMismatch)
+}
@@ -0,0 +1,14 @@
+package beefy
+
+import libErr "github.com/centrifuge/go-substrate-rpc-client/v4/error"
+
+const (
+	ErrInvalidAuthority       = libErr.Error("invalid authority public key")
+	ErrAuthoritySetMismatch   = libErr.Error("authorities do not match the authority set")
+	ErrValidatorSetIDMismatch = libErr.Error("validator set ID mismatch")
+	ErrSignatureCountMismatch = libErr.Error("number of signatures does not match the number of authorities")
+	ErrNotEnoughSignatures    = libErr.Error("not enough valid signatures")
+	ErrUnknownValidatorSet    = libErr.Error("commitment is not signed by the current or next validator set")
+	ErrMMRRootNotFound        = libErr.Error("MMR root not found in commitment payload")
+	ErrInvalidNextAuthorities = libErr.Error("invalid next authority set in MMR leaf")
+)
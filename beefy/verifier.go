@@ -0,0 +1,121 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package beefy
+
+import (
+	"github.com/centrifuge/go-substrate-rpc-client/v4/mmr"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+// MMRRootID is the ID of the payload item that contains the MMR root
+var MMRRootID = [2]byte{'m', 'h'}
+
+// MMRRoot returns the MMR root from the payload of the commitment
+func MMRRoot(c types.Commitment) (types.H256, error) {
+	for _, item := range c.Payload {
+		if item.ID != MMRRootID {
+			continue
+		}
+
+		var root types.H256
+		err := codec.Decode(item.Data, &root)
+		if err != nil {
+			return types.H256{}, err
+		}
+		return root, nil
+	}
+
+	return types.H256{}, ErrMMRRootNotFound
+}
+
+// Verifier verifies signed commitments and follows the changes of the BEEFY authority set. It keeps the current
+// authority set with the public keys of its authorities, and the next authority set as announced by the latest
+// verified MMR leaf.
+type Verifier struct {
+	current AuthoritySet
+	next    types.BeefyNextAuthoritySet
+}
+
+// NewVerifier creates a Verifier that trusts the given current and next authority sets
+func NewVerifier(current AuthoritySet, next types.BeefyNextAuthoritySet) *Verifier {
+	return &Verifier{current: current, next: next}
+}
+
+// Current returns the current authority set
+func (v *Verifier) Current() AuthoritySet {
+	return v.current
+}
+
+// Next returns the next authority set
+func (v *Verifier) Next() types.BeefyNextAuthoritySet {
+	return v.next
+}
+
+// Verify verifies a signed commitment together with a MMR leaf and its proof against the MMR root in the commitment
+// payload.
+//
+// The commitment must be signed by the current or the next authority set. Only the merkle root of the next authority
+// set is known, so its authorities must be given when the commitment is signed by the next set, they are ignored
+// otherwise. Once the commitment and the leaf are verified, the signing set becomes the current set and the next
+// authority set of the leaf becomes the next set.
+func (v *Verifier) Verify(sc types.SignedCommitment, leaf types.MMREncodableOpaqueLeaf, proof types.MMRProof,
+	nextAuthorities []Authority) (Verification, error) {
+	set := v.current
+	if sc.Commitment.ValidatorSetID != v.current.ID {
+		if sc.Commitment.ValidatorSetID != uint64(v.next.ID) {
+			return Verification{}, ErrUnknownValidatorSet.WithMsg("validator set %d, current %d, next %d",
+				sc.Commitment.ValidatorSetID, v.current.ID, v.next.ID)
+		}
+
+		var err error
+		set, err = NewAuthoritySet(v.next, nextAuthorities)
+		if err != nil {
+			return Verification{}, err
+		}
+	}
+
+	res, err := VerifySignedCommitment(sc, set)
+	if err != nil {
+		return res, err
+	}
+
+	root, err := MMRRoot(sc.Commitment)
+	if err != nil {
+		return res, err
+	}
+
+	err = mmr.VerifyProof(root, leaf, proof)
+	if err != nil {
+		return res, err
+	}
+
+	decoded, err := leaf.DecodeLeaf()
+	if err != nil {
+		return res, err
+	}
+
+	next := decoded.BeefyNextAuthoritySet
+	if uint64(next.ID) != set.ID+1 {
+		return res, ErrInvalidNextAuthorities.WithMsg("expected set %d, got %d", set.ID+1, next.ID)
+	}
+
+	v.current = set
+	v.next = next
+
+	return res, nil
+}
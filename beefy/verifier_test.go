@@ -0,0 +1,134 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package beefy
+
+import (
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/mmr"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+	"github.com/stretchr/testify/assert"
+)
+
+// testLeaf returns an encoded MMR leaf that announces next, the commitment for a MMR with only that leaf and the
+// proof of the leaf
+func testLeaf(t *testing.T, setID uint64, next types.BeefyNextAuthoritySet) (
+	types.Commitment, types.MMREncodableOpaqueLeaf, types.MMRProof) {
+	leaf, err := codec.Encode(types.MMRLeaf{
+		ParentNumberAndHash:   types.ParentNumberAndHash{ParentNumber: 99, Hash: types.Hash{1}},
+		BeefyNextAuthoritySet: next,
+	})
+	assert.NoError(t, err)
+
+	root := mmr.LeafHash(leaf)
+	c := types.Commitment{
+		Payload:        []types.PayloadItem{{ID: MMRRootID, Data: root[:]}},
+		BlockNumber:    100,
+		ValidatorSetID: setID,
+	}
+	return c, leaf, types.MMRProof{LeafIndex: 0, LeafCount: 1}
+}
+
+func nextAuthoritySet(t *testing.T, id uint64, authorities []Authority) types.BeefyNextAuthoritySet {
+	root, err := AuthoritySet{Authorities: authorities}.Root()
+	assert.NoError(t, err)
+	return types.BeefyNextAuthoritySet{ID: types.U64(id), Len: types.U32(len(authorities)), Root: root}
+}
+
+func TestMMRRoot(t *testing.T) {
+	root := types.H256{1, 2, 3}
+	c := types.Commitment{Payload: []types.PayloadItem{
+		{ID: [2]byte{'x', 'x'}, Data: []byte{0x01}},
+		{ID: MMRRootID, Data: root[:]},
+	}}
+	r, err := MMRRoot(c)
+	assert.NoError(t, err)
+	assert.Equal(t, root, r)
+
+	_, err = MMRRoot(types.Commitment{})
+	assert.Equal(t, ErrMMRRootNotFound, err)
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	keys0, authorities0 := testKeys(t, 4)
+	keys1, authorities1 := testKeys(t, 7)
+	_, authorities2 := testKeys(t, 5)
+	set1 := nextAuthoritySet(t, 1, authorities1)
+	set2 := nextAuthoritySet(t, 2, authorities2)
+
+	v := NewVerifier(AuthoritySet{ID: 0, Authorities: authorities0}, set1)
+
+	// signed by the current set, the next set is announced again
+	c, leaf, proof := testLeaf(t, 0, set1)
+	res, err := v.Verify(sign(t, c, keys0, 0, 1, 2), leaf, proof, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2}, res.Signers)
+	assert.Equal(t, uint64(0), v.Current().ID)
+	assert.Equal(t, set1, v.Next())
+
+	// signed by the next set, which becomes the current set
+	c, leaf, proof = testLeaf(t, 1, set2)
+	res, err = v.Verify(sign(t, c, keys1, 0, 1, 2, 3, 4), leaf, proof, authorities1)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, res.Signers)
+	assert.Equal(t, AuthoritySet{ID: 1, Authorities: authorities1}, v.Current())
+	assert.Equal(t, set2, v.Next())
+}
+
+func TestVerifier_Verify_Invalid(t *testing.T) {
+	keys0, authorities0 := testKeys(t, 4)
+	keys1, authorities1 := testKeys(t, 7)
+	set1 := nextAuthoritySet(t, 1, authorities1)
+	current := AuthoritySet{ID: 0, Authorities: authorities0}
+
+	v := NewVerifier(current, set1)
+
+	// unknown validator set
+	c, leaf, proof := testLeaf(t, 5, set1)
+	_, err := v.Verify(sign(t, c, keys0, 0, 1, 2), leaf, proof, nil)
+	assert.ErrorIs(t, err, ErrUnknownValidatorSet)
+
+	// authorities of the next set missing
+	c, leaf, proof = testLeaf(t, 1, nextAuthoritySet(t, 2, authorities0))
+	_, err = v.Verify(sign(t, c, keys1, 0, 1, 2, 3, 4), leaf, proof, authorities0)
+	assert.ErrorIs(t, err, ErrAuthoritySetMismatch)
+
+	// not enough signatures
+	c, leaf, proof = testLeaf(t, 0, set1)
+	_, err = v.Verify(sign(t, c, keys0, 0, 1), leaf, proof, nil)
+	assert.ErrorIs(t, err, ErrNotEnoughSignatures)
+
+	// MMR root missing
+	sc := sign(t, types.Commitment{BlockNumber: 100}, keys0, 0, 1, 2)
+	_, err = v.Verify(sc, leaf, proof, nil)
+	assert.Equal(t, ErrMMRRootNotFound, err)
+
+	// leaf not in the MMR
+	c, _, proof = testLeaf(t, 0, set1)
+	_, otherLeaf, _ := testLeaf(t, 0, nextAuthoritySet(t, 1, authorities0))
+	_, err = v.Verify(sign(t, c, keys0, 0, 1, 2), otherLeaf, proof, nil)
+	assert.Equal(t, mmr.ErrRootMismatch, err)
+
+	// leaf announces a set that does not follow the signing set
+	c, leaf, proof = testLeaf(t, 0, nextAuthoritySet(t, 3, authorities1))
+	_, err = v.Verify(sign(t, c, keys0, 0, 1, 2), leaf, proof, nil)
+	assert.ErrorIs(t, err, ErrInvalidNextAuthorities)
+
+	assert.Equal(t, current, v.Current())
+	assert.Equal(t, set1, v.Next())
+}
@@ -0,0 +1,351 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/config"
+	gethrpc "github.com/centrifuge/go-substrate-rpc-client/v4/gethrpc"
+)
+
+// Cache stores responses of calls that are pinned to a block hash, keyed by method, params and block hash
+type Cache interface {
+	// Get returns the stored response for the key, if any
+	Get(key string) ([]byte, bool)
+	// Set stores the response for the key, a cache may drop it at any time
+	Set(key string, value []byte)
+}
+
+type lruEntry struct {
+	key   string
+	value []byte
+}
+
+type lruCache struct {
+	mu       sync.Mutex
+	maxBytes int
+	size     int
+	entries  *list.List
+	index    map[string]*list.Element
+}
+
+// NewLRUCache returns an in-memory cache that holds up to maxBytes of responses and evicts the least recently used
+// ones first. Responses larger than maxBytes are not stored.
+func NewLRUCache(maxBytes int) Cache {
+	return &lruCache{
+		maxBytes: maxBytes,
+		entries:  list.New(),
+		index:    make(map[string]*list.Element),
+	}
+}
+
+func (c *lruCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.index[key]
+	if !ok {
+		return nil, false
+	}
+
+	c.entries.MoveToFront(elem)
+
+	return elem.Value.(*lruEntry).value, true
+}
+
+func (c *lruCache) Set(key string, value []byte) {
+	if len(value) > c.maxBytes {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.index[key]; ok {
+		c.size -= len(elem.Value.(*lruEntry).value)
+		c.entries.Remove(elem)
+	}
+
+	c.index[key] = c.entries.PushFront(&lruEntry{key: key, value: value})
+	c.size += len(value)
+
+	for c.size > c.maxBytes {
+		oldest := c.entries.Back()
+		entry := oldest.Value.(*lruEntry)
+
+		c.entries.Remove(oldest)
+		delete(c.index, entry.key)
+		c.size -= len(entry.value)
+	}
+}
+
+type diskCache struct {
+	dir string
+}
+
+// NewDiskCache returns a cache that stores every response in a file in dir, which is created if it does not exist.
+// The files are never evicted, the directory can be deleted at any time to clear the cache.
+func NewDiskCache(dir string) (Cache, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+
+	return &diskCache{dir: dir}, nil
+}
+
+func (c *diskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:]))
+}
+
+func (c *diskCache) Get(key string) ([]byte, bool) {
+	b, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+
+	return b, true
+}
+
+func (c *diskCache) Set(key string, value []byte) {
+	tmp, err := os.CreateTemp(c.dir, "tmp-*")
+	if err != nil {
+		return
+	}
+
+	_, err = tmp.Write(value)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+
+	// Renaming makes sure that concurrent readers never see a partially written file
+	if err == nil {
+		err = os.Rename(tmp.Name(), c.path(key))
+	}
+
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+	}
+}
+
+type tieredCache []Cache
+
+// NewTieredCache returns a cache that looks up the caches in order and stores responses in all of them. Responses
+// found in a later cache are copied to the earlier ones, which allows putting an LRU cache in front of a disk cache.
+func NewTieredCache(caches ...Cache) Cache {
+	return tieredCache(caches)
+}
+
+func (t tieredCache) Get(key string) ([]byte, bool) {
+	for i, c := range t {
+		if value, ok := c.Get(key); ok {
+			for j := 0; j < i; j++ {
+				t[j].Set(key, value)
+			}
+
+			return value, true
+		}
+	}
+
+	return nil, false
+}
+
+func (t tieredCache) Set(key string, value []byte) {
+	for _, c := range t {
+		c.Set(key, value)
+	}
+}
+
+// rangeEndArgs maps the methods that take a range of blocks to the position of the end block in their arguments. The
+// start block pins the call to a node in blockHashArgs, but the response only stops changing once the end block is
+// given, without it the range ends at the latest block.
+var rangeEndArgs = map[string]int{
+	"state_queryStorage": 2,
+}
+
+// cacheKey returns the key the response of the call is stored under, if the response can be cached. Only calls that
+// pass a block hash as their last argument are cached, calls that target the latest block never are.
+func cacheKey(method string, args []interface{}) (string, bool) {
+	pos, ok := rangeEndArgs[method]
+	if !ok {
+		pos, ok = blockHashArg(method, args)
+	}
+
+	if !ok || len(args) != pos+1 {
+		return "", false
+	}
+
+	if _, ok := args[pos].(string); !ok {
+		return "", false
+	}
+
+	params, err := json.Marshal(args)
+	if err != nil {
+		return "", false
+	}
+
+	return method + " " + string(params), true
+}
+
+// cacheable reports whether the raw response can be stored, null responses are not, since the node might not have
+// had the block yet
+func cacheable(raw json.RawMessage) bool {
+	return len(raw) > 0 && !bytes.Equal(raw, []byte("null"))
+}
+
+// decodeCached decodes the raw response into result. Like on the connection, a nil result discards the response and
+// a null response, which is not passed on by the connection, leaves result untouched.
+func decodeCached(raw json.RawMessage, result interface{}) error {
+	if result == nil || len(raw) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(raw, result)
+}
+
+// cachingInvoker returns an invoker that serves the responses of cacheable calls from the cache
+func cachingInvoker(cache Cache, next Invoker) Invoker {
+	return func(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+		key, ok := cacheKey(method, args)
+		if !ok {
+			return next(ctx, result, method, args...)
+		}
+
+		if raw, ok := cache.Get(key); ok {
+			return decodeCached(raw, result)
+		}
+
+		var raw json.RawMessage
+		if err := next(ctx, &raw, method, args...); err != nil {
+			return err
+		}
+
+		if cacheable(raw) {
+			cache.Set(key, raw)
+		}
+
+		return decodeCached(raw, result)
+	}
+}
+
+// cachingBatchCaller returns a batch caller that serves the responses of cacheable elements from the cache and only
+// sends the remaining elements
+func cachingBatchCaller(cache Cache, next batchCaller) batchCaller {
+	return func(ctx context.Context, b []gethrpc.BatchElem) error {
+		var (
+			keys    []string
+			raws    []json.RawMessage
+			indices []int
+			misses  []gethrpc.BatchElem
+		)
+
+		for i, elem := range b {
+			key, ok := cacheKey(elem.Method, elem.Args)
+			if ok {
+				if raw, ok := cache.Get(key); ok {
+					b[i].Error = decodeCached(raw, elem.Result)
+					continue
+				}
+			}
+
+			keys = append(keys, key)
+			indices = append(indices, i)
+			misses = append(misses, elem)
+		}
+
+		if len(misses) == 0 {
+			return nil
+		}
+
+		raws = make([]json.RawMessage, len(misses))
+		for i := range misses {
+			misses[i].Result = &raws[i]
+		}
+
+		if err := next(ctx, misses); err != nil {
+			return err
+		}
+
+		for i, elem := range misses {
+			orig := &b[indices[i]]
+
+			if elem.Error != nil {
+				orig.Error = elem.Error
+				continue
+			}
+
+			if keys[i] != "" && cacheable(raws[i]) {
+				cache.Set(keys[i], raws[i])
+			}
+
+			orig.Error = decodeCached(raws[i], orig.Result)
+		}
+
+		return nil
+	}
+}
+
+// cachingClient serves the responses of calls pinned to a block hash from a cache
+type cachingClient struct {
+	Client
+
+	invoke Invoker
+	batch  batchCaller
+}
+
+// NewCachingClient returns a client that caches the responses of calls that are pinned to a block hash, such as
+// chain_getBlock, chain_getHeader, state_getMetadata, state_getRuntimeVersion and state_getStorage with a block hash.
+// These responses never change for a given block. Calls without a block hash, which target the latest block, are
+// never cached. Use WithCache to enable the cache when connecting.
+func NewCachingClient(cl Client, cache Cache) Client {
+	return &cachingClient{
+		Client: cl,
+		invoke: cachingInvoker(cache, cl.CallContext),
+		batch:  cachingBatchCaller(cache, cl.BatchCallContext),
+	}
+}
+
+// Config returns the config of the wrapped client
+func (c *cachingClient) Config() config.Config {
+	return ConfigOf(c.Client)
+}
+
+func (c *cachingClient) Call(result interface{}, method string, args ...interface{}) error {
+	return c.invoke(context.Background(), result, method, args...)
+}
+
+func (c *cachingClient) CallContext(ctx context.Context, result interface{}, method string,
+	args ...interface{}) error {
+	return c.invoke(ctx, result, method, args...)
+}
+
+func (c *cachingClient) BatchCall(b []gethrpc.BatchElem) error {
+	return c.batch(context.Background(), b)
+}
+
+func (c *cachingClient) BatchCallContext(ctx context.Context, b []gethrpc.BatchElem) error {
+	return c.batch(ctx, b)
+}
@@ -0,0 +1,192 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"testing"
+
+	gethrpc "github.com/centrifuge/go-substrate-rpc-client/v4/gethrpc"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/rpcmocksrv"
+	"github.com/stretchr/testify/assert"
+)
+
+const testBlockHash = "0x0102030000000000000000000000000000000000000000000000000000000000"
+
+// cacheStateService counts the storage queries and returns null for the key "missing"
+type cacheStateService struct {
+	calls int
+}
+
+func (s *cacheStateService) GetStorage(key string, blockHash *string) *string {
+	s.calls++
+	if key == "missing" {
+		return nil
+	}
+	return &key
+}
+
+func startCacheServer(t *testing.T) (*rpcmocksrv.Server, *cacheStateService) {
+	s := rpcmocksrv.New()
+	svc := &cacheStateService{}
+	assert.NoError(t, s.RegisterName("state", svc))
+	return s, svc
+}
+
+func TestLRUCache_Evicts(t *testing.T) {
+	c := NewLRUCache(6)
+
+	c.Set("a", []byte("aa"))
+	c.Set("b", []byte("bb"))
+	c.Set("c", []byte("cc"))
+
+	// a becomes the most recently used entry, so b is evicted first
+	_, ok := c.Get("a")
+	assert.True(t, ok)
+	c.Set("d", []byte("dd"))
+
+	_, ok = c.Get("b")
+	assert.False(t, ok)
+
+	for _, key := range []string{"a", "c", "d"} {
+		_, ok = c.Get(key)
+		assert.True(t, ok, key)
+	}
+
+	c.Set("e", []byte("too large"))
+	_, ok = c.Get("e")
+	assert.False(t, ok)
+}
+
+func TestDiskCache(t *testing.T) {
+	dir := t.TempDir()
+
+	c, err := NewDiskCache(dir)
+	assert.NoError(t, err)
+
+	_, ok := c.Get("state_getMetadata [\"0x01\"]")
+	assert.False(t, ok)
+
+	c.Set("state_getMetadata [\"0x01\"]", []byte("\"0x1234\""))
+
+	// A new cache on the same directory sees the stored responses, like after a restart
+	c, err = NewDiskCache(dir)
+	assert.NoError(t, err)
+
+	value, ok := c.Get("state_getMetadata [\"0x01\"]")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("\"0x1234\""), value)
+}
+
+func TestTieredCache_Promotes(t *testing.T) {
+	mem, disk := NewLRUCache(1024), NewLRUCache(1024)
+	disk.Set("key", []byte("value"))
+
+	c := NewTieredCache(mem, disk)
+
+	value, ok := c.Get("key")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("value"), value)
+
+	_, ok = mem.Get("key")
+	assert.True(t, ok)
+
+	c.Set("other", []byte("value"))
+	_, ok = disk.Get("other")
+	assert.True(t, ok)
+}
+
+func TestConnect_WithCache(t *testing.T) {
+	s, svc := startCacheServer(t)
+	defer s.Close()
+
+	cl, err := Connect(s.URL, WithCache(NewLRUCache(1024)))
+	assert.NoError(t, err)
+	defer cl.Close()
+
+	var res *string
+
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, cl.Call(&res, "state_getStorage", "0xaa", testBlockHash))
+		assert.Equal(t, "0xaa", *res)
+	}
+	assert.Equal(t, 1, svc.calls)
+
+	// Calls that target the latest block are never cached
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, cl.Call(&res, "state_getStorage", "0xaa"))
+		assert.Equal(t, "0xaa", *res)
+	}
+	assert.Equal(t, 3, svc.calls)
+
+	// Neither are null responses
+	for i := 0; i < 2; i++ {
+		res = nil
+		assert.NoError(t, cl.Call(&res, "state_getStorage", "missing", testBlockHash))
+		assert.Nil(t, res)
+	}
+	assert.Equal(t, 5, svc.calls)
+}
+
+func TestCacheKey(t *testing.T) {
+	_, ok := cacheKey("state_getStorage", []interface{}{"0xaa", testBlockHash})
+	assert.True(t, ok)
+
+	_, ok = cacheKey("state_getStorage", []interface{}{"0xaa"})
+	assert.False(t, ok)
+
+	// the changes of a range that ends at the latest block keep changing
+	_, ok = cacheKey("state_queryStorage", []interface{}{[]string{"0xaa"}, testBlockHash})
+	assert.False(t, ok)
+
+	_, ok = cacheKey("state_queryStorage", []interface{}{[]string{"0xaa"}, testBlockHash, testBlockHash})
+	assert.True(t, ok)
+
+	_, ok = cacheKey("mmr_generateProof", []interface{}{[]uint32{1}, nil, testBlockHash})
+	assert.True(t, ok)
+}
+
+func TestCachingClient_BatchCall(t *testing.T) {
+	s, svc := startCacheServer(t)
+	defer s.Close()
+
+	cc, err := Connect(s.URL)
+	assert.NoError(t, err)
+	defer cc.Close()
+
+	cl := NewCachingClient(cc, NewLRUCache(1024))
+
+	var res string
+	assert.NoError(t, cl.Call(&res, "state_getStorage", "0xaa", testBlockHash))
+	assert.Equal(t, 1, svc.calls)
+
+	res = ""
+	var latest string
+	batch := []gethrpc.BatchElem{
+		{Method: "state_getStorage", Args: []interface{}{"0xaa", testBlockHash}, Result: &res},
+		{Method: "state_getStorage", Args: []interface{}{"0xbb"}, Result: &latest},
+		{Method: "state_unknown", Args: []interface{}{"0xcc", testBlockHash}},
+	}
+
+	assert.NoError(t, cl.BatchCall(batch))
+	assert.Equal(t, 2, svc.calls)
+
+	assert.NoError(t, batch[0].Error)
+	assert.Equal(t, "0xaa", res)
+	assert.NoError(t, batch[1].Error)
+	assert.Equal(t, "0xbb", latest)
+	assert.Error(t, batch[2].Error)
+}
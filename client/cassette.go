@@ -0,0 +1,569 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"reflect"
+	"sync"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/config"
+	libErr "github.com/centrifuge/go-substrate-rpc-client/v4/error"
+	gethrpc "github.com/centrifuge/go-substrate-rpc-client/v4/gethrpc"
+)
+
+const (
+	ErrNotRecorded       = libErr.Error("no recorded interaction")
+	ErrInvalidChannel    = libErr.Error("channel must be a writable channel")
+	ErrCassetteRead      = libErr.Error("unable to read cassette")
+	ErrCassetteWrite     = libErr.Error("unable to write cassette")
+	ErrRecordedArgsCodec = libErr.Error("unable to encode args")
+)
+
+// RecordedError is an error returned by the node, as stored in a cassette. Replayed calls return it in place of the
+// original error, decoded to a libErr.RPCError like errors returned by the node.
+type RecordedError struct {
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+func (e *RecordedError) Error() string {
+	return e.Message
+}
+
+// ErrorCode returns the JSON-RPC error code
+func (e *RecordedError) ErrorCode() int {
+	return e.Code
+}
+
+// ErrorData returns the decoded data of the error object, if any
+func (e *RecordedError) ErrorData() interface{} {
+	var data interface{}
+	if len(e.Data) == 0 || json.Unmarshal(e.Data, &data) != nil {
+		return nil
+	}
+
+	return data
+}
+
+// RecordedCall is a single call and its response
+type RecordedCall struct {
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  *RecordedError  `json:"error,omitempty"`
+}
+
+// RecordedSubscription is a subscription and all notifications it received while it was recorded
+type RecordedSubscription struct {
+	Namespace                string            `json:"namespace"`
+	SubscribeMethodSuffix    string            `json:"subscribeMethodSuffix"`
+	UnsubscribeMethodSuffix  string            `json:"unsubscribeMethodSuffix"`
+	NotificationMethodSuffix string            `json:"notificationMethodSuffix"`
+	Params                   json.RawMessage   `json:"params"`
+	Notifications            []json.RawMessage `json:"notifications"`
+	Error                    *RecordedError    `json:"error,omitempty"`
+}
+
+// Cassette holds the calls and subscriptions recorded against a node, in the order they were made
+type Cassette struct {
+	Calls         []*RecordedCall         `json:"calls"`
+	Subscriptions []*RecordedSubscription `json:"subscriptions"`
+}
+
+// LoadCassette reads a cassette from a file written by Cassette.Save
+func LoadCassette(path string) (*Cassette, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, ErrCassetteRead.Wrap(err)
+	}
+
+	var c Cassette
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, ErrCassetteRead.Wrap(err)
+	}
+
+	return &c, nil
+}
+
+// Save writes the cassette to a file
+func (c *Cassette) Save(path string) error {
+	b, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return ErrCassetteWrite.Wrap(err)
+	}
+
+	if err := os.WriteFile(path, b, 0o600); err != nil {
+		return ErrCassetteWrite.Wrap(err)
+	}
+
+	return nil
+}
+
+// Recorder is a client that records all calls, batch calls and subscription notifications made through the wrapped
+// client, so that they can be replayed later without a node using a Replayer. Only responses of the node are
+// recorded, calls failing because of the connection are not.
+type Recorder struct {
+	client Client
+
+	mu       sync.Mutex
+	cassette Cassette
+}
+
+// NewRecorder returns a recorder that makes all calls on cl
+func NewRecorder(cl Client) *Recorder {
+	return &Recorder{client: cl}
+}
+
+// Cassette returns a copy of everything recorded so far
+func (r *Recorder) Cassette() *Cassette {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c := &Cassette{
+		Calls:         append([]*RecordedCall{}, r.cassette.Calls...),
+		Subscriptions: make([]*RecordedSubscription, len(r.cassette.Subscriptions)),
+	}
+
+	for i, sub := range r.cassette.Subscriptions {
+		s := *sub
+		s.Notifications = append([]json.RawMessage{}, sub.Notifications...)
+		c.Subscriptions[i] = &s
+	}
+
+	return c
+}
+
+// Save writes everything recorded so far to a file
+func (r *Recorder) Save(path string) error {
+	return r.Cassette().Save(path)
+}
+
+// Call makes the call on the wrapped client and records it
+func (r *Recorder) Call(result interface{}, method string, args ...interface{}) error {
+	return r.CallContext(context.Background(), result, method, args...)
+}
+
+// CallContext makes the call on the wrapped client and records it
+func (r *Recorder) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	params, err := encodeParams(args)
+	if err != nil {
+		return err
+	}
+
+	var raw json.RawMessage
+
+	err = r.client.CallContext(ctx, &raw, method, args...)
+
+	call := &RecordedCall{Method: method, Params: params, Result: raw, Error: toRecordedError(err)}
+	if err != nil && call.Error == nil {
+		return err
+	}
+
+	r.mu.Lock()
+	r.cassette.Calls = append(r.cassette.Calls, call)
+	r.mu.Unlock()
+
+	return call.decode(result)
+}
+
+// BatchCall makes the batch call on the wrapped client and records every element as a call
+func (r *Recorder) BatchCall(b []gethrpc.BatchElem) error {
+	return r.BatchCallContext(context.Background(), b)
+}
+
+// BatchCallContext makes the batch call on the wrapped client and records every element as a call
+func (r *Recorder) BatchCallContext(ctx context.Context, b []gethrpc.BatchElem) error {
+	params := make([]json.RawMessage, len(b))
+	raws := make([]json.RawMessage, len(b))
+	batch := make([]gethrpc.BatchElem, len(b))
+
+	for i, elem := range b {
+		var err error
+		if params[i], err = encodeParams(elem.Args); err != nil {
+			return err
+		}
+
+		batch[i] = gethrpc.BatchElem{Method: elem.Method, Args: elem.Args, Result: &raws[i]}
+	}
+
+	if err := r.client.BatchCallContext(ctx, batch); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i := range b {
+		call := &RecordedCall{
+			Method: b[i].Method,
+			Params: params[i],
+			Result: raws[i],
+			Error:  toRecordedError(batch[i].Error),
+		}
+		if batch[i].Error != nil && call.Error == nil {
+			b[i].Error = batch[i].Error
+			continue
+		}
+
+		r.cassette.Calls = append(r.cassette.Calls, call)
+		b[i].Error = call.decode(b[i].Result)
+	}
+
+	return nil
+}
+
+// Subscribe creates the subscription on the wrapped client and records all notifications it receives until it is
+// unsubscribed
+func (r *Recorder) Subscribe(ctx context.Context, namespace, subscribeMethodSuffix, unsubscribeMethodSuffix,
+	notificationMethodSuffix string, channel interface{}, args ...interface{}) (*gethrpc.ClientSubscription, error) {
+	chanVal, err := checkChannel(channel)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := encodeParams(args)
+	if err != nil {
+		return nil, err
+	}
+
+	rec := &RecordedSubscription{
+		Namespace:                namespace,
+		SubscribeMethodSuffix:    subscribeMethodSuffix,
+		UnsubscribeMethodSuffix:  unsubscribeMethodSuffix,
+		NotificationMethodSuffix: notificationMethodSuffix,
+		Params:                   params,
+		Notifications:            []json.RawMessage{},
+	}
+
+	raws := make(chan json.RawMessage)
+
+	sub, err := r.client.Subscribe(ctx, namespace, subscribeMethodSuffix, unsubscribeMethodSuffix,
+		notificationMethodSuffix, raws, args...)
+	if err != nil {
+		if rec.Error = toRecordedError(err); rec.Error != nil {
+			r.mu.Lock()
+			r.cassette.Subscriptions = append(r.cassette.Subscriptions, rec)
+			r.mu.Unlock()
+		}
+
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.cassette.Subscriptions = append(r.cassette.Subscriptions, rec)
+	r.mu.Unlock()
+
+	quit := make(chan struct{})
+	var quitOnce sync.Once
+
+	managed := gethrpc.NewManagedSubscription(func() {
+		quitOnce.Do(func() { close(quit) })
+		sub.Unsubscribe()
+	})
+
+	go func() {
+		for {
+			select {
+			case raw := <-raws:
+				r.mu.Lock()
+				rec.Notifications = append(rec.Notifications, raw)
+				r.mu.Unlock()
+
+				if !deliver(chanVal, raw, quit) {
+					return
+				}
+			case err := <-sub.Err():
+				if err == nil {
+					// Terminate delivers ErrClientQuit as nil, like the wrapped subscription
+					err = gethrpc.ErrClientQuit
+				}
+				managed.Terminate(err)
+
+				return
+			case <-quit:
+				return
+			}
+		}
+	}()
+
+	return managed, nil
+}
+
+// Config returns the config of the wrapped client
+func (r *Recorder) Config() config.Config {
+	return ConfigOf(r.client)
+}
+
+// URL returns the URL of the wrapped client
+func (r *Recorder) URL() string {
+	return r.client.URL()
+}
+
+// Close closes the wrapped client
+func (r *Recorder) Close() {
+	r.client.Close()
+}
+
+// Replayer is a client that serves the calls and subscriptions of a cassette without any network access. Calls are
+// matched on method and params, calls with the same method and params are served in the order they were recorded and
+// the last one is repeated once all of them have been served. Calls that were never recorded fail with
+// ErrNotRecorded.
+//
+// Subscriptions deliver all recorded notifications and stay open afterwards until they are unsubscribed.
+type Replayer struct {
+	mu            sync.Mutex
+	calls         map[string][]*RecordedCall
+	subscriptions map[string][]*RecordedSubscription
+}
+
+// NewReplayer returns a client that replays the cassette
+func NewReplayer(c *Cassette) *Replayer {
+	r := &Replayer{
+		calls:         make(map[string][]*RecordedCall),
+		subscriptions: make(map[string][]*RecordedSubscription),
+	}
+
+	for _, call := range c.Calls {
+		key := replayKey(call.Method, call.Params)
+		r.calls[key] = append(r.calls[key], call)
+	}
+
+	for _, sub := range c.Subscriptions {
+		key := replayKey(sub.Namespace+"_"+sub.SubscribeMethodSuffix, sub.Params)
+		r.subscriptions[key] = append(r.subscriptions[key], sub)
+	}
+
+	return r
+}
+
+// Replay loads the cassette from a file and returns a client that replays it
+func Replay(path string) (*Replayer, error) {
+	c, err := LoadCassette(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewReplayer(c), nil
+}
+
+func (r *Replayer) next(method string, args []interface{}) (*RecordedCall, error) {
+	params, err := encodeParams(args)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := replayKey(method, params)
+
+	calls := r.calls[key]
+	if len(calls) == 0 {
+		return nil, ErrNotRecorded.WithMsg("%s %s", method, params)
+	}
+
+	if len(calls) > 1 {
+		r.calls[key] = calls[1:]
+	}
+
+	return calls[0], nil
+}
+
+// Call replays the recorded response of the call
+func (r *Replayer) Call(result interface{}, method string, args ...interface{}) error {
+	return r.CallContext(context.Background(), result, method, args...)
+}
+
+// CallContext replays the recorded response of the call
+func (r *Replayer) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	call, err := r.next(method, args)
+	if err != nil {
+		return err
+	}
+
+	return call.decode(result)
+}
+
+// BatchCall replays the recorded responses of all elements of the batch
+func (r *Replayer) BatchCall(b []gethrpc.BatchElem) error {
+	return r.BatchCallContext(context.Background(), b)
+}
+
+// BatchCallContext replays the recorded responses of all elements of the batch
+func (r *Replayer) BatchCallContext(ctx context.Context, b []gethrpc.BatchElem) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	for i := range b {
+		call, err := r.next(b[i].Method, b[i].Args)
+		if err != nil {
+			b[i].Error = err
+			continue
+		}
+
+		b[i].Error = call.decode(b[i].Result)
+	}
+
+	return nil
+}
+
+// Subscribe replays the recorded notifications of the subscription
+func (r *Replayer) Subscribe(ctx context.Context, namespace, subscribeMethodSuffix, _, _ string,
+	channel interface{}, args ...interface{}) (*gethrpc.ClientSubscription, error) {
+	chanVal, err := checkChannel(channel)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := encodeParams(args)
+	if err != nil {
+		return nil, err
+	}
+
+	method := namespace + "_" + subscribeMethodSuffix
+	key := replayKey(method, params)
+
+	r.mu.Lock()
+	subs := r.subscriptions[key]
+	if len(subs) > 1 {
+		r.subscriptions[key] = subs[1:]
+	}
+	r.mu.Unlock()
+
+	if len(subs) == 0 {
+		return nil, ErrNotRecorded.WithMsg("%s %s", method, params)
+	}
+
+	rec := subs[0]
+	if rec.Error != nil {
+		return nil, libErr.DecodeRPCError(rec.Error)
+	}
+
+	quit := make(chan struct{})
+	var quitOnce sync.Once
+
+	managed := gethrpc.NewManagedSubscription(func() {
+		quitOnce.Do(func() { close(quit) })
+	})
+
+	go func() {
+		for _, raw := range rec.Notifications {
+			if !deliver(chanVal, raw, quit) {
+				return
+			}
+		}
+	}()
+
+	return managed, nil
+}
+
+// URL returns a placeholder, a replayer is not connected to any node
+func (r *Replayer) URL() string {
+	return "replay://"
+}
+
+// Close is a no-op for a replayer
+func (r *Replayer) Close() {}
+
+func (c *RecordedCall) decode(result interface{}) error {
+	if c.Error != nil {
+		return libErr.DecodeRPCError(c.Error)
+	}
+
+	if len(c.Result) == 0 {
+		return gethrpc.ErrNoResult
+	}
+
+	return json.Unmarshal(c.Result, result)
+}
+
+// toRecordedError converts errors returned by the node, it returns nil for any other error
+func toRecordedError(err error) *RecordedError {
+	var rpcErr gethrpc.Error
+	if err == nil || !errors.As(err, &rpcErr) {
+		return nil
+	}
+
+	// The errors of the RPC package hold their data in an exported field, which is kept by marshalling them
+	if b, marshalErr := json.Marshal(rpcErr); marshalErr == nil {
+		var rec RecordedError
+		if json.Unmarshal(b, &rec) == nil && rec.Code == rpcErr.ErrorCode() {
+			return &rec
+		}
+	}
+
+	return &RecordedError{Code: rpcErr.ErrorCode(), Message: rpcErr.Error()}
+}
+
+func encodeParams(args []interface{}) (json.RawMessage, error) {
+	if args == nil {
+		args = []interface{}{}
+	}
+
+	b, err := json.Marshal(args)
+	if err != nil {
+		return nil, ErrRecordedArgsCodec.Wrap(err)
+	}
+
+	return b, nil
+}
+
+// replayKey returns the key calls are matched on, params are compacted since the cassette is stored indented
+func replayKey(method string, params json.RawMessage) string {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, params); err != nil {
+		return method + " " + string(params)
+	}
+
+	return method + " " + buf.String()
+}
+
+func checkChannel(channel interface{}) (reflect.Value, error) {
+	chanVal := reflect.ValueOf(channel)
+	if chanVal.Kind() != reflect.Chan || chanVal.Type().ChanDir()&reflect.SendDir == 0 {
+		return reflect.Value{}, ErrInvalidChannel
+	}
+
+	return chanVal, nil
+}
+
+// deliver decodes the notification into the element type of the channel and sends it, unless quit is closed first.
+// Notifications that cannot be decoded are dropped.
+func deliver(chanVal reflect.Value, raw json.RawMessage, quit <-chan struct{}) bool {
+	val := reflect.New(chanVal.Type().Elem())
+	if err := json.Unmarshal(raw, val.Interface()); err != nil {
+		return true
+	}
+
+	chosen, _, _ := reflect.Select([]reflect.SelectCase{
+		{Dir: reflect.SelectSend, Chan: chanVal, Send: val.Elem()},
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(quit)},
+	})
+
+	return chosen == 0
+}
@@ -0,0 +1,153 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	gethrpc "github.com/centrifuge/go-substrate-rpc-client/v4/gethrpc"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecorder_ClientClosed(t *testing.T) {
+	s := startHeadsServer(t, "")
+	defer s.Close()
+
+	cl, err := Connect(s.URL)
+	assert.NoError(t, err)
+
+	rec := NewRecorder(cl)
+
+	sub, err := rec.Subscribe(context.Background(), "chain", "subscribeNewHead", "unsubscribeNewHead", "newHead",
+		make(chan types.Header))
+	assert.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	rec.Close()
+
+	select {
+	case err := <-sub.Err():
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("subscription not ended")
+	}
+}
+
+func TestRecorder_Replayer(t *testing.T) {
+	s := startHeadsServer(t, "")
+	assert.NoError(t, s.RegisterName("test", &echoService{}))
+
+	cl, err := Connect(s.URL)
+	assert.NoError(t, err)
+
+	rec := NewRecorder(cl)
+
+	var res int
+	assert.NoError(t, rec.Call(&res, "test_echo", 1))
+	assert.NoError(t, rec.Call(&res, "test_echo", 2))
+	assert.NoError(t, rec.Call(&res, "test_echo", 2))
+
+	var name string
+	assert.NoError(t, rec.Call(&name, "chain_getName"))
+	assert.Equal(t, "mock", name)
+
+	callErr := rec.Call(&res, "test_unknown")
+	assert.Error(t, callErr)
+
+	batchRes := make([]int, 2)
+	batch := []gethrpc.BatchElem{
+		{Method: "test_echo", Args: []interface{}{3}, Result: &batchRes[0]},
+		{Method: "test_echo", Args: []interface{}{4}, Result: &batchRes[1]},
+	}
+	assert.NoError(t, rec.BatchCall(batch))
+	assert.Equal(t, []int{3, 4}, batchRes)
+
+	ch := make(chan types.Header)
+	sub, err := rec.Subscribe(context.Background(), "chain", "subscribeNewHead", "unsubscribeNewHead", "newHead", ch)
+	assert.NoError(t, err)
+
+	for i := 1; i <= 3; i++ {
+		h := <-ch
+		assert.Equal(t, types.BlockNumber(i), h.Number)
+	}
+	sub.Unsubscribe()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	assert.NoError(t, rec.Save(path))
+
+	rec.Close()
+	s.Close()
+
+	replayer, err := Replay(path)
+	assert.NoError(t, err)
+
+	assert.NoError(t, replayer.Call(&res, "test_echo", 2))
+	assert.Equal(t, 2, res)
+	assert.NoError(t, replayer.Call(&res, "test_echo", 1))
+	assert.Equal(t, 1, res)
+
+	// Calls are served in order and the last one is repeated
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, replayer.Call(&name, "chain_getName"))
+		assert.Equal(t, "mock", name)
+	}
+
+	err = replayer.Call(&res, "test_unknown")
+	assert.EqualError(t, err, callErr.Error())
+	var rpcErr gethrpc.Error
+	assert.ErrorAs(t, err, &rpcErr)
+	assert.Equal(t, callErr.(gethrpc.Error).ErrorCode(), rpcErr.ErrorCode())
+
+	assert.ErrorIs(t, replayer.Call(&res, "test_echo", 5), ErrNotRecorded)
+
+	batchRes = make([]int, 2)
+	batch = []gethrpc.BatchElem{
+		{Method: "test_echo", Args: []interface{}{4}, Result: &batchRes[0]},
+		{Method: "test_echo", Args: []interface{}{5}, Result: &batchRes[1]},
+	}
+	assert.NoError(t, replayer.BatchCall(batch))
+	assert.NoError(t, batch[0].Error)
+	assert.Equal(t, 4, batchRes[0])
+	assert.ErrorIs(t, batch[1].Error, ErrNotRecorded)
+
+	ch = make(chan types.Header)
+	sub, err = replayer.Subscribe(context.Background(), "chain", "subscribeNewHead", "unsubscribeNewHead", "newHead",
+		ch)
+	assert.NoError(t, err)
+
+	var replayed []types.BlockNumber
+
+	timeout := time.After(time.Second)
+	for len(replayed) < 3 {
+		select {
+		case h := <-ch:
+			replayed = append(replayed, h.Number)
+		case <-timeout:
+			t.Fatal("notifications not replayed")
+		}
+	}
+	assert.Equal(t, []types.BlockNumber{1, 2, 3}, replayed[:3])
+	sub.Unsubscribe()
+
+	_, err = replayer.Subscribe(context.Background(), "chain", "subscribeFinalizedHeads", "unsubscribeFinalizedHeads",
+		"finalizedHead", ch)
+	assert.ErrorIs(t, err, ErrNotRecorded)
+}
@@ -18,9 +18,10 @@ package client
 
 import (
 	"context"
-	"log"
+	"time"
 
 	"github.com/centrifuge/go-substrate-rpc-client/v4/config"
+	libErr "github.com/centrifuge/go-substrate-rpc-client/v4/error"
 	gethrpc "github.com/centrifuge/go-substrate-rpc-client/v4/gethrpc"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
@@ -33,6 +34,18 @@ type Client interface {
 	// args must be encoded in the format RPC understands
 	Call(result interface{}, method string, args ...interface{}) error
 
+	// CallContext makes the call to RPC method with the provided args, the call is aborted when ctx is done
+	// args must be encoded in the format RPC understands
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+
+	// BatchCall sends all given requests as a single batch and waits for the node to respond to all of them. Errors of
+	// the individual requests are reported through the Error field of the corresponding BatchElem, the returned error
+	// is only set if the batch as a whole failed
+	BatchCall(b []gethrpc.BatchElem) error
+
+	// BatchCallContext is like BatchCall, the batch is aborted when ctx is done
+	BatchCallContext(ctx context.Context, b []gethrpc.BatchElem) error
+
 	Subscribe(ctx context.Context, namespace, subscribeMethodSuffix, unsubscribeMethodSuffix,
 		notificationMethodSuffix string, channel interface{}, args ...interface{}) (
 		*gethrpc.ClientSubscription, error)
@@ -46,6 +59,79 @@ type client struct {
 	gethrpc.Client
 
 	url string
+	cfg config.Config
+}
+
+// ConfigOf returns the config a client was connected with, or config.Default() if the client does not carry a config.
+// Clients carry a config by implementing a Config() config.Config method, which all clients of this package do.
+func ConfigOf(c Client) config.Config {
+	if cc, ok := c.(interface{ Config() config.Config }); ok {
+		return cc.Config()
+	}
+
+	return config.Default()
+}
+
+// Config returns the config the client was connected with
+func (c *client) Config() config.Config {
+	return c.cfg
+}
+
+// withCallTimeout bounds ctx by the call timeout of the config, unless ctx already has a deadline
+func (c *client) withCallTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok || c.cfg.CallTimeout <= 0 {
+		return ctx, func() {}
+	}
+
+	return context.WithTimeout(ctx, c.cfg.CallTimeout)
+}
+
+// Call makes the call to RPC method with the provided args, bounded by the call timeout of the config
+func (c *client) Call(result interface{}, method string, args ...interface{}) error {
+	return c.CallContext(context.Background(), result, method, args...)
+}
+
+// CallContext makes the call to RPC method with the provided args, bounded by the call timeout of the config if ctx
+// has no deadline
+func (c *client) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	ctx, cancel := c.withCallTimeout(ctx)
+	defer cancel()
+
+	return libErr.DecodeRPCError(c.Client.CallContext(ctx, result, method, args...))
+}
+
+// BatchCall sends the requests as a single batch, bounded by the call timeout of the config
+func (c *client) BatchCall(b []gethrpc.BatchElem) error {
+	return c.BatchCallContext(context.Background(), b)
+}
+
+// BatchCallContext sends the requests as a single batch, bounded by the call timeout of the config if ctx has no
+// deadline
+func (c *client) BatchCallContext(ctx context.Context, b []gethrpc.BatchElem) error {
+	ctx, cancel := c.withCallTimeout(ctx)
+	defer cancel()
+
+	if err := c.Client.BatchCallContext(ctx, b); err != nil {
+		return libErr.DecodeRPCError(err)
+	}
+
+	for i := range b {
+		b[i].Error = libErr.DecodeRPCError(b[i].Error)
+	}
+
+	return nil
+}
+
+// Subscribe creates the subscription on the connection
+func (c *client) Subscribe(ctx context.Context, namespace, subscribeMethodSuffix, unsubscribeMethodSuffix,
+	notificationMethodSuffix string, channel interface{}, args ...interface{}) (*gethrpc.ClientSubscription, error) {
+	sub, err := c.Client.Subscribe(ctx, namespace, subscribeMethodSuffix, unsubscribeMethodSuffix,
+		notificationMethodSuffix, channel, args...)
+	if err != nil {
+		return nil, libErr.DecodeRPCError(err)
+	}
+
+	return sub, nil
 }
 
 // URL returns the URL the client connects to
@@ -58,23 +144,86 @@ func (c client) Close() {
 }
 
 // Connect connects to the provided url
-func Connect(url string) (Client, error) {
-	log.Printf("Connecting to %v...", url)
+func Connect(url string, opts ...Option) (Client, error) {
+	o := newOptions(opts)
 
-	ctx, cancel := context.WithTimeout(context.Background(), config.Default().DialTimeout)
-	defer cancel()
+	cc, err := dial(url, o)
+	if err != nil {
+		return nil, err
+	}
+	return newInterceptedClient(cc, o), nil
+}
 
-	c, err := gethrpc.DialContext(ctx, url)
+func dial(url string, o *options) (*client, error) {
+	dialOpts, err := o.dialOptions()
 	if err != nil {
 		return nil, err
 	}
-	cc := client{*c, url}
-	return &cc, nil
+
+	for attempt := 1; ; attempt++ {
+		o.logger.Info("Connecting", "url", url)
+
+		var c *gethrpc.Client
+		c, err = dialOnce(url, o.cfg.DialTimeout, dialOpts)
+		if err == nil {
+			return &client{Client: *c, url: url, cfg: o.cfg}, nil
+		}
+
+		if attempt >= o.cfg.Retry.MaxAttempts {
+			return nil, err
+		}
+
+		delay := o.cfg.Retry.Delay(attempt)
+		o.logger.Warn("Connecting failed, retrying", "url", url, "error", err, "delay", delay)
+		time.Sleep(delay)
+	}
+}
+
+func dialOnce(url string, timeout time.Duration, opts []gethrpc.ClientOption) (*gethrpc.Client, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return gethrpc.DialOptions(ctx, url, opts...)
+}
+
+// DefaultBatchSize is the maximum number of requests the RPC modules send in a single batch
+const DefaultBatchSize = 500
+
+// BatchCallInChunks sends the requests in consecutive batches of at most size requests, so that large batches do not
+// exceed the request limits of the node. It stops at the first batch that fails as a whole and returns its error, the
+// error is also set on the requests of that batch and all requests after it, so that the results of the earlier
+// batches can still be used.
+func BatchCallInChunks(ctx context.Context, c Client, b []gethrpc.BatchElem, size int) error {
+	if size <= 0 {
+		size = DefaultBatchSize
+	}
+
+	for start := 0; start < len(b); start += size {
+		end := start + size
+		if end > len(b) {
+			end = len(b)
+		}
+
+		if err := c.BatchCallContext(ctx, b[start:end]); err != nil {
+			for i := start; i < len(b); i++ {
+				b[i].Error = err
+			}
+			return err
+		}
+	}
+
+	return nil
 }
 
 func CallWithBlockHash(c Client, target interface{}, method string, blockHash *types.Hash, args ...interface{}) error {
+	return CallWithBlockHashContext(context.Background(), c, target, method, blockHash, args...)
+}
+
+// CallWithBlockHashContext is like CallWithBlockHash, the call is aborted when ctx is done
+func CallWithBlockHashContext(ctx context.Context, c Client, target interface{}, method string, blockHash *types.Hash,
+	args ...interface{}) error {
 	if blockHash == nil {
-		err := c.Call(target, method, args...)
+		err := c.CallContext(ctx, target, method, args...)
 		if err != nil {
 			return err
 		}
@@ -85,7 +234,7 @@ func CallWithBlockHash(c Client, target interface{}, method string, blockHash *t
 		return err
 	}
 	args = append(args, hexHash)
-	err = c.Call(target, method, args...)
+	err = c.CallContext(ctx, target, method, args...)
 	if err != nil {
 		return err
 	}
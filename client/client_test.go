@@ -0,0 +1,176 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	libErr "github.com/centrifuge/go-substrate-rpc-client/v4/error"
+	gethrpc "github.com/centrifuge/go-substrate-rpc-client/v4/gethrpc"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/rpcmocksrv"
+	"github.com/stretchr/testify/assert"
+)
+
+type echoService struct {
+	calls int
+}
+
+func (s *echoService) Echo(i int) int {
+	s.calls++
+	return i
+}
+
+func TestBatchCallInChunks(t *testing.T) {
+	s := rpcmocksrv.New()
+	defer s.Close()
+
+	svc := &echoService{}
+	assert.NoError(t, s.RegisterName("test", svc))
+
+	cl, err := Connect(s.URL)
+	assert.NoError(t, err)
+	defer cl.Close()
+
+	res := make([]int, 7)
+	batch := make([]gethrpc.BatchElem, len(res))
+	for i := range batch {
+		batch[i] = gethrpc.BatchElem{Method: "test_echo", Args: []interface{}{i}, Result: &res[i]}
+	}
+	batch[3].Method = "test_unknown"
+
+	err = BatchCallInChunks(context.Background(), cl, batch, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, 6, svc.calls)
+
+	for i, elem := range batch {
+		if i == 3 {
+			assert.Error(t, elem.Error)
+			continue
+		}
+		assert.NoError(t, elem.Error)
+		assert.Equal(t, i, res[i])
+	}
+}
+
+// failingBatchClient fails every batch after the first n batches as a whole
+type failingBatchClient struct {
+	Client
+
+	n int
+}
+
+func (c *failingBatchClient) BatchCallContext(ctx context.Context, b []gethrpc.BatchElem) error {
+	if c.n == 0 {
+		return errors.New("batch failed")
+	}
+	c.n--
+	return c.Client.BatchCallContext(ctx, b)
+}
+
+func TestBatchCallInChunks_PartialResults(t *testing.T) {
+	s := rpcmocksrv.New()
+	defer s.Close()
+
+	svc := &echoService{}
+	assert.NoError(t, s.RegisterName("test", svc))
+
+	cl, err := Connect(s.URL)
+	assert.NoError(t, err)
+	defer cl.Close()
+
+	res := make([]int, 7)
+	batch := make([]gethrpc.BatchElem, len(res))
+	for i := range batch {
+		batch[i] = gethrpc.BatchElem{Method: "test_echo", Args: []interface{}{i}, Result: &res[i]}
+	}
+
+	err = BatchCallInChunks(context.Background(), &failingBatchClient{Client: cl, n: 1}, batch, 3)
+	assert.EqualError(t, err, "batch failed")
+	assert.Equal(t, 3, svc.calls)
+
+	for i, elem := range batch {
+		if i < 3 {
+			assert.NoError(t, elem.Error)
+			assert.Equal(t, i, res[i])
+			continue
+		}
+		assert.Equal(t, err, elem.Error)
+	}
+}
+
+type poolError struct {
+	code int
+	msg  string
+	data interface{}
+}
+
+func (e *poolError) Error() string          { return e.msg }
+func (e *poolError) ErrorCode() int         { return e.code }
+func (e *poolError) ErrorData() interface{} { return e.data }
+
+type poolService struct{}
+
+func (s *poolService) SubmitExtrinsic(xt string) (string, error) {
+	switch xt {
+	case "stale":
+		return "", &poolError{1010, "Invalid Transaction", "Transaction is outdated"}
+	case "custom":
+		return "", &poolError{1010, "Invalid Transaction", "Custom error: 7"}
+	case "low":
+		return "", &poolError{1014, "Priority is too low: (10 vs 20)",
+			"The transaction has too low priority to replace another transaction already in the pool."}
+	default:
+		return "", &poolError{4003, "UnknownBlock: State already discarded for 0x01", nil}
+	}
+}
+
+func TestClient_TypedRPCErrors(t *testing.T) {
+	s := rpcmocksrv.New()
+	defer s.Close()
+
+	assert.NoError(t, s.RegisterName("author", &poolService{}))
+
+	cl, err := Connect(s.URL)
+	assert.NoError(t, err)
+	defer cl.Close()
+
+	var res string
+	err = cl.Call(&res, "author_submitExtrinsic", "stale")
+	assert.EqualError(t, err, "Invalid Transaction: Transaction is outdated")
+
+	var invalid libErr.ErrInvalidTransaction
+	assert.ErrorAs(t, err, &invalid)
+	assert.Equal(t, libErr.InvalidTransactionStale, invalid.Reason)
+
+	var rpcErr *libErr.RPCError
+	assert.ErrorAs(t, err, &rpcErr)
+	assert.Equal(t, 1010, rpcErr.Code)
+	assert.Equal(t, "Transaction is outdated", rpcErr.Data)
+
+	err = cl.CallContext(context.Background(), &res, "author_submitExtrinsic", "custom")
+	assert.ErrorIs(t, err, libErr.ErrInvalidTransaction{Reason: libErr.InvalidTransactionCustom, Custom: 7})
+
+	batch := []gethrpc.BatchElem{
+		{Method: "author_submitExtrinsic", Args: []interface{}{"low"}, Result: &res},
+		{Method: "author_submitExtrinsic", Args: []interface{}{"pruned"}, Result: &res},
+	}
+	assert.NoError(t, cl.BatchCall(batch))
+	assert.ErrorIs(t, batch[0].Error, libErr.ErrPriorityTooLow)
+	assert.ErrorIs(t, batch[1].Error, libErr.ErrUnknownBlock)
+}
@@ -0,0 +1,425 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/config"
+	gethrpc "github.com/centrifuge/go-substrate-rpc-client/v4/gethrpc"
+)
+
+// Invoker makes a unary RPC call, either on the connection or by calling the next interceptor of the chain
+type Invoker func(ctx context.Context, result interface{}, method string, args ...interface{}) error
+
+// Interceptor intercepts unary RPC calls. It is given the call and the invoker that continues the chain, and may
+// inspect or rewrite the arguments, the result and the error, or not call the invoker at all.
+type Interceptor func(ctx context.Context, result interface{}, method string, args []interface{},
+	invoker Invoker) error
+
+// SubscribeRequest describes a subscription that is about to be created
+type SubscribeRequest struct {
+	Namespace                string
+	SubscribeMethodSuffix    string
+	UnsubscribeMethodSuffix  string
+	NotificationMethodSuffix string
+	Channel                  interface{}
+	Args                     []interface{}
+}
+
+// Method returns the name of the RPC method that creates the subscription, e.g. chain_subscribeNewHead
+func (r SubscribeRequest) Method() string {
+	return r.Namespace + "_" + r.SubscribeMethodSuffix
+}
+
+// Subscriber creates a subscription, either on the connection or by calling the next interceptor of the chain
+type Subscriber func(ctx context.Context, req SubscribeRequest) (*gethrpc.ClientSubscription, error)
+
+// SubscribeInterceptor intercepts the creation of subscriptions. It is given the request and the subscriber that
+// continues the chain. Notifications are delivered on the channel of the request and are not intercepted.
+type SubscribeInterceptor func(ctx context.Context, req SubscribeRequest,
+	subscriber Subscriber) (*gethrpc.ClientSubscription, error)
+
+// chainInterceptors returns an invoker that runs the interceptors in order, the first interceptor being the outermost
+// one, and calls invoker at the end of the chain
+func chainInterceptors(interceptors []Interceptor, invoker Invoker) Invoker {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], invoker
+
+		invoker = func(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+			return interceptor(ctx, result, method, args, next)
+		}
+	}
+
+	return invoker
+}
+
+// chainSubscribeInterceptors returns a subscriber that runs the interceptors in order, the first interceptor being the
+// outermost one, and calls subscriber at the end of the chain
+func chainSubscribeInterceptors(interceptors []SubscribeInterceptor, subscriber Subscriber) Subscriber {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], subscriber
+
+		subscriber = func(ctx context.Context, req SubscribeRequest) (*gethrpc.ClientSubscription, error) {
+			return interceptor(ctx, req, next)
+		}
+	}
+
+	return subscriber
+}
+
+type batchCaller func(ctx context.Context, b []gethrpc.BatchElem) error
+
+// interceptBatch returns a batch caller that runs every element of a batch through the interceptors. The interceptors
+// of the elements run concurrently, the elements that reach the end of the chain are sent with next as a single batch
+// once the chains of all elements have either reached their end or returned. Elements whose chain calls the invoker
+// again after the batch was sent, e.g. to retry, are sent one by one with call.
+func interceptBatch(interceptors []Interceptor, next batchCaller, call Invoker) batchCaller {
+	if len(interceptors) == 0 {
+		return next
+	}
+
+	return func(ctx context.Context, b []gethrpc.BatchElem) error {
+		if len(b) == 0 {
+			return next(ctx, b)
+		}
+
+		var (
+			mu       sync.Mutex
+			waiting  = len(b)
+			closed   bool
+			pending  []gethrpc.BatchElem
+			sent     = make(chan struct{})
+			batchErr error
+		)
+
+		// arrive counts an element as done with the chain and sends the batch once all elements are, mu must be held
+		arrive := func() bool {
+			waiting--
+			closed = waiting == 0
+			return closed
+		}
+
+		send := func() {
+			if len(pending) > 0 {
+				batchErr = next(ctx, pending)
+			}
+			close(sent)
+		}
+
+		var wg sync.WaitGroup
+
+		for i := range b {
+			wg.Add(1)
+
+			go func(elem *gethrpc.BatchElem) {
+				defer wg.Done()
+
+				reached := false
+				terminal := func(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+					mu.Lock()
+					if closed {
+						mu.Unlock()
+						return call(ctx, result, method, args...)
+					}
+
+					reached = true
+					idx := len(pending)
+					pending = append(pending, gethrpc.BatchElem{Method: method, Args: args, Result: result})
+					last := arrive()
+					mu.Unlock()
+
+					if last {
+						send()
+					}
+					<-sent
+
+					if batchErr != nil {
+						return batchErr
+					}
+					return pending[idx].Error
+				}
+
+				elem.Error = chainInterceptors(interceptors, terminal)(ctx, elem.Result, elem.Method, elem.Args...)
+
+				mu.Lock()
+				last := !reached && arrive()
+				mu.Unlock()
+
+				if last {
+					send()
+				}
+			}(&b[i])
+		}
+
+		wg.Wait()
+
+		return batchErr
+	}
+}
+
+// interceptedClient runs the calls, batches and subscriptions of the wrapped client through the interceptor chains
+type interceptedClient struct {
+	Client
+
+	invoke    Invoker
+	batch     batchCaller
+	subscribe Subscriber
+}
+
+func newInterceptedClient(c Client, opts *options) Client {
+	if opts.cache != nil {
+		c = NewCachingClient(c, opts.cache)
+	}
+
+	if len(opts.interceptors) == 0 && len(opts.subscribeInterceptors) == 0 {
+		return c
+	}
+
+	return &interceptedClient{
+		Client:    c,
+		invoke:    chainInterceptors(opts.interceptors, c.CallContext),
+		batch:     interceptBatch(opts.interceptors, c.BatchCallContext, c.CallContext),
+		subscribe: chainSubscribeInterceptors(opts.subscribeInterceptors, subscribeOn(c)),
+	}
+}
+
+// subscribeOn returns a subscriber that creates the subscription on c
+func subscribeOn(c Client) Subscriber {
+	return func(ctx context.Context, req SubscribeRequest) (*gethrpc.ClientSubscription, error) {
+		return c.Subscribe(ctx, req.Namespace, req.SubscribeMethodSuffix, req.UnsubscribeMethodSuffix,
+			req.NotificationMethodSuffix, req.Channel, req.Args...)
+	}
+}
+
+// Config returns the config of the wrapped client
+func (c *interceptedClient) Config() config.Config {
+	return ConfigOf(c.Client)
+}
+
+func (c *interceptedClient) Call(result interface{}, method string, args ...interface{}) error {
+	return c.invoke(context.Background(), result, method, args...)
+}
+
+func (c *interceptedClient) CallContext(ctx context.Context, result interface{}, method string,
+	args ...interface{}) error {
+	return c.invoke(ctx, result, method, args...)
+}
+
+func (c *interceptedClient) BatchCall(b []gethrpc.BatchElem) error {
+	return c.batch(context.Background(), b)
+}
+
+func (c *interceptedClient) BatchCallContext(ctx context.Context, b []gethrpc.BatchElem) error {
+	return c.batch(ctx, b)
+}
+
+func (c *interceptedClient) Subscribe(ctx context.Context, namespace, subscribeMethodSuffix,
+	unsubscribeMethodSuffix, notificationMethodSuffix string, channel interface{}, args ...interface{}) (
+	*gethrpc.ClientSubscription, error) {
+	return c.subscribe(ctx, SubscribeRequest{
+		Namespace:                namespace,
+		SubscribeMethodSuffix:    subscribeMethodSuffix,
+		UnsubscribeMethodSuffix:  unsubscribeMethodSuffix,
+		NotificationMethodSuffix: notificationMethodSuffix,
+		Channel:                  channel,
+		Args:                     args,
+	})
+}
+
+// LoggingInterceptor returns an interceptor that logs every call with its method, params and duration. Successful
+// calls are logged at debug level, failed calls at error level. Results are not logged, as they can be large, see
+// LoggingResultInterceptor.
+func LoggingInterceptor(logger *slog.Logger) Interceptor {
+	return loggingInterceptor(logger, 0)
+}
+
+// LoggingResultInterceptor is like LoggingInterceptor, but also logs the results of successful calls, formatted and
+// truncated to at most maxLen bytes. Results are only formatted if debug logging is enabled.
+func LoggingResultInterceptor(logger *slog.Logger, maxLen int) Interceptor {
+	return loggingInterceptor(logger, maxLen)
+}
+
+func loggingInterceptor(logger *slog.Logger, maxLen int) Interceptor {
+	return func(ctx context.Context, result interface{}, method string, args []interface{}, invoker Invoker) error {
+		start := time.Now()
+		err := invoker(ctx, result, method, args...)
+		duration := time.Since(start)
+
+		if err != nil {
+			logger.LogAttrs(ctx, slog.LevelError, "RPC call failed", slog.String("method", method),
+				slog.Any("params", args), slog.Duration("duration", duration), slog.Any("error", err))
+			return err
+		}
+
+		if !logger.Enabled(ctx, slog.LevelDebug) {
+			return nil
+		}
+
+		attrs := []slog.Attr{slog.String("method", method), slog.Any("params", args)}
+		if maxLen > 0 {
+			attrs = append(attrs, slog.String("result", truncate(fmt.Sprint(indirect(result)), maxLen)))
+		}
+		attrs = append(attrs, slog.Duration("duration", duration))
+
+		logger.LogAttrs(ctx, slog.LevelDebug, "RPC call", attrs...)
+
+		return nil
+	}
+}
+
+// truncate shortens s to at most maxLen bytes, marking it as truncated
+func truncate(s string, maxLen int) string {
+	if len(s) <= maxLen {
+		return s
+	}
+
+	return strings.ToValidUTF8(s[:maxLen], "") + "...(truncated)"
+}
+
+// indirect returns the value result points to, so that results are logged instead of their addresses
+func indirect(result interface{}) interface{} {
+	v := reflect.ValueOf(result)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return result
+	}
+
+	return v.Elem().Interface()
+}
+
+// LoggingSubscribeInterceptor returns a subscribe interceptor that logs the creation of every subscription. Successful
+// subscriptions are logged at debug level, failed ones at error level.
+func LoggingSubscribeInterceptor(logger *slog.Logger) SubscribeInterceptor {
+	return func(ctx context.Context, req SubscribeRequest, subscriber Subscriber) (*gethrpc.ClientSubscription,
+		error) {
+		start := time.Now()
+		sub, err := subscriber(ctx, req)
+		duration := time.Since(start)
+
+		if err != nil {
+			logger.LogAttrs(ctx, slog.LevelError, "RPC subscription failed", slog.String("method", req.Method()),
+				slog.Any("params", req.Args), slog.Duration("duration", duration), slog.Any("error", err))
+			return nil, err
+		}
+
+		logger.LogAttrs(ctx, slog.LevelDebug, "RPC subscription", slog.String("method", req.Method()),
+			slog.Any("params", req.Args), slog.Duration("duration", duration))
+
+		return sub, nil
+	}
+}
+
+// MethodStats contains the counters of a single RPC method
+type MethodStats struct {
+	Calls         uint64
+	Errors        uint64
+	TotalDuration time.Duration
+	MaxDuration   time.Duration
+}
+
+// AvgDuration returns the average duration of the calls
+func (s MethodStats) AvgDuration() time.Duration {
+	if s.Calls == 0 {
+		return 0
+	}
+
+	return s.TotalDuration / time.Duration(s.Calls)
+}
+
+// Metrics counts the calls, errors and latencies per RPC method. Its interceptors can be shared by multiple clients.
+type Metrics struct {
+	mu      sync.Mutex
+	methods map[string]*MethodStats
+}
+
+// NewMetrics creates empty metrics
+func NewMetrics() *Metrics {
+	return &Metrics{methods: make(map[string]*MethodStats)}
+}
+
+func (m *Metrics) record(method string, duration time.Duration, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stats, ok := m.methods[method]
+	if !ok {
+		stats = &MethodStats{}
+		m.methods[method] = stats
+	}
+
+	stats.Calls++
+	stats.TotalDuration += duration
+
+	if duration > stats.MaxDuration {
+		stats.MaxDuration = duration
+	}
+
+	if err != nil {
+		stats.Errors++
+	}
+}
+
+// Interceptor returns an interceptor that records the unary calls
+func (m *Metrics) Interceptor() Interceptor {
+	return func(ctx context.Context, result interface{}, method string, args []interface{}, invoker Invoker) error {
+		start := time.Now()
+		err := invoker(ctx, result, method, args...)
+		m.record(method, time.Since(start), err)
+
+		return err
+	}
+}
+
+// SubscribeInterceptor returns a subscribe interceptor that records the creation of subscriptions under the name of
+// the subscribe method
+func (m *Metrics) SubscribeInterceptor() SubscribeInterceptor {
+	return func(ctx context.Context, req SubscribeRequest, subscriber Subscriber) (*gethrpc.ClientSubscription,
+		error) {
+		start := time.Now()
+		sub, err := subscriber(ctx, req)
+		m.record(req.Method(), time.Since(start), err)
+
+		return sub, err
+	}
+}
+
+// Snapshot returns a copy of the counters of all methods that have been called
+func (m *Metrics) Snapshot() map[string]MethodStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	snapshot := make(map[string]MethodStats, len(m.methods))
+	for method, stats := range m.methods {
+		snapshot[method] = *stats
+	}
+
+	return snapshot
+}
+
+// Reset clears all counters
+func (m *Metrics) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.methods = make(map[string]*MethodStats)
+}
@@ -0,0 +1,244 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	gethrpc "github.com/centrifuge/go-substrate-rpc-client/v4/gethrpc"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/rpcmocksrv"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInterceptors_Order(t *testing.T) {
+	var calls []string
+
+	record := func(name string) Interceptor {
+		return func(ctx context.Context, result interface{}, method string, args []interface{},
+			invoker Invoker) error {
+			calls = append(calls, name+" before")
+			err := invoker(ctx, result, method, args...)
+			calls = append(calls, name+" after")
+			return err
+		}
+	}
+
+	invoker := chainInterceptors([]Interceptor{record("first"), record("second")},
+		func(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+			calls = append(calls, "call")
+			return nil
+		})
+
+	assert.NoError(t, invoker(context.Background(), nil, "test_echo"))
+	assert.Equal(t, []string{"first before", "second before", "call", "second after", "first after"}, calls)
+}
+
+func TestConnect_WithInterceptors(t *testing.T) {
+	s := rpcmocksrv.New()
+	defer s.Close()
+	assert.NoError(t, s.RegisterName("test", &echoService{}))
+
+	rewrite := func(ctx context.Context, result interface{}, method string, args []interface{},
+		invoker Invoker) error {
+		if method == "test_echo" {
+			args = []interface{}{args[0].(int) * 2}
+		}
+		return invoker(ctx, result, method, args...)
+	}
+
+	metrics := NewMetrics()
+
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	cl, err := Connect(s.URL, WithLogger(logger),
+		WithInterceptors(metrics.Interceptor(), LoggingInterceptor(logger), rewrite))
+	assert.NoError(t, err)
+	defer cl.Close()
+
+	var res int
+	assert.NoError(t, cl.Call(&res, "test_echo", 21))
+	assert.Equal(t, 42, res)
+
+	assert.Error(t, cl.Call(&res, "test_unknown"))
+
+	stats := metrics.Snapshot()
+	assert.Equal(t, uint64(1), stats["test_echo"].Calls)
+	assert.Equal(t, uint64(0), stats["test_echo"].Errors)
+	assert.Equal(t, uint64(1), stats["test_unknown"].Calls)
+	assert.Equal(t, uint64(1), stats["test_unknown"].Errors)
+	assert.True(t, stats["test_echo"].AvgDuration() > 0)
+
+	assert.Contains(t, logs.String(), "msg=Connecting url="+s.URL)
+	assert.Contains(t, logs.String(), "level=DEBUG msg=\"RPC call\" method=test_echo params=[21] duration=")
+	assert.Contains(t, logs.String(), "level=ERROR msg=\"RPC call failed\" method=test_unknown")
+
+	metrics.Reset()
+	assert.Empty(t, metrics.Snapshot())
+}
+
+func TestLoggingResultInterceptor(t *testing.T) {
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	invoker := func(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+		*result.(*string) = strings.Repeat("ab", 10)
+		return nil
+	}
+
+	var res string
+	assert.NoError(t, LoggingResultInterceptor(logger, 5)(context.Background(), &res, "test_long", nil, invoker))
+	assert.Contains(t, logs.String(), "method=test_long params=[] result=ababa...(truncated) duration=")
+
+	logs.Reset()
+	assert.NoError(t, LoggingResultInterceptor(logger, 100)(context.Background(), &res, "test_long", nil, invoker))
+	assert.Contains(t, logs.String(), "result="+res+" duration=")
+
+	// nothing is logged below debug level
+	logs.Reset()
+	logger = slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelInfo}))
+	assert.NoError(t, LoggingResultInterceptor(logger, 5)(context.Background(), &res, "test_long", nil, invoker))
+	assert.Empty(t, logs.String())
+}
+
+func TestInterceptBatch(t *testing.T) {
+	var batches [][]gethrpc.BatchElem
+	next := func(ctx context.Context, b []gethrpc.BatchElem) error {
+		batches = append(batches, b)
+		for i := range b {
+			*b[i].Result.(*int) = b[i].Args[0].(int)
+		}
+		return nil
+	}
+
+	var calls []string
+	call := func(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+		calls = append(calls, method)
+		*result.(*int) = args[0].(int)
+		return nil
+	}
+
+	interceptor := func(ctx context.Context, result interface{}, method string, args []interface{},
+		invoker Invoker) error {
+		switch method {
+		case "test_reject":
+			return errors.New("rejected")
+		case "test_retry":
+			if err := invoker(ctx, result, method, args...); err != nil {
+				return err
+			}
+			return invoker(ctx, result, method, args[0].(int)+1)
+		}
+		return invoker(ctx, result, method, args[0].(int)*2)
+	}
+
+	var echo, rejected, retried int
+	batch := []gethrpc.BatchElem{
+		{Method: "test_echo", Args: []interface{}{21}, Result: &echo},
+		{Method: "test_reject", Args: []interface{}{1}, Result: &rejected},
+		{Method: "test_retry", Args: []interface{}{1}, Result: &retried},
+	}
+
+	assert.NoError(t, interceptBatch([]Interceptor{interceptor}, next, call)(context.Background(), batch))
+
+	// the elements that reach the end of the chain are sent as a single batch, later calls one by one
+	assert.Len(t, batches, 1)
+	assert.Len(t, batches[0], 2)
+	assert.Equal(t, []string{"test_retry"}, calls)
+
+	assert.NoError(t, batch[0].Error)
+	assert.Equal(t, 42, echo)
+	assert.EqualError(t, batch[1].Error, "rejected")
+	assert.Equal(t, 0, rejected)
+	assert.NoError(t, batch[2].Error)
+	assert.Equal(t, 2, retried)
+}
+
+func TestConnect_WithInterceptors_BatchCall(t *testing.T) {
+	s := rpcmocksrv.New()
+	defer s.Close()
+	assert.NoError(t, s.RegisterName("test", &echoService{}))
+
+	metrics := NewMetrics()
+
+	cl, err := Connect(s.URL, WithInterceptors(metrics.Interceptor()))
+	assert.NoError(t, err)
+	defer cl.Close()
+
+	var a, b int
+	batch := []gethrpc.BatchElem{
+		{Method: "test_echo", Args: []interface{}{1}, Result: &a},
+		{Method: "test_echo", Args: []interface{}{2}, Result: &b},
+		{Method: "test_unknown"},
+	}
+
+	assert.NoError(t, BatchCallInChunks(context.Background(), cl, batch, 2))
+	assert.Equal(t, 1, a)
+	assert.Equal(t, 2, b)
+	assert.Error(t, batch[2].Error)
+
+	stats := metrics.Snapshot()
+	assert.Equal(t, uint64(2), stats["test_echo"].Calls)
+	assert.Equal(t, uint64(1), stats["test_unknown"].Calls)
+	assert.Equal(t, uint64(1), stats["test_unknown"].Errors)
+}
+
+func TestConnect_WithSubscribeInterceptors(t *testing.T) {
+	s := startHeadsServer(t, "")
+	defer s.Close()
+
+	metrics := NewMetrics()
+
+	var requests []SubscribeRequest
+	record := func(ctx context.Context, req SubscribeRequest, subscriber Subscriber) (*gethrpc.ClientSubscription,
+		error) {
+		requests = append(requests, req)
+		return subscriber(ctx, req)
+	}
+
+	reject := func(ctx context.Context, req SubscribeRequest, subscriber Subscriber) (*gethrpc.ClientSubscription,
+		error) {
+		if req.SubscribeMethodSuffix != "subscribeNewHead" {
+			return nil, errors.New("rejected")
+		}
+		return subscriber(ctx, req)
+	}
+
+	cl, err := Connect(s.URL, WithSubscribeInterceptors(metrics.SubscribeInterceptor(), record, reject))
+	assert.NoError(t, err)
+	defer cl.Close()
+
+	ch := make(chan types.Header)
+	sub, err := cl.Subscribe(context.Background(), "chain", "subscribeNewHead", "unsubscribeNewHead", "newHead", ch)
+	assert.NoError(t, err)
+	<-ch
+	sub.Unsubscribe()
+
+	_, err = cl.Subscribe(context.Background(), "chain", "subscribeOther", "unsubscribeOther", "other", ch)
+	assert.EqualError(t, err, "rejected")
+
+	assert.Len(t, requests, 2)
+	assert.Equal(t, "chain_subscribeNewHead", requests[0].Method())
+	assert.Equal(t, uint64(1), metrics.Snapshot()["chain_subscribeNewHead"].Calls)
+	assert.Equal(t, uint64(1), metrics.Snapshot()["chain_subscribeOther"].Errors)
+}
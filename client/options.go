@@ -0,0 +1,200 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"crypto/tls"
+	"log/slog"
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/config"
+	gethrpc "github.com/centrifuge/go-substrate-rpc-client/v4/gethrpc"
+)
+
+// Option configures a client at connect time. Options are applied in order, later options override earlier ones.
+type Option func(o *options)
+
+type options struct {
+	cfg       config.Config
+	tlsConfig *tls.Config
+
+	logger                *slog.Logger
+	interceptors          []Interceptor
+	subscribeInterceptors []SubscribeInterceptor
+	cache                 Cache
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{
+		cfg:    config.Default(),
+		logger: slog.Default(),
+	}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return o
+}
+
+// WithConfig replaces the config of the client, which defaults to config.Default(). The RPCURL of the config is not
+// used, the url is passed to Connect instead.
+func WithConfig(cfg config.Config) Option {
+	return func(o *options) {
+		o.cfg = cfg
+	}
+}
+
+// WithDialTimeout sets the timeout of establishing the connection
+func WithDialTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.cfg.DialTimeout = timeout
+	}
+}
+
+// WithCallTimeout sets the timeout of calls that are made without a context or with a context without deadline, 0
+// means no limit
+func WithCallTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.cfg.CallTimeout = timeout
+	}
+}
+
+// WithSubscribeTimeout sets the timeout of subscription requests that the RPC modules make without a context
+func WithSubscribeTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.cfg.SubscribeTimeout = timeout
+	}
+}
+
+// WithRetry sets how often establishing the connection is attempted
+func WithRetry(retry config.Retry) Option {
+	return func(o *options) {
+		o.cfg.Retry = retry
+	}
+}
+
+// WithHeader adds a header that is sent along with HTTP requests and the websocket handshake
+func WithHeader(key, value string) Option {
+	return func(o *options) {
+		headers := make(map[string]string, len(o.cfg.Headers)+1)
+		for k, v := range o.cfg.Headers {
+			headers[k] = v
+		}
+		headers[key] = value
+
+		o.cfg.Headers = headers
+	}
+}
+
+// WithBearerToken sets the token that is sent as the Authorization header
+func WithBearerToken(token string) Option {
+	return func(o *options) {
+		o.cfg.BearerToken = token
+	}
+}
+
+// WithTLSConfig sets the TLS config of secure connections, overriding the TLS settings of the config
+func WithTLSConfig(tlsConfig *tls.Config) Option {
+	return func(o *options) {
+		o.tlsConfig = tlsConfig
+	}
+}
+
+// WithWebsocketReadLimit sets the maximum size of a websocket message read from the node in bytes
+func WithWebsocketReadLimit(limit int64) Option {
+	return func(o *options) {
+		o.cfg.WSReadLimit = limit
+	}
+}
+
+// WithSubscriptionBufferSize sets the capacity of the notification channels created by the RPC modules
+func WithSubscriptionBufferSize(size int) Option {
+	return func(o *options) {
+		o.cfg.SubscriptionBufferSize = size
+	}
+}
+
+// WithSubscriptionOverflowPolicy sets what happens to notifications when a notification channel created by the RPC
+// modules is full
+func WithSubscriptionOverflowPolicy(policy config.OverflowPolicy) Option {
+	return func(o *options) {
+		o.cfg.SubscriptionOverflowPolicy = policy
+	}
+}
+
+// dialOptions returns the transport options of the connection
+func (o *options) dialOptions() ([]gethrpc.ClientOption, error) {
+	var opts []gethrpc.ClientOption
+
+	for k, v := range o.cfg.Headers {
+		opts = append(opts, gethrpc.WithHeader(k, v))
+	}
+
+	if o.cfg.BearerToken != "" {
+		opts = append(opts, gethrpc.WithHeader("Authorization", "Bearer "+o.cfg.BearerToken))
+	}
+
+	tlsConfig := o.tlsConfig
+	if tlsConfig == nil {
+		var err error
+		if tlsConfig, err = o.cfg.TLS.ClientConfig(); err != nil {
+			return nil, err
+		}
+	}
+
+	if tlsConfig != nil {
+		opts = append(opts, gethrpc.WithTLSConfig(tlsConfig))
+	}
+
+	if o.cfg.WSReadLimit > 0 {
+		opts = append(opts, gethrpc.WithWebsocketMessageSizeLimit(o.cfg.WSReadLimit))
+	}
+
+	return opts, nil
+}
+
+// WithLogger sets the logger the client reports connection events to, instead of the default slog logger
+func WithLogger(logger *slog.Logger) Option {
+	return func(o *options) {
+		o.logger = logger
+	}
+}
+
+// WithInterceptors appends interceptors to the chain that all unary calls of the client run through, including every
+// element of batch calls. The first interceptor is the outermost one.
+func WithInterceptors(interceptors ...Interceptor) Option {
+	return func(o *options) {
+		o.interceptors = append(o.interceptors, interceptors...)
+	}
+}
+
+// WithSubscribeInterceptors appends interceptors to the chain that the creation of all subscriptions of the client
+// runs through. The first interceptor is the outermost one.
+func WithSubscribeInterceptors(interceptors ...SubscribeInterceptor) Option {
+	return func(o *options) {
+		o.subscribeInterceptors = append(o.subscribeInterceptors, interceptors...)
+	}
+}
+
+// WithCache enables caching the responses of calls that are pinned to a block hash, see NewCachingClient. The cache
+// sits below the interceptors, so interceptors see cached calls as well.
+func WithCache(cache Cache) Option {
+	return func(o *options) {
+		o.cache = cache
+	}
+}
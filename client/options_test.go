@@ -0,0 +1,155 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/config"
+	gethrpc "github.com/centrifuge/go-substrate-rpc-client/v4/gethrpc"
+	"github.com/stretchr/testify/assert"
+)
+
+type optionsService struct{}
+
+func (optionsService) Echo(s string) string {
+	return s
+}
+
+func (optionsService) Sleep(ctx context.Context, d time.Duration) error {
+	select {
+	case <-time.After(d):
+	case <-ctx.Done():
+	}
+	return nil
+}
+
+// startHeaderServer starts a websocket server that records the headers of the handshake
+func startHeaderServer(t *testing.T) (url string, headers func() http.Header) {
+	srv := gethrpc.NewServer()
+	assert.NoError(t, srv.RegisterName("test", optionsService{}))
+
+	var (
+		mu     sync.Mutex
+		header http.Header
+	)
+
+	ws := srv.WebsocketHandler([]string{"*"})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		header = r.Header.Clone()
+		mu.Unlock()
+		ws.ServeHTTP(w, r)
+	}))
+
+	t.Cleanup(func() {
+		ts.Close()
+		srv.Stop()
+	})
+
+	return "ws" + strings.TrimPrefix(ts.URL, "http"), func() http.Header {
+		mu.Lock()
+		defer mu.Unlock()
+		return header
+	}
+}
+
+func TestConnect_WithHeaders(t *testing.T) {
+	url, headers := startHeaderServer(t)
+
+	cl, err := Connect(url, WithHeader("X-Api-Key", "secret"), WithBearerToken("token"))
+	assert.NoError(t, err)
+	defer cl.Close()
+
+	var res string
+	assert.NoError(t, cl.Call(&res, "test_echo", "hello"))
+	assert.Equal(t, "hello", res)
+
+	assert.Equal(t, "secret", headers().Get("X-Api-Key"))
+	assert.Equal(t, "Bearer token", headers().Get("Authorization"))
+}
+
+func TestConnect_WithCallTimeout(t *testing.T) {
+	url, _ := startHeaderServer(t)
+
+	cl, err := Connect(url, WithCallTimeout(50*time.Millisecond))
+	assert.NoError(t, err)
+	defer cl.Close()
+
+	err = cl.Call(nil, "test_sleep", time.Second)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), err)
+
+	// A deadline of the caller takes precedence
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	assert.NoError(t, cl.CallContext(ctx, nil, "test_sleep", 100*time.Millisecond))
+}
+
+func TestConnect_WithWebsocketReadLimit(t *testing.T) {
+	url, _ := startHeaderServer(t)
+
+	cl, err := Connect(url, WithWebsocketReadLimit(128))
+	assert.NoError(t, err)
+	defer cl.Close()
+
+	var res string
+	assert.NoError(t, cl.Call(&res, "test_echo", "small"))
+	assert.Error(t, cl.Call(&res, "test_echo", strings.Repeat("x", 256)))
+}
+
+func TestConnect_WithRetry(t *testing.T) {
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, nil))
+
+	_, err := Connect("ws://127.0.0.1:1", WithLogger(logger),
+		WithRetry(config.Retry{MaxAttempts: 3, InitialBackoff: time.Millisecond}))
+	assert.Error(t, err)
+	assert.Equal(t, 3, strings.Count(logs.String(), "msg=Connecting "))
+	assert.Equal(t, 2, strings.Count(logs.String(), "msg=\"Connecting failed, retrying\""))
+}
+
+func TestConnect_ConfigPerClient(t *testing.T) {
+	url, _ := startHeaderServer(t)
+
+	cfg := config.Default()
+	cfg.SubscribeTimeout = time.Minute
+	cfg.SubscriptionBufferSize = 16
+
+	a, err := Connect(url, WithConfig(cfg), WithCache(NewLRUCache(1024)))
+	assert.NoError(t, err)
+	defer a.Close()
+
+	b, err := Connect(url, WithSubscribeTimeout(time.Second))
+	assert.NoError(t, err)
+	defer b.Close()
+
+	assert.Equal(t, time.Minute, ConfigOf(a).SubscribeTimeout)
+	assert.Equal(t, 16, ConfigOf(a).SubscriptionBufferSize)
+	assert.Equal(t, time.Second, ConfigOf(b).SubscribeTimeout)
+	assert.Equal(t, 0, ConfigOf(b).SubscriptionBufferSize)
+
+	assert.Equal(t, config.Default(), ConfigOf(NewReplayer(&Cassette{})))
+}
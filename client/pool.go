@@ -0,0 +1,732 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/config"
+	libErr "github.com/centrifuge/go-substrate-rpc-client/v4/error"
+	gethrpc "github.com/centrifuge/go-substrate-rpc-client/v4/gethrpc"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+const (
+	ErrNoEndpoints       = libErr.Error("no endpoints provided")
+	ErrNoNodeAvailable   = libErr.Error("no node available")
+	ErrBlockNotAvailable = libErr.Error("block not available on any node")
+)
+
+const (
+	defaultHealthCheckInterval = 10 * time.Second
+	maxKnownBlocksPerNode      = 1024
+)
+
+// NodeStatus is the state of a single endpoint of a pool, as determined by the last health check
+type NodeStatus struct {
+	URL string
+	// Healthy is true if the node is reachable, is not syncing, has peers if it should have any and does not lag
+	// behind the best block of the other nodes by more than the configured lag
+	Healthy   bool
+	Peers     uint64
+	IsSyncing bool
+	BestBlock uint64
+	// Latency is the round trip time of the last health check
+	Latency time.Duration
+	// Err is the error of the last health check or failed call, if any
+	Err error
+}
+
+// Policy decides in which order the healthy nodes of a pool are tried
+type Policy interface {
+	// Order returns the nodes in the order they should be tried
+	Order(nodes []NodeStatus) []NodeStatus
+}
+
+type roundRobin struct {
+	next uint64
+}
+
+// NewRoundRobinPolicy returns a policy that spreads the calls evenly over the healthy nodes
+func NewRoundRobinPolicy() Policy {
+	return &roundRobin{}
+}
+
+func (r *roundRobin) Order(nodes []NodeStatus) []NodeStatus {
+	if len(nodes) == 0 {
+		return nodes
+	}
+
+	start := int(atomic.AddUint64(&r.next, 1)-1) % len(nodes)
+
+	return append(append([]NodeStatus{}, nodes[start:]...), nodes[:start]...)
+}
+
+type leastLatency struct{}
+
+// NewLeastLatencyPolicy returns a policy that prefers the nodes with the lowest latency in the last health check
+func NewLeastLatencyPolicy() Policy {
+	return leastLatency{}
+}
+
+func (leastLatency) Order(nodes []NodeStatus) []NodeStatus {
+	ordered := append([]NodeStatus{}, nodes...)
+
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].Latency < ordered[j].Latency
+	})
+
+	return ordered
+}
+
+// PoolConfig configures the health checks and node selection of a pool
+type PoolConfig struct {
+	// HealthCheckInterval is the time between two health checks of all nodes
+	HealthCheckInterval time.Duration
+	// HealthCheckTimeout bounds the calls of a single health check
+	HealthCheckTimeout time.Duration
+	// MaxBlockLag is the number of blocks a node may lag behind the best node before it is considered unhealthy
+	MaxBlockLag uint64
+	// Policy decides which healthy node is tried first
+	Policy Policy
+}
+
+// DefaultPoolConfig returns a config that checks the nodes every 10s, tolerates a lag of 5 blocks and distributes the
+// calls round-robin
+func DefaultPoolConfig() PoolConfig {
+	return PoolConfig{
+		HealthCheckInterval: defaultHealthCheckInterval,
+		HealthCheckTimeout:  5 * time.Second,
+		MaxBlockLag:         5,
+		Policy:              NewRoundRobinPolicy(),
+	}
+}
+
+// Pool is a client that distributes calls over multiple nodes
+type Pool interface {
+	Client
+
+	// Status returns the status of all nodes, in the order the endpoints were provided
+	Status() []NodeStatus
+
+	// CheckHealth checks all nodes immediately, without waiting for the next scheduled health check
+	CheckHealth(ctx context.Context)
+}
+
+type pool struct {
+	nodes []*poolNode
+	cfg   PoolConfig
+	opts  *options
+
+	invoke    Invoker
+	batch     batchCaller
+	subscribe Subscriber
+
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+// ConnectPool connects to all provided urls and returns a client that sends calls to the healthy nodes. A node is
+// healthy if it is not syncing, has peers and does not lag behind the other nodes, see NodeStatus. Calls failing
+// because of the connection are retried on the next node.
+//
+// Calls that are pinned to a block, such as state_getStorage with a block hash, are only sent to nodes that have
+// that block, which allows mixing archive and pruned nodes. Calls that need the state of the block, which is all calls
+// except chain_*, are only sent to nodes that have not pruned it. Subscriptions are created on a single node and are not
+// moved to another node when that node fails.
+//
+// ConnectPool fails only if none of the urls can be connected to. Unreachable nodes are dialed again on every health
+// check. Interceptors run once per call, not per node that is tried, and do not see the health checks.
+func ConnectPool(urls []string, cfg PoolConfig, opts ...Option) (Pool, error) {
+	if len(urls) == 0 {
+		return nil, ErrNoEndpoints
+	}
+
+	if cfg.Policy == nil {
+		cfg.Policy = NewRoundRobinPolicy()
+	}
+
+	if cfg.HealthCheckInterval <= 0 {
+		cfg.HealthCheckInterval = defaultHealthCheckInterval
+	}
+
+	p := &pool{
+		cfg:    cfg,
+		opts:   newOptions(opts),
+		closed: make(chan struct{}),
+	}
+	invoke, batch := Invoker(p.call), batchCaller(p.batchCall)
+
+	if p.opts.cache != nil {
+		invoke = cachingInvoker(p.opts.cache, p.call)
+		batch = cachingBatchCaller(p.opts.cache, p.batchCall)
+	}
+
+	p.invoke = chainInterceptors(p.opts.interceptors, invoke)
+	p.batch = interceptBatch(p.opts.interceptors, batch, invoke)
+
+	p.subscribe = chainSubscribeInterceptors(p.opts.subscribeInterceptors, p.subscribeOnNode)
+
+	var dialErr error
+
+	for _, url := range urls {
+		n := &poolNode{
+			status: NodeStatus{URL: url},
+			blocks: make(map[string]bool),
+		}
+
+		n.client, dialErr = dial(url, p.opts)
+		if dialErr != nil {
+			n.status.Err = dialErr
+		}
+
+		p.nodes = append(p.nodes, n)
+	}
+
+	if p.connected() == 0 {
+		return nil, ErrNoNodeAvailable.Wrap(dialErr)
+	}
+
+	p.CheckHealth(context.Background())
+
+	go p.run()
+
+	return p, nil
+}
+
+func (p *pool) run() {
+	ticker := time.NewTicker(p.cfg.HealthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			p.CheckHealth(context.Background())
+		case <-p.closed:
+			return
+		}
+	}
+}
+
+func (p *pool) connected() int {
+	var count int
+
+	for _, n := range p.nodes {
+		if n.conn() != nil {
+			count++
+		}
+	}
+
+	return count
+}
+
+// CheckHealth checks all nodes concurrently and updates their status
+func (p *pool) CheckHealth(ctx context.Context) {
+	previous := make([]bool, len(p.nodes))
+	for i, status := range p.Status() {
+		previous[i] = status.Healthy
+	}
+
+	var wg sync.WaitGroup
+
+	for _, n := range p.nodes {
+		wg.Add(1)
+
+		go func(n *poolNode) {
+			defer wg.Done()
+
+			n.check(ctx, p.cfg.HealthCheckTimeout, p.opts)
+		}(n)
+	}
+
+	wg.Wait()
+
+	var best uint64
+
+	for _, n := range p.nodes {
+		n.mu.RLock()
+		if n.status.Err == nil && n.status.BestBlock > best {
+			best = n.status.BestBlock
+		}
+		n.mu.RUnlock()
+	}
+
+	for i, n := range p.nodes {
+		n.mu.Lock()
+		if n.status.Healthy && best-n.status.BestBlock > p.cfg.MaxBlockLag {
+			n.status.Healthy = false
+		}
+		status := n.status
+		n.mu.Unlock()
+
+		if status.Healthy != previous[i] {
+			p.opts.logger.Info("Node health changed", "url", status.URL, "healthy", status.Healthy,
+				"bestBlock", status.BestBlock, "isSyncing", status.IsSyncing, "error", status.Err)
+		}
+	}
+}
+
+// Status returns the status of all nodes, in the order the endpoints were provided
+func (p *pool) Status() []NodeStatus {
+	status := make([]NodeStatus, len(p.nodes))
+
+	for i, n := range p.nodes {
+		n.mu.RLock()
+		status[i] = n.status
+		n.mu.RUnlock()
+	}
+
+	return status
+}
+
+// candidates returns the nodes to try in order. If no node is healthy, all connected nodes are returned, since a
+// degraded node is still better than none.
+func (p *pool) candidates() []*poolNode {
+	byURL := make(map[string]*poolNode, len(p.nodes))
+
+	var healthy []NodeStatus
+
+	for _, n := range p.nodes {
+		n.mu.RLock()
+		if n.status.Healthy && n.client != nil {
+			healthy = append(healthy, n.status)
+			byURL[n.status.URL] = n
+		}
+		n.mu.RUnlock()
+	}
+
+	if len(healthy) == 0 {
+		var connected []*poolNode
+
+		for _, n := range p.nodes {
+			if n.conn() != nil {
+				connected = append(connected, n)
+			}
+		}
+
+		return connected
+	}
+
+	ordered := p.cfg.Policy.Order(healthy)
+
+	nodes := make([]*poolNode, 0, len(ordered))
+	for _, s := range ordered {
+		if n, ok := byURL[s.URL]; ok {
+			nodes = append(nodes, n)
+		}
+	}
+
+	return nodes
+}
+
+// Call makes the call to RPC method with the provided args on a healthy node
+func (p *pool) Call(result interface{}, method string, args ...interface{}) error {
+	return p.invoke(context.Background(), result, method, args...)
+}
+
+// CallContext makes the call to RPC method with the provided args on a healthy node, failing over to the next node
+// if the connection fails. The call is aborted when ctx is done.
+func (p *pool) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	return p.invoke(ctx, result, method, args...)
+}
+
+func (p *pool) call(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	blockHash, pinned := pinnedBlockHash(method, args)
+
+	var lastErr error = ErrNoNodeAvailable
+
+	for _, n := range p.candidates() {
+		cl := n.conn()
+		if cl == nil {
+			continue
+		}
+
+		if pinned {
+			ok, err := n.hasBlocks(ctx, []pinnedBlock{{hash: blockHash, state: needsState(method)}})
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			if isConnectionError(err) {
+				n.fail(err)
+				lastErr = err
+
+				continue
+			}
+
+			if !ok {
+				lastErr = ErrBlockNotAvailable.WithMsg("%s", blockHash)
+				continue
+			}
+		}
+
+		err := cl.CallContext(ctx, result, method, args...)
+		if !isConnectionError(err) {
+			return err
+		}
+
+		n.fail(err)
+		lastErr = err
+	}
+
+	return lastErr
+}
+
+// BatchCall sends the requests as a single batch to a healthy node
+func (p *pool) BatchCall(b []gethrpc.BatchElem) error {
+	return p.BatchCallContext(context.Background(), b)
+}
+
+// BatchCallContext sends the requests as a single batch to a healthy node that has all blocks the requests are
+// pinned to, failing over to the next node if the connection fails. The batch is aborted when ctx is done.
+func (p *pool) BatchCallContext(ctx context.Context, b []gethrpc.BatchElem) error {
+	return p.batch(ctx, b)
+}
+
+func (p *pool) batchCall(ctx context.Context, b []gethrpc.BatchElem) error {
+	var blocks []pinnedBlock
+
+	for _, elem := range b {
+		if blockHash, ok := pinnedBlockHash(elem.Method, elem.Args); ok {
+			blocks = append(blocks, pinnedBlock{hash: blockHash, state: needsState(elem.Method)})
+		}
+	}
+
+	var lastErr error = ErrNoNodeAvailable
+
+	for _, n := range p.candidates() {
+		cl := n.conn()
+		if cl == nil {
+			continue
+		}
+
+		ok, err := n.hasBlocks(ctx, blocks)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if isConnectionError(err) {
+			n.fail(err)
+			lastErr = err
+
+			continue
+		}
+
+		if !ok {
+			lastErr = ErrBlockNotAvailable
+			continue
+		}
+
+		err = cl.BatchCallContext(ctx, b)
+		if !isConnectionError(err) {
+			return err
+		}
+
+		n.fail(err)
+		lastErr = err
+	}
+
+	return lastErr
+}
+
+// Subscribe creates the subscription on a healthy node, failing over to the next node if the connection fails
+func (p *pool) Subscribe(ctx context.Context, namespace, subscribeMethodSuffix, unsubscribeMethodSuffix,
+	notificationMethodSuffix string, channel interface{}, args ...interface{}) (*gethrpc.ClientSubscription, error) {
+	return p.subscribe(ctx, SubscribeRequest{
+		Namespace:                namespace,
+		SubscribeMethodSuffix:    subscribeMethodSuffix,
+		UnsubscribeMethodSuffix:  unsubscribeMethodSuffix,
+		NotificationMethodSuffix: notificationMethodSuffix,
+		Channel:                  channel,
+		Args:                     args,
+	})
+}
+
+func (p *pool) subscribeOnNode(ctx context.Context, req SubscribeRequest) (*gethrpc.ClientSubscription, error) {
+	var lastErr error = ErrNoNodeAvailable
+
+	for _, n := range p.candidates() {
+		cl := n.conn()
+		if cl == nil {
+			continue
+		}
+
+		sub, err := subscribeOn(cl)(ctx, req)
+		if !isConnectionError(err) {
+			return sub, err
+		}
+
+		n.fail(err)
+		lastErr = err
+	}
+
+	return nil, lastErr
+}
+
+// Config returns the config the nodes were connected with
+func (p *pool) Config() config.Config {
+	return p.opts.cfg
+}
+
+// URL returns the URL of the node that is currently tried first
+func (p *pool) URL() string {
+	if nodes := p.candidates(); len(nodes) > 0 {
+		return nodes[0].url()
+	}
+
+	return p.nodes[0].url()
+}
+
+// Close stops the health checks and closes the connections to all nodes
+func (p *pool) Close() {
+	p.closeOnce.Do(func() {
+		close(p.closed)
+	})
+
+	for _, n := range p.nodes {
+		if cl := n.conn(); cl != nil {
+			cl.Close()
+		}
+	}
+}
+
+type poolNode struct {
+	mu     sync.RWMutex
+	client *client
+	status NodeStatus
+	// blocks contains the hashes of blocks the node is known to have, mapped to whether it is known to have their state
+	blocks map[string]bool
+}
+
+// pinnedBlock is a block a call is pinned to
+type pinnedBlock struct {
+	hash string
+	// state is set if the call needs the state of the block, not just its header
+	state bool
+}
+
+// needsState reports whether the method needs the state of the block it is pinned to. Pruned nodes keep the headers
+// and bodies of old blocks, but discard their state.
+func needsState(method string) bool {
+	return !strings.HasPrefix(method, "chain_")
+}
+
+func (n *poolNode) url() string {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	return n.status.URL
+}
+
+func (n *poolNode) conn() *client {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	return n.client
+}
+
+// fail marks the node as unhealthy until the next health check
+func (n *poolNode) fail(err error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.status.Healthy = false
+	n.status.Err = err
+}
+
+// check dials the node if it is not connected and updates its status with its health and best block
+func (n *poolNode) check(ctx context.Context, timeout time.Duration, opts *options) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	cl := n.conn()
+	if cl == nil {
+		var err error
+
+		cl, err = dial(n.url(), opts)
+		if err != nil {
+			n.fail(err)
+			return
+		}
+
+		n.mu.Lock()
+		n.client = cl
+		n.mu.Unlock()
+	}
+
+	var health types.Health
+
+	start := time.Now()
+
+	if err := cl.CallContext(ctx, &health, "system_health"); err != nil {
+		n.fail(err)
+		return
+	}
+
+	latency := time.Since(start)
+
+	var header types.Header
+
+	if err := cl.CallContext(ctx, &header, "chain_getHeader"); err != nil {
+		n.fail(err)
+		return
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.status.Peers = uint64(health.Peers)
+	n.status.IsSyncing = health.IsSyncing
+	n.status.BestBlock = uint64(header.Number)
+	n.status.Latency = latency
+	n.status.Err = nil
+	n.status.Healthy = !health.IsSyncing && (health.Peers > 0 || !health.ShouldHavePeers)
+}
+
+// hasBlocks reports whether the node has all given blocks
+func (n *poolNode) hasBlocks(ctx context.Context, blocks []pinnedBlock) (bool, error) {
+	for _, b := range blocks {
+		ok, err := n.hasBlock(ctx, b)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// hasBlock reports whether the node has the given block, and its state if required. The header is looked up with
+// chain_getHeader, the state by querying the runtime version at the block, which fails if the state was pruned.
+func (n *poolNode) hasBlock(ctx context.Context, b pinnedBlock) (bool, error) {
+	n.mu.RLock()
+	state, ok := n.blocks[b.hash]
+	n.mu.RUnlock()
+
+	if ok && (state || !b.state) {
+		return true, nil
+	}
+
+	cl := n.conn()
+
+	if !ok {
+		var header *types.Header
+
+		if err := cl.CallContext(ctx, &header, "chain_getHeader", b.hash); err != nil {
+			return false, err
+		}
+
+		if header == nil {
+			return false, nil
+		}
+	}
+
+	if b.state {
+		var version json.RawMessage
+
+		err := cl.CallContext(ctx, &version, "state_getRuntimeVersion", b.hash)
+		if isConnectionError(err) {
+			return false, err
+		}
+
+		state = err == nil
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if len(n.blocks) >= maxKnownBlocksPerNode {
+		n.blocks = make(map[string]bool)
+	}
+
+	n.blocks[b.hash] = n.blocks[b.hash] || state
+
+	return !b.state || state, nil
+}
+
+// blockHashArgs maps the RPC methods that accept a block hash to the position of the block hash in their arguments. A
+// call is only pinned to a block if the argument is present, most methods use the latest block otherwise. For
+// state_queryStorage this is the start block, which the node needs to have in any case.
+var blockHashArgs = map[string]int{
+	"chain_getBlock":            0,
+	"chain_getHeader":           0,
+	"childstate_getKeysPaged":   4,
+	"kate_blockLength":          0,
+	"kate_queryDataProof":       1,
+	"kate_queryProof":           1,
+	"kate_queryRows":            1,
+	"mmr_root":                  0,
+	"payment_queryFeeDetails":   1,
+	"payment_queryInfo":         1,
+	"state_call":                2,
+	"state_getChildKeys":        2,
+	"state_getChildReadProof":   2,
+	"state_getChildStorage":     2,
+	"state_getChildStorageHash": 2,
+	"state_getChildStorageSize": 2,
+	"state_getKeys":             1,
+	"state_getKeysPaged":        3,
+	"state_getMetadata":         0,
+	"state_getReadProof":        1,
+	"state_getRuntimeVersion":   0,
+	"state_getStorage":          1,
+	"state_getStorageHash":      1,
+	"state_getStoragePaged":     3,
+	"state_getStorageSize":      1,
+	"state_queryStorage":        1,
+	"state_queryStorageAt":      1,
+	"state_traceBlock":          0,
+}
+
+// blockHashArgsByCount maps the RPC methods whose block hash position depends on the number of arguments to the
+// position of the block hash for each argument count. mmr_generateProof takes a leaf index and a block hash, or block
+// numbers, the best known block number and a block hash.
+var blockHashArgsByCount = map[string]map[int]int{
+	"mmr_generateProof": {2: 1, 3: 2},
+}
+
+// blockHashArg returns the position of the block hash in the arguments of the call
+func blockHashArg(method string, args []interface{}) (int, bool) {
+	if byCount, ok := blockHashArgsByCount[method]; ok {
+		pos, ok := byCount[len(args)]
+		return pos, ok
+	}
+
+	pos, ok := blockHashArgs[method]
+
+	return pos, ok
+}
+
+// pinnedBlockHash returns the block hash the call is pinned to, if any
+func pinnedBlockHash(method string, args []interface{}) (string, bool) {
+	pos, ok := blockHashArg(method, args)
+	if !ok || len(args) <= pos {
+		return "", false
+	}
+
+	blockHash, ok := args[pos].(string)
+
+	return blockHash, ok
+}
@@ -0,0 +1,319 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	gethrpc "github.com/centrifuge/go-substrate-rpc-client/v4/gethrpc"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/rpcmocksrv"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type poolSystemService struct {
+	health types.Health
+}
+
+func (s *poolSystemService) Health() types.Health {
+	return s.health
+}
+
+type poolChainService struct {
+	name   string
+	best   types.BlockNumber
+	blocks map[string]bool
+	// pruned contains the blocks the node has discarded the state of
+	pruned map[string]bool
+}
+
+func (s *poolChainService) GetHeader(blockHash *string) *types.Header {
+	if blockHash != nil && !s.blocks[*blockHash] {
+		return nil
+	}
+	return &types.Header{Number: s.best}
+}
+
+func (s *poolChainService) GetName() string {
+	return s.name
+}
+
+// poolStateService returns the name of the node as storage, which tells which node served a call
+type poolStateService struct {
+	chain *poolChainService
+}
+
+func (s *poolStateService) GetStorage(key string, blockHash *string) string {
+	return s.chain.name
+}
+
+func (s *poolStateService) GetRuntimeVersion(blockHash *string) (*types.RuntimeVersion, error) {
+	if blockHash != nil && (!s.chain.blocks[*blockHash] || s.chain.pruned[*blockHash]) {
+		return nil, fmt.Errorf("state already discarded for %s", *blockHash)
+	}
+	return &types.RuntimeVersion{SpecName: s.chain.name}, nil
+}
+
+// poolMMRService returns the name of the node as batch proof
+type poolMMRService struct {
+	chain *poolChainService
+}
+
+func (s *poolMMRService) GenerateProof(blockNumbers []uint32, bestKnownBlockNumber *uint32, blockHash *string) string {
+	return s.chain.name
+}
+
+type poolNodeMock struct {
+	*rpcmocksrv.Server
+
+	system *poolSystemService
+	chain  *poolChainService
+}
+
+func startPoolNode(t *testing.T, name string, best types.BlockNumber, blocks ...string) *poolNodeMock {
+	n := &poolNodeMock{
+		Server: rpcmocksrv.New(),
+		system: &poolSystemService{health: types.Health{Peers: 3, ShouldHavePeers: true}},
+		chain: &poolChainService{
+			name:   name,
+			best:   best,
+			blocks: make(map[string]bool),
+			pruned: make(map[string]bool),
+		},
+	}
+	for _, b := range blocks {
+		n.chain.blocks[b] = true
+	}
+	assert.NoError(t, n.RegisterName("system", n.system))
+	assert.NoError(t, n.RegisterName("chain", n.chain))
+	assert.NoError(t, n.RegisterName("state", &poolStateService{n.chain}))
+	assert.NoError(t, n.RegisterName("mmr", &poolMMRService{n.chain}))
+	return n
+}
+
+// inOrder always tries the nodes in the order the endpoints were provided
+type inOrder struct{}
+
+func (inOrder) Order(nodes []NodeStatus) []NodeStatus {
+	return nodes
+}
+
+func testPoolConfig(policy Policy) PoolConfig {
+	return PoolConfig{
+		HealthCheckInterval: time.Hour,
+		HealthCheckTimeout:  time.Second,
+		MaxBlockLag:         5,
+		Policy:              policy,
+	}
+}
+
+func TestPool_SkipsUnhealthyNodes(t *testing.T) {
+	syncing := startPoolNode(t, "syncing", 100)
+	syncing.system.health.IsSyncing = true
+	defer syncing.Close()
+
+	lagging := startPoolNode(t, "lagging", 90)
+	defer lagging.Close()
+
+	noPeers := startPoolNode(t, "noPeers", 100)
+	noPeers.system.health.Peers = 0
+	defer noPeers.Close()
+
+	healthy := startPoolNode(t, "healthy", 100)
+	defer healthy.Close()
+
+	p, err := ConnectPool([]string{syncing.URL, lagging.URL, noPeers.URL, healthy.URL},
+		testPoolConfig(NewRoundRobinPolicy()))
+	assert.NoError(t, err)
+	defer p.Close()
+
+	status := p.Status()
+	assert.False(t, status[0].Healthy)
+	assert.True(t, status[0].IsSyncing)
+	assert.False(t, status[1].Healthy)
+	assert.Equal(t, uint64(90), status[1].BestBlock)
+	assert.False(t, status[2].Healthy)
+	assert.True(t, status[3].Healthy)
+
+	for i := 0; i < 5; i++ {
+		var name string
+		assert.NoError(t, p.Call(&name, "chain_getName"))
+		assert.Equal(t, "healthy", name)
+	}
+}
+
+func TestPool_FailsOver(t *testing.T) {
+	first := startPoolNode(t, "first", 100)
+	second := startPoolNode(t, "second", 100)
+	defer second.Close()
+
+	p, err := ConnectPool([]string{first.URL, second.URL}, testPoolConfig(inOrder{}))
+	assert.NoError(t, err)
+	defer p.Close()
+
+	first.Close()
+
+	for i := 0; i < 3; i++ {
+		var name string
+		assert.NoError(t, p.Call(&name, "chain_getName"))
+		assert.Equal(t, "second", name)
+	}
+
+	assert.False(t, p.Status()[0].Healthy)
+	assert.Error(t, p.Status()[0].Err)
+}
+
+func TestPool_PinnedCallsGoToNodesWithBlock(t *testing.T) {
+	const blockHash = "0x0102030000000000000000000000000000000000000000000000000000000000"
+
+	pruned := startPoolNode(t, "pruned", 100)
+	defer pruned.Close()
+
+	archive := startPoolNode(t, "archive", 100, blockHash)
+	defer archive.Close()
+
+	p, err := ConnectPool([]string{pruned.URL, archive.URL}, testPoolConfig(NewRoundRobinPolicy()))
+	assert.NoError(t, err)
+	defer p.Close()
+
+	for i := 0; i < 4; i++ {
+		var name string
+		assert.NoError(t, p.Call(&name, "state_getStorage", "0x01", blockHash))
+		assert.Equal(t, "archive", name)
+	}
+
+	var name string
+	err = p.Call(&name, "state_getStorage", "0x01", "0xff")
+	assert.ErrorIs(t, err, ErrBlockNotAvailable)
+}
+
+func TestPool_StateCallsGoToNodesWithState(t *testing.T) {
+	const blockHash = "0x0102030000000000000000000000000000000000000000000000000000000000"
+
+	// the pruned node has the header of the block, but not its state
+	pruned := startPoolNode(t, "pruned", 100, blockHash)
+	pruned.chain.pruned[blockHash] = true
+	defer pruned.Close()
+
+	archive := startPoolNode(t, "archive", 100, blockHash)
+	defer archive.Close()
+
+	p, err := ConnectPool([]string{pruned.URL, archive.URL}, testPoolConfig(inOrder{}))
+	assert.NoError(t, err)
+	defer p.Close()
+
+	for i := 0; i < 2; i++ {
+		var name string
+		assert.NoError(t, p.Call(&name, "state_getStorage", "0x01", blockHash))
+		assert.Equal(t, "archive", name)
+	}
+
+	// headers are served by the pruned node
+	var header types.Header
+	assert.NoError(t, p.Call(&header, "chain_getHeader", blockHash))
+
+	var batchName string
+	batch := []gethrpc.BatchElem{
+		{Method: "chain_getHeader", Args: []interface{}{blockHash}, Result: &header},
+		{Method: "state_getStorage", Args: []interface{}{"0x01", blockHash}, Result: &batchName},
+	}
+	assert.NoError(t, p.BatchCall(batch))
+	assert.Equal(t, "archive", batchName)
+}
+
+func TestPool_PinnedBatchProofGoesToNodesWithBlock(t *testing.T) {
+	const blockHash = "0x0102030000000000000000000000000000000000000000000000000000000000"
+
+	pruned := startPoolNode(t, "pruned", 100)
+	defer pruned.Close()
+
+	archive := startPoolNode(t, "archive", 100, blockHash)
+	defer archive.Close()
+
+	p, err := ConnectPool([]string{pruned.URL, archive.URL}, testPoolConfig(inOrder{}))
+	assert.NoError(t, err)
+	defer p.Close()
+
+	best := uint32(90)
+	for _, bestKnown := range []*uint32{nil, &best} {
+		var name string
+		assert.NoError(t, p.Call(&name, "mmr_generateProof", []uint32{1, 2}, bestKnown, blockHash))
+		assert.Equal(t, "archive", name)
+	}
+
+	var name string
+	assert.NoError(t, p.Call(&name, "mmr_generateProof", []uint32{1, 2}, nil))
+	assert.Equal(t, "pruned", name)
+}
+
+func TestPool_NoEndpoints(t *testing.T) {
+	_, err := ConnectPool(nil, DefaultPoolConfig())
+	assert.ErrorIs(t, err, ErrNoEndpoints)
+}
+
+func TestRoundRobinPolicy(t *testing.T) {
+	nodes := []NodeStatus{{URL: "a"}, {URL: "b"}, {URL: "c"}}
+	policy := NewRoundRobinPolicy()
+
+	assert.Equal(t, []NodeStatus{{URL: "a"}, {URL: "b"}, {URL: "c"}}, policy.Order(nodes))
+	assert.Equal(t, []NodeStatus{{URL: "b"}, {URL: "c"}, {URL: "a"}}, policy.Order(nodes))
+	assert.Equal(t, []NodeStatus{{URL: "c"}, {URL: "a"}, {URL: "b"}}, policy.Order(nodes))
+	assert.Equal(t, []NodeStatus{{URL: "a"}, {URL: "b"}, {URL: "c"}}, policy.Order(nodes))
+}
+
+func TestLeastLatencyPolicy(t *testing.T) {
+	nodes := []NodeStatus{
+		{URL: "a", Latency: 30 * time.Millisecond},
+		{URL: "b", Latency: 10 * time.Millisecond},
+		{URL: "c", Latency: 20 * time.Millisecond},
+	}
+
+	ordered := NewLeastLatencyPolicy().Order(nodes)
+	assert.Equal(t, "b", ordered[0].URL)
+	assert.Equal(t, "c", ordered[1].URL)
+	assert.Equal(t, "a", ordered[2].URL)
+	assert.Equal(t, "a", nodes[0].URL)
+}
+
+func TestPinnedBlockHash(t *testing.T) {
+	hash, ok := pinnedBlockHash("state_getStorage", []interface{}{"0x01", "0x02"})
+	assert.True(t, ok)
+	assert.Equal(t, "0x02", hash)
+
+	_, ok = pinnedBlockHash("state_getStorage", []interface{}{"0x01"})
+	assert.False(t, ok)
+
+	_, ok = pinnedBlockHash("system_health", nil)
+	assert.False(t, ok)
+
+	_, ok = pinnedBlockHash("state_getStorage", []interface{}{"0x01", context.Background()})
+	assert.False(t, ok)
+
+	hash, ok = pinnedBlockHash("mmr_generateProof", []interface{}{uint64(1), "0x02"})
+	assert.True(t, ok)
+	assert.Equal(t, "0x02", hash)
+
+	hash, ok = pinnedBlockHash("mmr_generateProof", []interface{}{[]uint32{1}, nil, "0x02"})
+	assert.True(t, ok)
+	assert.Equal(t, "0x02", hash)
+
+	_, ok = pinnedBlockHash("mmr_generateProof", []interface{}{[]uint32{1}, nil})
+	assert.False(t, ok)
+}
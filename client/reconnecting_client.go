@@ -0,0 +1,276 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	gethrpc "github.com/centrifuge/go-substrate-rpc-client/v4/gethrpc"
+)
+
+// Backoff configures the delays between the attempts of a reconnecting client to re-establish a lost connection.
+type Backoff struct {
+	// Initial is the delay after the first failed attempt
+	Initial time.Duration
+	// Max caps the delay, which doubles with every failed attempt
+	Max time.Duration
+	// MaxAttempts is the number of consecutive failed attempts after which the client gives up, 0 means no limit
+	MaxAttempts int
+}
+
+// DefaultBackoff returns a backoff that retries forever, starting at 500ms and capped at 30s
+func DefaultBackoff() Backoff {
+	return Backoff{
+		Initial: 500 * time.Millisecond,
+		Max:     30 * time.Second,
+	}
+}
+
+func (b Backoff) delay(attempt int) time.Duration {
+	d := b.Initial
+	for i := 1; i < attempt && d < b.Max; i++ {
+		d *= 2
+	}
+	if d > b.Max {
+		return b.Max
+	}
+	return d
+}
+
+type reconnectingClient struct {
+	client
+
+	backoff   Backoff
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+// ConnectWithReconnect connects to the provided url and returns a client that survives connection losses. Calls that
+// fail because the connection dropped are retried with the given backoff once the node is reachable again, and
+// active subscriptions are re-issued on the new connection, delivering notifications on the same channel.
+//
+// Notifications sent while the connection was down are lost; the Reconnected channel of the subscription receives a
+// value whenever that might have happened. Subscriptions end with an error if the backoff gives up.
+//
+// Note that calls and subscriptions with side effects, such as submitting an extrinsic, are re-issued as well.
+func ConnectWithReconnect(url string, backoff Backoff, opts ...Option) (Client, error) {
+	o := newOptions(opts)
+
+	cc, err := dial(url, o)
+	if err != nil {
+		return nil, err
+	}
+
+	return newInterceptedClient(&reconnectingClient{
+		client:  *cc,
+		backoff: backoff,
+		closed:  make(chan struct{}),
+	}, o), nil
+}
+
+// Call makes the call to RPC method with the provided args, retrying it if the connection was lost
+func (c *reconnectingClient) Call(result interface{}, method string, args ...interface{}) error {
+	return c.CallContext(context.Background(), result, method, args...)
+}
+
+// CallContext makes the call to RPC method with the provided args, retrying it if the connection was lost until ctx
+// is done
+func (c *reconnectingClient) CallContext(ctx context.Context, result interface{}, method string,
+	args ...interface{}) error {
+	return c.retry(ctx, func() error {
+		return c.client.CallContext(ctx, result, method, args...)
+	})
+}
+
+// BatchCall sends the requests as a single batch, retrying it if the connection was lost
+func (c *reconnectingClient) BatchCall(b []gethrpc.BatchElem) error {
+	return c.BatchCallContext(context.Background(), b)
+}
+
+// BatchCallContext sends the requests as a single batch, retrying it if the connection was lost until ctx is done
+func (c *reconnectingClient) BatchCallContext(ctx context.Context, b []gethrpc.BatchElem) error {
+	return c.retry(ctx, func() error {
+		return c.client.BatchCallContext(ctx, b)
+	})
+}
+
+// Subscribe creates a subscription that is re-established on a new connection whenever the connection is lost
+func (c *reconnectingClient) Subscribe(ctx context.Context, namespace, subscribeMethodSuffix,
+	unsubscribeMethodSuffix, notificationMethodSuffix string, channel interface{}, args ...interface{}) (
+	*gethrpc.ClientSubscription, error) {
+	subscribe := func(ctx context.Context) (*gethrpc.ClientSubscription, error) {
+		return c.client.Subscribe(ctx, namespace, subscribeMethodSuffix, unsubscribeMethodSuffix,
+			notificationMethodSuffix, channel, args...)
+	}
+
+	sub, err := subscribe(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rs := &resubscription{
+		client:    c,
+		subscribe: subscribe,
+		sub:       sub,
+	}
+	rs.ctx, rs.cancel = context.WithCancel(context.Background())
+	rs.managed = gethrpc.NewManagedSubscription(rs.unsubscribe)
+	rs.managed.SetID(sub.ID())
+
+	go rs.run()
+
+	return rs.managed, nil
+}
+
+func (c *reconnectingClient) Close() {
+	c.closeOnce.Do(func() {
+		close(c.closed)
+	})
+	c.client.Close()
+}
+
+// retry calls fn until it succeeds, fails for a reason other than the connection or the backoff gives up. It aborts
+// when ctx is done.
+func (c *reconnectingClient) retry(ctx context.Context, fn func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if !isConnectionError(err) {
+			return err
+		}
+
+		if c.backoff.MaxAttempts > 0 && attempt >= c.backoff.MaxAttempts {
+			return err
+		}
+
+		select {
+		case <-time.After(c.backoff.delay(attempt)):
+		case <-ctx.Done():
+			return err
+		case <-c.closed:
+			return gethrpc.ErrClientQuit
+		}
+	}
+}
+
+// isConnectionError reports whether err might be resolved by re-establishing the connection, as opposed to errors
+// returned by the node or caused by the arguments or results of a call
+func isConnectionError(err error) bool {
+	if err == nil ||
+		errors.Is(err, gethrpc.ErrClientQuit) ||
+		errors.Is(err, gethrpc.ErrNoResult) ||
+		errors.Is(err, context.Canceled) ||
+		errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var (
+		rpcErr           gethrpc.Error
+		syntaxErr        *json.SyntaxError
+		unmarshalTypeErr *json.UnmarshalTypeError
+		unsupportedType  *json.UnsupportedTypeError
+		unsupportedValue *json.UnsupportedValueError
+		marshalerErr     *json.MarshalerError
+	)
+
+	return !errors.As(err, &rpcErr) &&
+		!errors.As(err, &syntaxErr) &&
+		!errors.As(err, &unmarshalTypeErr) &&
+		!errors.As(err, &unsupportedType) &&
+		!errors.As(err, &unsupportedValue) &&
+		!errors.As(err, &marshalerErr)
+}
+
+// resubscription keeps a managed subscription alive by re-issuing the underlying subscription whenever it ends
+type resubscription struct {
+	client    *reconnectingClient
+	subscribe func(ctx context.Context) (*gethrpc.ClientSubscription, error)
+	managed   *gethrpc.ClientSubscription
+
+	mu      sync.Mutex
+	sub     *gethrpc.ClientSubscription
+	stopped bool
+	ctx     context.Context
+	cancel  context.CancelFunc
+}
+
+func (rs *resubscription) run() {
+	for {
+		rs.mu.Lock()
+		sub := rs.sub
+		rs.mu.Unlock()
+
+		select {
+		case <-rs.ctx.Done():
+			return
+		case err := <-sub.Err():
+			if err == nil {
+				// Either unsubscribed or the client has been closed
+				rs.managed.Terminate(gethrpc.ErrClientQuit)
+				return
+			}
+		}
+
+		var next *gethrpc.ClientSubscription
+		err := rs.client.retry(rs.ctx, func() error {
+			ctx, cancel := context.WithTimeout(rs.ctx, rs.client.cfg.SubscribeTimeout)
+			defer cancel()
+
+			var err error
+			next, err = rs.subscribe(ctx)
+			if errors.Is(err, context.DeadlineExceeded) && rs.ctx.Err() == nil {
+				// Only this attempt timed out, which is worth another one
+				return fmt.Errorf("resubscribe: %v", err)
+			}
+			return err
+		})
+
+		rs.mu.Lock()
+		if rs.stopped {
+			rs.mu.Unlock()
+			if next != nil {
+				next.Unsubscribe()
+			}
+			return
+		}
+		if err != nil {
+			rs.mu.Unlock()
+			rs.managed.Terminate(err)
+			return
+		}
+		rs.sub = next
+		rs.mu.Unlock()
+
+		rs.managed.SetID(next.ID())
+		rs.managed.MarkReconnected()
+	}
+}
+
+// unsubscribe is called when the managed subscription is unsubscribed. It stops the current underlying subscription
+// synchronously, so that no further notifications are delivered once Unsubscribe returns.
+func (rs *resubscription) unsubscribe() {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	rs.stopped = true
+	rs.cancel()
+	rs.sub.Unsubscribe()
+}
@@ -0,0 +1,186 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	gethrpc "github.com/centrifuge/go-substrate-rpc-client/v4/gethrpc"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/rpcmocksrv"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type headsService struct{}
+
+func (h *headsService) GetName() string {
+	return "mock"
+}
+
+func (h *headsService) SubscribeNewHead(ctx context.Context) (*gethrpc.Subscription, error) {
+	n, ok := gethrpc.NotifierFromContext(ctx)
+	if !ok {
+		return nil, gethrpc.ErrNotificationsUnsupported
+	}
+	sub := n.CreateSubscription()
+
+	go func() {
+		ticker := time.NewTicker(10 * time.Millisecond)
+		defer ticker.Stop()
+
+		for i := 1; ; i++ {
+			select {
+			case <-ticker.C:
+				if err := n.Notify(sub.ID, types.Header{Number: types.BlockNumber(i)}); err != nil {
+					return
+				}
+			case <-sub.Err():
+				return
+			case <-n.Closed():
+				return
+			}
+		}
+	}()
+
+	return sub, nil
+}
+
+func startHeadsServer(t *testing.T, host string) *rpcmocksrv.Server {
+	var s *rpcmocksrv.Server
+	if host == "" {
+		s = rpcmocksrv.New()
+	} else {
+		s = rpcmocksrv.NewAt(host)
+	}
+	assert.NoError(t, s.RegisterName("chain", &headsService{}))
+	return s
+}
+
+func TestReconnectingClient_Resubscribes(t *testing.T) {
+	s := startHeadsServer(t, "")
+
+	cl, err := ConnectWithReconnect(s.URL, Backoff{Initial: 20 * time.Millisecond, Max: 100 * time.Millisecond})
+	assert.NoError(t, err)
+	defer cl.Close()
+
+	ch := make(chan types.Header)
+	sub, err := cl.Subscribe(context.Background(), "chain", "subscribeNewHead", "unsubscribeNewHead", "newHead",
+		ch)
+	assert.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	select {
+	case <-ch:
+	case <-time.After(time.Second):
+		t.Fatal("no header received")
+	}
+
+	s.Close()
+	s = startHeadsServer(t, s.Host)
+	defer s.Close()
+
+	select {
+	case <-sub.Reconnected():
+	case err := <-sub.Err():
+		t.Fatalf("subscription ended: %v", err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscription not re-established")
+	}
+
+	// Drain headers from the old connection that might have been buffered, the new server starts counting at 1 again
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case h := <-ch:
+			if h.Number == 1 {
+				return
+			}
+		case <-timeout:
+			t.Fatal("no header received after reconnect")
+		}
+	}
+}
+
+func TestReconnectingClient_RetriesCalls(t *testing.T) {
+	s := startHeadsServer(t, "")
+
+	cl, err := ConnectWithReconnect(s.URL, Backoff{Initial: 20 * time.Millisecond, Max: 100 * time.Millisecond})
+	assert.NoError(t, err)
+	defer cl.Close()
+
+	s.Close()
+
+	restarted := make(chan *rpcmocksrv.Server, 1)
+	go func() {
+		time.Sleep(200 * time.Millisecond)
+		restarted <- startHeadsServer(t, s.Host)
+	}()
+
+	var name string
+	err = cl.Call(&name, "chain_getName")
+	assert.NoError(t, err)
+	assert.Equal(t, "mock", name)
+
+	(<-restarted).Close()
+}
+
+func TestReconnectingClient_GivesUp(t *testing.T) {
+	s := startHeadsServer(t, "")
+
+	cl, err := ConnectWithReconnect(s.URL, Backoff{Initial: 10 * time.Millisecond, Max: 10 * time.Millisecond,
+		MaxAttempts: 3})
+	assert.NoError(t, err)
+	defer cl.Close()
+
+	ch := make(chan types.Header, 100)
+	sub, err := cl.Subscribe(context.Background(), "chain", "subscribeNewHead", "unsubscribeNewHead", "newHead",
+		ch)
+	assert.NoError(t, err)
+
+	s.Close()
+
+	select {
+	case err := <-sub.Err():
+		assert.Error(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscription did not end")
+	}
+
+	var name string
+	err = cl.Call(&name, "chain_getName")
+	assert.Error(t, err)
+	assert.True(t, isConnectionError(err))
+}
+
+func TestIsConnectionError(t *testing.T) {
+	assert.False(t, isConnectionError(nil))
+	assert.False(t, isConnectionError(gethrpc.ErrClientQuit))
+	assert.False(t, isConnectionError(context.DeadlineExceeded))
+	assert.True(t, isConnectionError(errors.New("connection reset by peer")))
+}
+
+func TestBackoff_Delay(t *testing.T) {
+	b := Backoff{Initial: time.Second, Max: 5 * time.Second}
+	assert.Equal(t, time.Second, b.delay(1))
+	assert.Equal(t, 2*time.Second, b.delay(2))
+	assert.Equal(t, 4*time.Second, b.delay(3))
+	assert.Equal(t, 5*time.Second, b.delay(4))
+	assert.Equal(t, 5*time.Second, b.delay(10))
+}
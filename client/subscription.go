@@ -0,0 +1,245 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/config"
+	libErr "github.com/centrifuge/go-substrate-rpc-client/v4/error"
+	gethrpc "github.com/centrifuge/go-substrate-rpc-client/v4/gethrpc"
+)
+
+const ErrSubscriptionOverflow = libErr.Error("subscription overflow")
+
+// SubscriptionOptions configure the notification channel of a single subscription
+type SubscriptionOptions struct {
+	// BufferSize is the capacity of the notification channel
+	BufferSize int
+	// OverflowPolicy decides what happens to notifications when the channel is full
+	OverflowPolicy config.OverflowPolicy
+}
+
+type subscriptionOptionsKey struct{}
+
+// WithSubscriptionOptions returns a context that makes the subscribe methods of the RPC modules use the given options
+// instead of the subscription settings of the client config, e.g.
+//
+//	ctx := client.WithSubscriptionOptions(ctx, client.SubscriptionOptions{
+//		BufferSize:     100,
+//		OverflowPolicy: config.OverflowDropOldest,
+//	})
+//	sub, err := api.RPC.Chain.SubscribeNewHeadsContext(ctx)
+func WithSubscriptionOptions(ctx context.Context, opts SubscriptionOptions) context.Context {
+	return context.WithValue(ctx, subscriptionOptionsKey{}, opts)
+}
+
+// subscriptionOptions returns the options of ctx, falling back to the settings of the config of c
+func subscriptionOptions(ctx context.Context, c Client) SubscriptionOptions {
+	if opts, ok := ctx.Value(subscriptionOptionsKey{}).(SubscriptionOptions); ok {
+		return opts
+	}
+
+	cfg := ConfigOf(c)
+
+	return SubscriptionOptions{
+		BufferSize:     cfg.SubscriptionBufferSize,
+		OverflowPolicy: cfg.SubscriptionOverflowPolicy,
+	}
+}
+
+// BufferedSubscription delivers the notifications of a subscription on a buffered channel, applying an overflow
+// policy when the consumer falls behind. Unlike with the channel passed to Client.Subscribe, a slow consumer does not
+// stall the connection unless the policy is config.OverflowBlock.
+type BufferedSubscription[T any] struct {
+	sub   *gethrpc.ClientSubscription
+	inner *gethrpc.ClientSubscription
+
+	in     chan T
+	out    chan T
+	policy config.OverflowPolicy
+
+	dropped atomic.Uint64
+
+	stop      chan struct{}
+	stopOnce  sync.Once
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// SubscribeBuffered creates the subscription on c and delivers its notifications on a channel that is configured by
+// the subscription options of ctx, see WithSubscriptionOptions, or else by the config of c. The context bounds the
+// subscription request only, the subscription itself lives until it is unsubscribed.
+func SubscribeBuffered[T any](ctx context.Context, c Client, namespace, subscribeMethodSuffix,
+	unsubscribeMethodSuffix, notificationMethodSuffix string, args ...interface{}) (*BufferedSubscription[T], error) {
+	opts := subscriptionOptions(ctx, c)
+	if err := opts.OverflowPolicy.Validate(); err != nil {
+		return nil, err
+	}
+
+	if opts.BufferSize < 0 {
+		opts.BufferSize = 0
+	}
+
+	s := &BufferedSubscription[T]{
+		in:     make(chan T),
+		out:    make(chan T, opts.BufferSize),
+		policy: opts.OverflowPolicy,
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+
+	inner, err := c.Subscribe(ctx, namespace, subscribeMethodSuffix, unsubscribeMethodSuffix,
+		notificationMethodSuffix, s.in, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	s.inner = inner
+	s.sub = gethrpc.NewManagedSubscription(func() {
+		s.stopOnce.Do(func() { close(s.stop) })
+	})
+
+	go s.pump()
+
+	return s, nil
+}
+
+// pump moves the notifications from the connection to the buffered channel until the subscription ends
+func (s *BufferedSubscription[T]) pump() {
+	defer close(s.done)
+	defer s.inner.Unsubscribe()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case err := <-s.inner.Err():
+			if err == nil {
+				// Either unsubscribed or the client has been closed. Terminate delivers ErrClientQuit as nil, so
+				// that Err keeps the semantics of the wrapped subscription.
+				err = gethrpc.ErrClientQuit
+			}
+
+			s.sub.Terminate(err)
+			return
+		case <-s.inner.Reconnected():
+			s.sub.MarkReconnected()
+		case v := <-s.in:
+			if !s.push(v) {
+				return
+			}
+		}
+	}
+}
+
+// push delivers v according to the overflow policy, it returns false if the subscription has ended
+func (s *BufferedSubscription[T]) push(v T) bool {
+	switch s.policy {
+	case config.OverflowDropNewest:
+		select {
+		case s.out <- v:
+		default:
+			s.dropped.Add(1)
+		}
+	case config.OverflowDropOldest:
+		for {
+			select {
+			case s.out <- v:
+				return true
+			default:
+			}
+
+			if cap(s.out) == 0 {
+				// Without a buffer the new notification is the oldest one
+				s.dropped.Add(1)
+				return true
+			}
+
+			select {
+			case <-s.out:
+				s.dropped.Add(1)
+			default:
+			}
+		}
+	case config.OverflowClose:
+		select {
+		case s.out <- v:
+		default:
+			s.dropped.Add(1)
+			s.sub.Terminate(ErrSubscriptionOverflow.WithMsg("buffer of %d notifications is full", cap(s.out)))
+			return false
+		}
+	default:
+		select {
+		case s.out <- v:
+		case <-s.stop:
+			return false
+		}
+	}
+
+	return true
+}
+
+// Chan returns the notification channel.
+//
+// The channel is closed when Unsubscribe is called on the subscription.
+func (s *BufferedSubscription[T]) Chan() <-chan T {
+	return s.out
+}
+
+// Err returns the subscription error channel. The intended use of Err is to schedule
+// resubscription when the client connection is closed unexpectedly.
+//
+// The error channel receives a value when the subscription has ended due to an error, which includes
+// ErrSubscriptionOverflow with the config.OverflowClose policy. The received error is nil if Close has been called
+// on the underlying client and no other error has occurred.
+//
+// The error channel is closed when Unsubscribe is called on the subscription.
+func (s *BufferedSubscription[T]) Err() <-chan error {
+	return s.sub.Err()
+}
+
+// Reconnected returns a channel that receives a value whenever the subscription has been re-established after the
+// connection to the node was lost. Notifications may have been missed in between. It only ever receives values if
+// the subscription was created through a reconnecting client, see ConnectWithReconnect.
+func (s *BufferedSubscription[T]) Reconnected() <-chan struct{} {
+	return s.sub.Reconnected()
+}
+
+// ID returns the ID the node assigned to the subscription, which changes when the subscription is re-established by a
+// reconnecting client
+func (s *BufferedSubscription[T]) ID() string {
+	return s.inner.ID()
+}
+
+// Dropped returns the number of notifications that have been dropped because the channel was full
+func (s *BufferedSubscription[T]) Dropped() uint64 {
+	return s.dropped.Load()
+}
+
+// Unsubscribe unsubscribes the notification and closes the error and notification channels.
+// It can safely be called more than once.
+func (s *BufferedSubscription[T]) Unsubscribe() {
+	s.sub.Unsubscribe()
+	s.stopOnce.Do(func() { close(s.stop) })
+	<-s.done
+
+	s.closeOnce.Do(func() { close(s.out) })
+}
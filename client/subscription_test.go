@@ -0,0 +1,184 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/config"
+	gethrpc "github.com/centrifuge/go-substrate-rpc-client/v4/gethrpc"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/rpcmocksrv"
+	"github.com/stretchr/testify/assert"
+)
+
+// countService notifies the numbers from 1 to n at once and keeps the subscription open afterwards
+type countService struct{}
+
+func (countService) SubscribeCount(ctx context.Context, n int) (*gethrpc.Subscription, error) {
+	notifier, ok := gethrpc.NotifierFromContext(ctx)
+	if !ok {
+		return nil, gethrpc.ErrNotificationsUnsupported
+	}
+	sub := notifier.CreateSubscription()
+
+	go func() {
+		for i := 1; i <= n; i++ {
+			if err := notifier.Notify(sub.ID, i); err != nil {
+				return
+			}
+		}
+	}()
+
+	return sub, nil
+}
+
+func startCountServer(t *testing.T) *rpcmocksrv.Server {
+	s := rpcmocksrv.New()
+	assert.NoError(t, s.RegisterName("test", countService{}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func subscribeCount(t *testing.T, cl Client, opts *SubscriptionOptions, n int) *BufferedSubscription[int] {
+	ctx := context.Background()
+	if opts != nil {
+		ctx = WithSubscriptionOptions(ctx, *opts)
+	}
+
+	sub, err := SubscribeBuffered[int](ctx, cl, "test", "subscribeCount", "unsubscribeCount", "count", n)
+	assert.NoError(t, err)
+	return sub
+}
+
+func receive(t *testing.T, sub *BufferedSubscription[int], n int) []int {
+	var res []int
+	for i := 0; i < n; i++ {
+		select {
+		case v := <-sub.Chan():
+			res = append(res, v)
+		case <-time.After(time.Second):
+			t.Fatalf("received %d of %d notifications", len(res), n)
+		}
+	}
+	return res
+}
+
+func TestBufferedSubscription_Policies(t *testing.T) {
+	s := startCountServer(t)
+
+	cl, err := Connect(s.URL)
+	assert.NoError(t, err)
+	defer cl.Close()
+
+	for _, test := range []struct {
+		policy  config.OverflowPolicy
+		exp     []int
+		dropped uint64
+	}{
+		{config.OverflowDropNewest, []int{1, 2, 3}, 7},
+		{config.OverflowDropOldest, []int{8, 9, 10}, 7},
+	} {
+		t.Run(string(test.policy), func(t *testing.T) {
+			sub := subscribeCount(t, cl, &SubscriptionOptions{BufferSize: 3, OverflowPolicy: test.policy}, 10)
+			defer sub.Unsubscribe()
+
+			assert.Eventually(t, func() bool { return sub.Dropped() == test.dropped }, time.Second,
+				10*time.Millisecond)
+			assert.Equal(t, test.exp, receive(t, sub, 3))
+		})
+	}
+}
+
+func TestBufferedSubscription_Block(t *testing.T) {
+	s := startCountServer(t)
+
+	cl, err := Connect(s.URL)
+	assert.NoError(t, err)
+	defer cl.Close()
+
+	sub := subscribeCount(t, cl, &SubscriptionOptions{BufferSize: 2, OverflowPolicy: config.OverflowBlock}, 10)
+
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, receive(t, sub, 10))
+	assert.Equal(t, uint64(0), sub.Dropped())
+
+	sub.Unsubscribe()
+	_, ok := <-sub.Chan()
+	assert.False(t, ok)
+	sub.Unsubscribe()
+}
+
+func TestBufferedSubscription_Close(t *testing.T) {
+	s := startCountServer(t)
+
+	cl, err := Connect(s.URL)
+	assert.NoError(t, err)
+	defer cl.Close()
+
+	sub := subscribeCount(t, cl, &SubscriptionOptions{BufferSize: 2, OverflowPolicy: config.OverflowClose}, 10)
+	defer sub.Unsubscribe()
+
+	select {
+	case err := <-sub.Err():
+		assert.ErrorIs(t, err, ErrSubscriptionOverflow)
+	case <-time.After(time.Second):
+		t.Fatal("subscription not closed")
+	}
+
+	assert.Equal(t, []int{1, 2}, receive(t, sub, 2))
+	assert.Equal(t, uint64(1), sub.Dropped())
+}
+
+func TestBufferedSubscription_ClientClosed(t *testing.T) {
+	s := startCountServer(t)
+
+	cl, err := Connect(s.URL)
+	assert.NoError(t, err)
+
+	sub := subscribeCount(t, cl, nil, 0)
+	defer sub.Unsubscribe()
+
+	cl.Close()
+
+	select {
+	case err := <-sub.Err():
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("subscription not ended")
+	}
+}
+
+func TestBufferedSubscription_OptionsFromConfig(t *testing.T) {
+	s := startCountServer(t)
+
+	cl, err := Connect(s.URL, WithSubscriptionBufferSize(4),
+		WithSubscriptionOverflowPolicy(config.OverflowDropNewest))
+	assert.NoError(t, err)
+	defer cl.Close()
+
+	sub := subscribeCount(t, cl, nil, 10)
+	defer sub.Unsubscribe()
+
+	assert.Eventually(t, func() bool { return sub.Dropped() == 6 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []int{1, 2, 3, 4}, receive(t, sub, 4))
+
+	_, err = SubscribeBuffered[int](WithSubscriptionOptions(context.Background(),
+		SubscriptionOptions{OverflowPolicy: "ignore"}), cl, "test", "subscribeCount", "unsubscribeCount", "count", 1)
+	assert.ErrorIs(t, err, config.ErrUnknownOverflowPolicy)
+}
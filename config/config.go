@@ -27,6 +27,88 @@ type Config struct {
 	// Timeouts
 	DialTimeout      time.Duration
 	SubscribeTimeout time.Duration
+	// CallTimeout bounds calls that are made without a context or with a context without deadline, 0 means no limit
+	CallTimeout time.Duration
+
+	// Retry configures how often connecting to the node is attempted
+	Retry Retry
+
+	// Headers are sent along with HTTP requests and the websocket handshake
+	Headers map[string]string
+	// BearerToken is sent as the Authorization header, if set
+	BearerToken string
+
+	// TLS configures secure connections
+	TLS TLS
+
+	// WSReadLimit is the maximum size of a websocket message read from the node in bytes, 0 means the default of 5MB
+	WSReadLimit int64
+
+	// SubscriptionBufferSize is the capacity of the notification channels created by the RPC modules
+	SubscriptionBufferSize int
+	// SubscriptionOverflowPolicy decides what happens to notifications when a notification channel is full, the
+	// default is OverflowBlock
+	SubscriptionOverflowPolicy OverflowPolicy
+}
+
+// OverflowPolicy decides what happens to a notification when the notification channel of a subscription is full
+type OverflowPolicy string
+
+const (
+	// OverflowBlock waits until the consumer makes room, which delays the notifications of the connection. The
+	// connection buffers a limited number of notifications and fails the subscription once that limit is reached.
+	OverflowBlock OverflowPolicy = "block"
+	// OverflowDropOldest drops the oldest notification in the channel to make room for the new one
+	OverflowDropOldest OverflowPolicy = "drop_oldest"
+	// OverflowDropNewest drops the new notification
+	OverflowDropNewest OverflowPolicy = "drop_newest"
+	// OverflowClose ends the subscription with an error on the error channel
+	OverflowClose OverflowPolicy = "close"
+)
+
+// Validate returns an error if the policy is unknown, the empty policy is valid and means OverflowBlock
+func (p OverflowPolicy) Validate() error {
+	switch p {
+	case "", OverflowBlock, OverflowDropOldest, OverflowDropNewest, OverflowClose:
+		return nil
+	default:
+		return ErrUnknownOverflowPolicy.WithMsg("%q", string(p))
+	}
+}
+
+// Retry configures the attempts of an operation and the delays between them, which double with every failed attempt
+type Retry struct {
+	// MaxAttempts is the number of attempts, 0 and 1 both mean a single attempt without retries
+	MaxAttempts int
+	// InitialBackoff is the delay after the first failed attempt
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay
+	MaxBackoff time.Duration
+}
+
+// Delay returns the delay after the given failed attempt, starting at 1
+func (r Retry) Delay(attempt int) time.Duration {
+	d := r.InitialBackoff
+	for i := 1; i < attempt && (r.MaxBackoff <= 0 || d < r.MaxBackoff); i++ {
+		d *= 2
+	}
+	if r.MaxBackoff > 0 && d > r.MaxBackoff {
+		return r.MaxBackoff
+	}
+	return d
+}
+
+// TLS configures secure connections. Certificates and keys are PEM encoded.
+type TLS struct {
+	// CAFile contains the certificates used to verify the node, instead of the system pool
+	CAFile string
+	// CertFile and KeyFile contain the client certificate for mutual TLS
+	CertFile string
+	KeyFile  string
+	// ServerName overrides the name the certificate of the node is verified against
+	ServerName string
+	// InsecureSkipVerify disables the verification of the certificate of the node, use for testing only
+	InsecureSkipVerify bool
 }
 
 // DefaultConfig returns the default config. Default values can be overwritten with env variables, most importantly
@@ -36,6 +118,10 @@ func Default() Config {
 		RPCURL:           extractDefaultRPCURL(),
 		DialTimeout:      10 * time.Second,
 		SubscribeTimeout: 5 * time.Second,
+		Retry: Retry{
+			InitialBackoff: 500 * time.Millisecond,
+			MaxBackoff:     10 * time.Second,
+		},
 	}
 }
 
@@ -0,0 +1,131 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoad_YAML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte(`
+rpc_url: wss://example.com
+call_timeout: 30s
+retry:
+  max_attempts: 3
+  max_backoff: 1m
+headers:
+  X-Api-Key: secret
+tls:
+  server_name: node.example.com
+ws_read_limit: 1048576
+subscription_buffer_size: 64
+subscription_overflow_policy: drop_oldest
+`), 0o600))
+
+	cfg, err := Load(path)
+	assert.NoError(t, err)
+
+	exp := Default()
+	exp.RPCURL = "wss://example.com"
+	exp.CallTimeout = 30 * time.Second
+	exp.Retry.MaxAttempts = 3
+	exp.Retry.MaxBackoff = time.Minute
+	exp.Headers = map[string]string{"X-Api-Key": "secret"}
+	exp.TLS.ServerName = "node.example.com"
+	exp.WSReadLimit = 1 << 20
+	exp.SubscriptionBufferSize = 64
+	exp.SubscriptionOverflowPolicy = OverflowDropOldest
+
+	assert.Equal(t, exp, cfg)
+}
+
+func TestLoad_JSON(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	assert.NoError(t, os.WriteFile(path, []byte(`{"dial_timeout": "3s", "bearer_token": "token"}`), 0o600))
+
+	cfg, err := Load(path)
+	assert.NoError(t, err)
+
+	exp := Default()
+	exp.DialTimeout = 3 * time.Second
+	exp.BearerToken = "token"
+
+	assert.Equal(t, exp, cfg)
+}
+
+func TestLoad_Errors(t *testing.T) {
+	_, err := Load(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.ErrorIs(t, err, ErrReadConfigFile)
+
+	cfg := Default()
+	assert.ErrorIs(t, cfg.LoadBytes([]byte("call_timeout: soon")), ErrParseConfig)
+	assert.ErrorIs(t, cfg.LoadBytes([]byte("subscription_overflow_policy: ignore")), ErrUnknownOverflowPolicy)
+}
+
+func TestConfig_LoadEnv(t *testing.T) {
+	t.Setenv("TEST_RPC_URL", "ws://node:9944")
+	t.Setenv("TEST_SUBSCRIBE_TIMEOUT", "1m")
+	t.Setenv("TEST_RETRY_MAX_ATTEMPTS", "5")
+	t.Setenv("TEST_HEADERS", "X-A=1, X-B=2")
+	t.Setenv("TEST_TLS_INSECURE_SKIP_VERIFY", "true")
+	t.Setenv("TEST_WS_READ_LIMIT", "1024")
+	t.Setenv("TEST_SUBSCRIPTION_OVERFLOW_POLICY", "close")
+
+	cfg := Default()
+	cfg.Headers = map[string]string{"X-A": "0", "X-C": "3"}
+	assert.NoError(t, cfg.LoadEnv("TEST"))
+
+	assert.Equal(t, "ws://node:9944", cfg.RPCURL)
+	assert.Equal(t, time.Minute, cfg.SubscribeTimeout)
+	assert.Equal(t, 10*time.Second, cfg.DialTimeout)
+	assert.Equal(t, 5, cfg.Retry.MaxAttempts)
+	assert.Equal(t, map[string]string{"X-A": "1", "X-B": "2", "X-C": "3"}, cfg.Headers)
+	assert.True(t, cfg.TLS.InsecureSkipVerify)
+	assert.Equal(t, int64(1024), cfg.WSReadLimit)
+	assert.Equal(t, OverflowClose, cfg.SubscriptionOverflowPolicy)
+
+	t.Setenv("TEST_CALL_TIMEOUT", "later")
+	assert.ErrorIs(t, cfg.LoadEnv("TEST"), ErrInvalidEnvVar)
+}
+
+func TestRetry_Delay(t *testing.T) {
+	r := Retry{InitialBackoff: time.Second, MaxBackoff: 5 * time.Second}
+
+	assert.Equal(t, time.Second, r.Delay(1))
+	assert.Equal(t, 2*time.Second, r.Delay(2))
+	assert.Equal(t, 4*time.Second, r.Delay(3))
+	assert.Equal(t, 5*time.Second, r.Delay(4))
+}
+
+func TestTLS_ClientConfig(t *testing.T) {
+	cfg, err := TLS{}.ClientConfig()
+	assert.NoError(t, err)
+	assert.Nil(t, cfg)
+
+	cfg, err = TLS{ServerName: "node"}.ClientConfig()
+	assert.NoError(t, err)
+	assert.Equal(t, "node", cfg.ServerName)
+
+	_, err = TLS{CAFile: filepath.Join(t.TempDir(), "missing.pem")}.ClientConfig()
+	assert.ErrorIs(t, err, ErrLoadTLS)
+}
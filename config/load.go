@@ -0,0 +1,259 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// fileConfig is the layout of config files, settings that are missing in the file are nil
+type fileConfig struct {
+	RPCURL           *string        `yaml:"rpc_url"`
+	DialTimeout      *time.Duration `yaml:"dial_timeout"`
+	SubscribeTimeout *time.Duration `yaml:"subscribe_timeout"`
+	CallTimeout      *time.Duration `yaml:"call_timeout"`
+
+	Retry struct {
+		MaxAttempts    *int           `yaml:"max_attempts"`
+		InitialBackoff *time.Duration `yaml:"initial_backoff"`
+		MaxBackoff     *time.Duration `yaml:"max_backoff"`
+	} `yaml:"retry"`
+
+	Headers     map[string]string `yaml:"headers"`
+	BearerToken *string           `yaml:"bearer_token"`
+
+	TLS struct {
+		CAFile             *string `yaml:"ca_file"`
+		CertFile           *string `yaml:"cert_file"`
+		KeyFile            *string `yaml:"key_file"`
+		ServerName         *string `yaml:"server_name"`
+		InsecureSkipVerify *bool   `yaml:"insecure_skip_verify"`
+	} `yaml:"tls"`
+
+	WSReadLimit                *int64          `yaml:"ws_read_limit"`
+	SubscriptionBufferSize     *int            `yaml:"subscription_buffer_size"`
+	SubscriptionOverflowPolicy *OverflowPolicy `yaml:"subscription_overflow_policy"`
+}
+
+// Load returns the default config, overridden by the settings of the YAML or JSON file at path. Durations are written
+// as strings such as "10s". Example:
+//
+//	rpc_url: wss://rpc.polkadot.io
+//	call_timeout: 30s
+//	retry:
+//	  max_attempts: 3
+//	headers:
+//	  X-Api-Key: secret
+func Load(path string) (Config, error) {
+	cfg := Default()
+	if err := cfg.LoadFile(path); err != nil {
+		return Config{}, err
+	}
+
+	return cfg, nil
+}
+
+// LoadFile overrides the settings of the config with those of the YAML or JSON file at path, see Load
+func (c *Config) LoadFile(path string) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return ErrReadConfigFile.Wrap(err)
+	}
+
+	return c.LoadBytes(b)
+}
+
+// LoadBytes overrides the settings of the config with those of the YAML or JSON document, see Load
+func (c *Config) LoadBytes(b []byte) error {
+	var f fileConfig
+	if err := yaml.Unmarshal(b, &f); err != nil {
+		return ErrParseConfig.Wrap(err)
+	}
+
+	set(&c.RPCURL, f.RPCURL)
+	set(&c.DialTimeout, f.DialTimeout)
+	set(&c.SubscribeTimeout, f.SubscribeTimeout)
+	set(&c.CallTimeout, f.CallTimeout)
+	set(&c.Retry.MaxAttempts, f.Retry.MaxAttempts)
+	set(&c.Retry.InitialBackoff, f.Retry.InitialBackoff)
+	set(&c.Retry.MaxBackoff, f.Retry.MaxBackoff)
+	set(&c.BearerToken, f.BearerToken)
+	set(&c.TLS.CAFile, f.TLS.CAFile)
+	set(&c.TLS.CertFile, f.TLS.CertFile)
+	set(&c.TLS.KeyFile, f.TLS.KeyFile)
+	set(&c.TLS.ServerName, f.TLS.ServerName)
+	set(&c.TLS.InsecureSkipVerify, f.TLS.InsecureSkipVerify)
+	set(&c.WSReadLimit, f.WSReadLimit)
+	set(&c.SubscriptionBufferSize, f.SubscriptionBufferSize)
+	set(&c.SubscriptionOverflowPolicy, f.SubscriptionOverflowPolicy)
+
+	c.addHeaders(f.Headers)
+
+	if err := c.SubscriptionOverflowPolicy.Validate(); err != nil {
+		return ErrParseConfig.Wrap(err)
+	}
+
+	return nil
+}
+
+func set[T any](dst *T, src *T) {
+	if src != nil {
+		*dst = *src
+	}
+}
+
+func (c *Config) addHeaders(headers map[string]string) {
+	if len(headers) == 0 {
+		return
+	}
+
+	merged := make(map[string]string, len(c.Headers)+len(headers))
+	for k, v := range c.Headers {
+		merged[k] = v
+	}
+	for k, v := range headers {
+		merged[k] = v
+	}
+
+	c.Headers = merged
+}
+
+// LoadEnv overrides the settings of the config with the env variables that start with prefix, followed by an
+// underscore, e.g. with prefix GSRPC:
+//
+//	GSRPC_RPC_URL, GSRPC_DIAL_TIMEOUT, GSRPC_SUBSCRIBE_TIMEOUT, GSRPC_CALL_TIMEOUT,
+//	GSRPC_RETRY_MAX_ATTEMPTS, GSRPC_RETRY_INITIAL_BACKOFF, GSRPC_RETRY_MAX_BACKOFF,
+//	GSRPC_HEADERS (comma separated key=value pairs), GSRPC_BEARER_TOKEN,
+//	GSRPC_TLS_CA_FILE, GSRPC_TLS_CERT_FILE, GSRPC_TLS_KEY_FILE, GSRPC_TLS_SERVER_NAME, GSRPC_TLS_INSECURE_SKIP_VERIFY,
+//	GSRPC_WS_READ_LIMIT, GSRPC_SUBSCRIPTION_BUFFER_SIZE, GSRPC_SUBSCRIPTION_OVERFLOW_POLICY
+//
+// Durations are written as strings such as "10s". Unset variables leave the settings unchanged.
+func (c *Config) LoadEnv(prefix string) error {
+	vars := []struct {
+		name  string
+		parse func(string) error
+	}{
+		{"RPC_URL", stringVar(&c.RPCURL)},
+		{"DIAL_TIMEOUT", durationVar(&c.DialTimeout)},
+		{"SUBSCRIBE_TIMEOUT", durationVar(&c.SubscribeTimeout)},
+		{"CALL_TIMEOUT", durationVar(&c.CallTimeout)},
+		{"RETRY_MAX_ATTEMPTS", intVar(&c.Retry.MaxAttempts)},
+		{"RETRY_INITIAL_BACKOFF", durationVar(&c.Retry.InitialBackoff)},
+		{"RETRY_MAX_BACKOFF", durationVar(&c.Retry.MaxBackoff)},
+		{"HEADERS", c.headersVar},
+		{"BEARER_TOKEN", stringVar(&c.BearerToken)},
+		{"TLS_CA_FILE", stringVar(&c.TLS.CAFile)},
+		{"TLS_CERT_FILE", stringVar(&c.TLS.CertFile)},
+		{"TLS_KEY_FILE", stringVar(&c.TLS.KeyFile)},
+		{"TLS_SERVER_NAME", stringVar(&c.TLS.ServerName)},
+		{"TLS_INSECURE_SKIP_VERIFY", boolVar(&c.TLS.InsecureSkipVerify)},
+		{"WS_READ_LIMIT", int64Var(&c.WSReadLimit)},
+		{"SUBSCRIPTION_BUFFER_SIZE", intVar(&c.SubscriptionBufferSize)},
+		{"SUBSCRIPTION_OVERFLOW_POLICY", overflowPolicyVar(&c.SubscriptionOverflowPolicy)},
+	}
+
+	for _, v := range vars {
+		name := v.name
+		if prefix != "" {
+			name = prefix + "_" + name
+		}
+
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			continue
+		}
+
+		if err := v.parse(value); err != nil {
+			return ErrInvalidEnvVar.WithMsg("%s: %v", name, err)
+		}
+	}
+
+	return nil
+}
+
+func stringVar(dst *string) func(string) error {
+	return func(s string) error {
+		*dst = s
+		return nil
+	}
+}
+
+func durationVar(dst *time.Duration) func(string) error {
+	return func(s string) (err error) {
+		*dst, err = time.ParseDuration(s)
+		return err
+	}
+}
+
+func intVar(dst *int) func(string) error {
+	return func(s string) (err error) {
+		*dst, err = strconv.Atoi(s)
+		return err
+	}
+}
+
+func int64Var(dst *int64) func(string) error {
+	return func(s string) (err error) {
+		*dst, err = strconv.ParseInt(s, 10, 64)
+		return err
+	}
+}
+
+func boolVar(dst *bool) func(string) error {
+	return func(s string) (err error) {
+		*dst, err = strconv.ParseBool(s)
+		return err
+	}
+}
+
+func overflowPolicyVar(dst *OverflowPolicy) func(string) error {
+	return func(s string) error {
+		p := OverflowPolicy(s)
+		if err := p.Validate(); err != nil {
+			return err
+		}
+
+		*dst = p
+		return nil
+	}
+}
+
+func (c *Config) headersVar(s string) error {
+	headers := make(map[string]string)
+
+	for _, pair := range strings.Split(s, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return ErrParseConfig.WithMsg("header %q is not a key=value pair", pair)
+		}
+
+		headers[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+
+	c.addHeaders(headers)
+
+	return nil
+}
@@ -0,0 +1,277 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package das
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
+	gethrpc "github.com/centrifuge/go-substrate-rpc-client/v4/gethrpc"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/kzg"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/kzg/kzgtest"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/rpc/chain"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/rpc/kate"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/rpcmocksrv"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+var (
+	testKate  kate.Kate
+	testChain chain.Chain
+	testSetup *kzg.TrustedSetup
+)
+
+func TestMain(m *testing.M) {
+	s := rpcmocksrv.New()
+	err := s.RegisterName("chain", &mockSrv)
+	if err != nil {
+		panic(err)
+	}
+	err = s.RegisterName("kate", &mockSrv)
+	if err != nil {
+		panic(err)
+	}
+
+	cl, err := client.Connect(s.URL)
+	if err != nil {
+		panic(err)
+	}
+	testKate = kate.NewKate(cl)
+	testChain = chain.NewChain(cl)
+
+	testSetup = kzgtest.Setup()
+
+	os.Exit(m.Run())
+}
+
+// testBlock is a block with an extended data matrix
+type testBlock struct {
+	number types.BlockNumber
+	hash   types.Hash
+	matrix *kzgtest.Matrix
+	// withheld blocks have proofs and rows that don't match their commitments
+	withheld bool
+	lookup   types.DataLookup
+}
+
+func newTestBlock(number uint32, rows int, cols uint32, withheld bool) *testBlock {
+	b := &testBlock{
+		number:   types.BlockNumber(number),
+		matrix:   kzgtest.NewMatrix(rows, cols, int64(number)),
+		withheld: withheld,
+	}
+	b.setHash()
+	return b
+}
+
+// setHash sets the hash of the block to the hash of its header
+func (b *testBlock) setHash() {
+	hash, err := b.header().Hash()
+	if err != nil {
+		panic(err)
+	}
+	b.hash = hash
+}
+
+// newDataBlock creates a block with the given data matrix, its columns are erasure-coded to twice their height
+func newDataBlock(number uint32, data [][]*big.Int, lookup types.DataLookup) *testBlock {
+	rows, cols := len(data), len(data[0])
+	b := newTestBlock(number, 0, uint32(cols), false)
+	b.lookup = lookup
+
+	extended := make([][]*big.Int, 2*rows)
+	for c := 0; c < cols; c++ {
+		column := make([]*big.Int, 2*rows)
+		for r := range column {
+			column[r] = new(big.Int)
+		}
+		for r := 0; r < rows; r++ {
+			column[r].Set(data[r][c])
+		}
+
+		coeffs := ifft(column[:rows], kzg.RootOfUnity(uint32(rows)))
+		copy(column, coeffs)
+		for r, v := range fft(column, kzg.RootOfUnity(uint32(2*rows))) {
+			extended[r] = append(extended[r], v)
+		}
+	}
+
+	for _, row := range extended {
+		b.matrix.Rows = append(b.matrix.Rows, ifft(row, kzg.RootOfUnity(uint32(cols))))
+	}
+	b.setHash()
+
+	return b
+}
+
+// row returns the contents of the given row of the extended matrix
+func (b *testBlock) row(row uint32) types.KateRow {
+	res := make(types.KateRow, b.matrix.Cols)
+	for c := range res {
+		res[c] = b.matrix.Scalar(types.NewCell(row, uint32(c)))
+		if b.withheld {
+			v := new(big.Int).SetBytes(res[c][:])
+			v.Add(v, big.NewInt(int64(row)+1)).FillBytes(res[c][:])
+		}
+	}
+	return res
+}
+
+func (b *testBlock) header() types.Header {
+	return types.Header{
+		ParentHash: types.NewHash([]byte{0xa0, 31: byte(b.number - 1)}),
+		Number:     b.number,
+		Extension: types.HeaderExtensionEnum{V3: types.V3HeaderExtension{
+			AppLookup:  b.lookup,
+			Commitment: b.matrix.Commitment(),
+		}},
+	}
+}
+
+func (b *testBlock) proof(cell types.Cell) types.CellProof {
+	p := b.matrix.Proof(cell)
+	if b.withheld {
+		p.Scalar[len(p.Scalar)-1] ^= 1
+	}
+	return p
+}
+
+// MockSrv holds data and methods exposed by the RPC Mock Server used in integration tests
+type MockSrv struct {
+	blocks []*testBlock
+	// dataBlocks are blocks with application data, they are not part of the finalized chain
+	dataBlocks []*testBlock
+}
+
+func (s *MockSrv) block(hash string) (*testBlock, error) {
+	for _, b := range append(s.blocks, s.dataBlocks...) {
+		if b.hash.Hex() == hash {
+			return b, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown block %s", hash)
+}
+
+func (s *MockSrv) SubscribeFinalizedHeads(ctx context.Context) (*gethrpc.Subscription, error) {
+	n, ok := gethrpc.NotifierFromContext(ctx)
+	if !ok {
+		return nil, gethrpc.ErrNotificationsUnsupported
+	}
+	sub := n.CreateSubscription()
+
+	go func() {
+		for _, b := range s.blocks {
+			_ = n.Notify(sub.ID, b.header())
+		}
+	}()
+
+	return sub, nil
+}
+
+func (s *MockSrv) UnsubscribeFinalizedHeads(id string) bool {
+	return true
+}
+
+func (s *MockSrv) QueryProof(cells []types.Cell, at string) ([]types.CellProof, error) {
+	b, err := s.block(at)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]types.CellProof, len(cells))
+	for i, c := range cells {
+		res[i] = b.proof(c)
+	}
+	return res, nil
+}
+
+// mockSrv sets default data used in tests. This data might become stale when substrate is updated – just run the tests
+// against real servers and update the values stored here. To do that, replace s.URL with
+// config.Default().RPCURL
+var mockSrv = MockSrv{
+	blocks: []*testBlock{
+		newTestBlock(10, 4, 8, false),
+		newTestBlock(11, 4, 8, true),
+		newTestBlock(12, 2, 4, false),
+		newTestBlock(13, 4, 8, false),
+	},
+	dataBlocks: []*testBlock{
+		newDataBlock(20, testData, testLookup),
+		withhold(newDataBlock(21, testData, testLookup)),
+	},
+}
+
+// testAppData is the data of the apps in the data blocks, app 2 has no data
+var testAppData = map[uint32][]byte{
+	0: []byte("avail"),
+	1: []byte(strings.Repeat("data availability sampling ", 5)),
+	2: {},
+}
+
+// testLookup is the data lookup of the data blocks, the data of app 0 spans one cell and the one of app 1 five cells
+var testLookup = types.DataLookup{
+	Size: types.NewUCompactFromUInt(6),
+	Index: []types.DataLookupIndexItem{
+		{AppId: types.NewUCompactFromUInt(0), Start: types.NewUCompactFromUInt(0)},
+		{AppId: types.NewUCompactFromUInt(1), Start: types.NewUCompactFromUInt(1)},
+		{AppId: types.NewUCompactFromUInt(2), Start: types.NewUCompactFromUInt(6)},
+	},
+}
+
+// testData is the data matrix of the data blocks, 2 rows of 4 columns holding the chunks of the app data
+var testData = func() [][]*big.Int {
+	var chunks [][]byte
+	for app := uint32(0); app < 2; app++ {
+		data := testAppData[app]
+		for len(data) > 0 {
+			n := len(data)
+			if n > ChunkSize {
+				n = ChunkSize
+			}
+			chunk := make([]byte, ChunkSize)
+			copy(chunk, data[:n])
+			chunks = append(chunks, chunk)
+			data = data[n:]
+		}
+	}
+
+	matrix := [][]*big.Int{make([]*big.Int, 4), make([]*big.Int, 4)}
+	for i := range matrix {
+		for j := range matrix[i] {
+			matrix[i][j] = new(big.Int)
+			if c := 4*i + j; c < len(chunks) {
+				// chunks are stored little-endian
+				le := make([]byte, len(chunks[c]))
+				for k, x := range chunks[c] {
+					le[len(le)-1-k] = x
+				}
+				matrix[i][j].SetBytes(le)
+			}
+		}
+	}
+	return matrix
+}()
+
+func withhold(b *testBlock) *testBlock {
+	b.withheld = true
+	return b
+}
@@ -0,0 +1,186 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package das
+
+import (
+	"math/big"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/kzg"
+)
+
+var (
+	// modulus is the modulus of the scalar field of the data matrix
+	modulus = kzg.ScalarModulus()
+	// cosetShift moves the evaluation domain to a coset that does not intersect it, the multiplicative generator of
+	// the scalar field
+	cosetShift = big.NewInt(7)
+)
+
+// fft evaluates the polynomial with the given coefficients at the powers of omega, a primitive root of unity of the
+// order of the number of coefficients, which must be a power of two
+func fft(coeffs []*big.Int, omega *big.Int) []*big.Int {
+	n := len(coeffs)
+	if n == 1 {
+		return []*big.Int{new(big.Int).Set(coeffs[0])}
+	}
+
+	even := make([]*big.Int, n/2)
+	odd := make([]*big.Int, n/2)
+	for i := 0; i < n/2; i++ {
+		even[i] = coeffs[2*i]
+		odd[i] = coeffs[2*i+1]
+	}
+
+	omega2 := new(big.Int).Mul(omega, omega)
+	omega2.Mod(omega2, modulus)
+	evenEvals := fft(even, omega2)
+	oddEvals := fft(odd, omega2)
+
+	res := make([]*big.Int, n)
+	w := big.NewInt(1)
+	for i := 0; i < n/2; i++ {
+		t := new(big.Int).Mul(w, oddEvals[i])
+		t.Mod(t, modulus)
+		res[i] = new(big.Int).Add(evenEvals[i], t)
+		res[i].Mod(res[i], modulus)
+		res[i+n/2] = new(big.Int).Sub(evenEvals[i], t)
+		res[i+n/2].Mod(res[i+n/2], modulus)
+		w.Mul(w, omega).Mod(w, modulus)
+	}
+
+	return res
+}
+
+// ifft returns the coefficients of the polynomial with the given evaluations at the powers of omega
+func ifft(evals []*big.Int, omega *big.Int) []*big.Int {
+	res := fft(evals, new(big.Int).ModInverse(omega, modulus))
+
+	nInv := new(big.Int).ModInverse(big.NewInt(int64(len(evals))), modulus)
+	for _, c := range res {
+		c.Mul(c, nInv).Mod(c, modulus)
+	}
+
+	return res
+}
+
+// scale multiplies the i-th coefficient by the i-th power of factor in place, which evaluates the polynomial on the
+// domain multiplied by factor
+func scale(coeffs []*big.Int, factor *big.Int) {
+	f := big.NewInt(1)
+	for _, c := range coeffs {
+		c.Mul(c, f).Mod(c, modulus)
+		f.Mul(f, factor).Mod(f, modulus)
+	}
+}
+
+// zeroPoly returns the coefficients, padded to n, of the polynomial that vanishes at the given powers of omega
+func zeroPoly(missing []int, omega *big.Int, n int) []*big.Int {
+	coeffs := make([]*big.Int, n)
+	for i := range coeffs {
+		coeffs[i] = new(big.Int)
+	}
+	coeffs[0].SetInt64(1)
+
+	// multiply by (x - omega^m) for every missing position m
+	for deg, m := range missing {
+		root := new(big.Int).Exp(omega, big.NewInt(int64(m)), modulus)
+		for i := deg + 1; i > 0; i-- {
+			t := new(big.Int).Mul(coeffs[i], root)
+			coeffs[i].Sub(coeffs[i-1], t).Mod(coeffs[i], modulus)
+		}
+		coeffs[0].Mul(coeffs[0], root).Neg(coeffs[0]).Mod(coeffs[0], modulus)
+	}
+
+	return coeffs
+}
+
+// recoverEvaluations recovers the missing evaluations of an erasure-coded vector. The vector holds the evaluations of
+// a polynomial of degree less than half its length at the powers of the root of unity of the order of its length,
+// missing evaluations are nil. At least half of the evaluations must be given. If more than half are given, they are
+// checked to be evaluations of the same polynomial, even if none are missing.
+//
+// The data polynomial D is recovered from the product E*Z, where E takes the given evaluations and 0 where they are
+// missing and Z vanishes exactly at the missing positions, so that E*Z = D*Z on the whole domain. D is then the
+// quotient (E*Z)/Z, computed on a coset of the domain where Z has no zeros.
+func recoverEvaluations(evals []*big.Int) ([]*big.Int, error) {
+	n := len(evals)
+	var missing []int
+	for i, e := range evals {
+		if e == nil {
+			missing = append(missing, i)
+		}
+	}
+
+	if len(missing) > n/2 {
+		return nil, ErrNotEnoughCells.WithMsg("got %d of %d cells, need %d", n-len(missing), n, n/2)
+	}
+
+	omega := kzg.RootOfUnity(uint32(n))
+
+	if len(missing) == 0 {
+		if err := checkDegree(ifft(evals, omega)); err != nil {
+			return nil, err
+		}
+		return evals, nil
+	}
+
+	zCoeffs := zeroPoly(missing, omega, n)
+	zEvals := fft(zCoeffs, omega)
+
+	ezEvals := make([]*big.Int, n)
+	for i, e := range evals {
+		if e == nil {
+			ezEvals[i] = new(big.Int)
+			continue
+		}
+		ezEvals[i] = new(big.Int).Mul(e, zEvals[i])
+		ezEvals[i].Mod(ezEvals[i], modulus)
+	}
+	ezCoeffs := ifft(ezEvals, omega)
+
+	scale(ezCoeffs, cosetShift)
+	scale(zCoeffs, cosetShift)
+	ezCoset := fft(ezCoeffs, omega)
+	zCoset := fft(zCoeffs, omega)
+
+	quotient := make([]*big.Int, n)
+	for i := range quotient {
+		quotient[i] = new(big.Int).ModInverse(zCoset[i], modulus)
+		quotient[i].Mul(quotient[i], ezCoset[i]).Mod(quotient[i], modulus)
+	}
+
+	dCoeffs := ifft(quotient, omega)
+	scale(dCoeffs, new(big.Int).ModInverse(cosetShift, modulus))
+
+	if err := checkDegree(dCoeffs); err != nil {
+		return nil, err
+	}
+
+	return fft(dCoeffs, omega), nil
+}
+
+// checkDegree checks that the polynomial with the given coefficients has a degree less than half their number, the
+// evaluations it was recovered from are only consistent then
+func checkDegree(coeffs []*big.Int) error {
+	n := len(coeffs)
+	for i := n - 1; i >= n/2; i-- {
+		if coeffs[i].Sign() != 0 {
+			return ErrInconsistentCells.WithMsg("recovered polynomial has degree %d, expected less than %d", i, n/2)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package das
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/kzg"
+	"github.com/stretchr/testify/assert"
+)
+
+func testPoly(n int) []*big.Int {
+	coeffs := make([]*big.Int, n)
+	for i := range coeffs {
+		coeffs[i] = big.NewInt(int64(1000 + i*i))
+	}
+	return coeffs
+}
+
+// testExtension returns the evaluations of a polynomial of degree less than n on the domain of size 2n
+func testExtension(n int) []*big.Int {
+	coeffs := append(testPoly(n), testPoly(n)...)
+	for i := n; i < 2*n; i++ {
+		coeffs[i] = new(big.Int)
+	}
+	return fft(coeffs, kzg.RootOfUnity(uint32(2*n)))
+}
+
+func TestFFT(t *testing.T) {
+	for _, n := range []int{1, 2, 8, 32} {
+		coeffs := testPoly(n)
+		omega := kzg.RootOfUnity(uint32(n))
+
+		evals := fft(coeffs, omega)
+		for i, e := range evals {
+			x := new(big.Int).Exp(omega, big.NewInt(int64(i)), modulus)
+			y := new(big.Int)
+			for j := n - 1; j >= 0; j-- {
+				y.Mul(y, x).Add(y, coeffs[j]).Mod(y, modulus)
+			}
+			assert.Equal(t, y, e)
+		}
+
+		assert.Equal(t, coeffs, ifft(evals, omega))
+	}
+}
+
+func TestRecoverEvaluations(t *testing.T) {
+	r := rand.New(rand.NewSource(1)) //nolint:gosec
+	for _, n := range []int{1, 2, 8, 32} {
+		expected := testExtension(n)
+
+		for _, missing := range []int{0, 1, n / 2, n} {
+			evals := make([]*big.Int, 2*n)
+			copy(evals, expected)
+			for _, i := range r.Perm(2 * n)[:missing] {
+				evals[i] = nil
+			}
+
+			res, err := recoverEvaluations(evals)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, res)
+		}
+	}
+}
+
+func TestRecoverEvaluations_NotEnough(t *testing.T) {
+	evals := testExtension(4)
+	evals[1], evals[2], evals[3], evals[5], evals[7] = nil, nil, nil, nil, nil
+
+	_, err := recoverEvaluations(evals)
+	assert.ErrorIs(t, err, ErrNotEnoughCells)
+}
+
+func TestRecoverEvaluations_Inconsistent(t *testing.T) {
+	evals := testExtension(4)
+	evals[0].Add(evals[0], big.NewInt(1))
+	evals[1], evals[2], evals[5] = nil, nil, nil
+
+	_, err := recoverEvaluations(evals)
+	assert.ErrorIs(t, err, ErrInconsistentCells)
+}
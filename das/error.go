@@ -0,0 +1,11 @@
+package das
+
+import libErr "github.com/centrifuge/go-substrate-rpc-client/v4/error"
+
+const (
+	ErrNotEnoughCells    = libErr.Error("not enough cells to reconstruct the data")
+	ErrInconsistentCells = libErr.Error("cells are not part of the same extended matrix")
+	ErrUnknownApp        = libErr.Error("app not found in the data lookup")
+	ErrInvalidLookup     = libErr.Error("invalid data lookup")
+	ErrRecoveryFailed    = libErr.Error("column could not be recovered")
+)
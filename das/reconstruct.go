@@ -0,0 +1,203 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package das
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/kzg"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/rpc/kate"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+// ChunkSize is the number of bytes of application data stored in a cell. Data is split into chunks of 31 bytes, which
+// are stored in the scalars of the cells in little-endian order, so that every chunk is a valid field element.
+const ChunkSize = 31
+
+// CellData is a cell of the extended data matrix with its content
+type CellData struct {
+	Cell   types.Cell
+	Scalar types.KateScalar
+}
+
+// Matrix is the original data matrix of a block, as recovered from cells of its extended matrix
+type Matrix struct {
+	Rows uint32
+	Cols uint32
+	// Scalars are the contents of the cells, row by row
+	Scalars [][]types.KateScalar
+
+	lookup types.DataLookup
+}
+
+// Reconstruct recovers the original data matrix of the block with the given header from cells of its extended
+// matrix.
+//
+// Avail extends the data matrix per column, not per row: each column is erasure-coded to twice its height, so the
+// extended matrix has twice the rows and the same columns as the original one. The extended column holds the
+// evaluations of the polynomial interpolating the original column at the roots of unity of the order of the extended
+// height, with the original cells at the even rows. At least half of the cells of every extended column are needed,
+// which is the case if at least half of the rows of the extended matrix are given.
+//
+// The cells are used as given. If more than half of the cells of a column are given, the reconstruction fails unless
+// they all lie on the same polynomial, but only cells with a verified proof guarantee that the data is the one the
+// block commits to.
+func Reconstruct(header types.Header, cells []CellData) (*Matrix, error) {
+	c := header.Extension.V3.Commitment
+	if len(c.Commitment) == 0 {
+		return nil, kzg.ErrMissingExtension
+	}
+
+	rows := uint32(c.Rows.Int64())
+	cols := uint32(c.Cols.Int64())
+	if rows == 0 || rows&(rows-1) != 0 || cols == 0 || cols&(cols-1) != 0 {
+		return nil, kzg.ErrInvalidDimensions.WithMsg("rows and columns must be powers of two, got %d rows and %d "+
+			"columns", rows, cols)
+	}
+
+	extended := make([][]*big.Int, cols)
+	for i := range extended {
+		extended[i] = make([]*big.Int, 2*rows)
+	}
+
+	for _, cell := range cells {
+		row, col := uint32(cell.Cell.Row), uint32(cell.Cell.Col)
+		if row >= 2*rows || col >= cols {
+			return nil, kzg.ErrCellOutOfRange.WithMsg("cell (%d, %d), extended matrix of %d rows and %d columns", row,
+				col, 2*rows, cols)
+		}
+
+		v := new(big.Int).SetBytes(cell.Scalar[:])
+		if v.Cmp(modulus) >= 0 {
+			return nil, kzg.ErrInvalidScalar.WithMsg("scalar of cell (%d, %d) exceeds the field modulus", row, col)
+		}
+
+		if prev := extended[col][row]; prev != nil && prev.Cmp(v) != 0 {
+			return nil, ErrInconsistentCells.WithMsg("cell (%d, %d) given with different contents", row, col)
+		}
+		extended[col][row] = v
+	}
+
+	m := &Matrix{
+		Rows:    rows,
+		Cols:    cols,
+		Scalars: make([][]types.KateScalar, rows),
+		lookup:  header.Extension.V3.AppLookup,
+	}
+	for i := range m.Scalars {
+		m.Scalars[i] = make([]types.KateScalar, cols)
+	}
+
+	for col, evals := range extended {
+		recovered, err := recoverEvaluations(evals)
+		if err != nil {
+			return nil, ErrRecoveryFailed.WithMsg("column %d", col).Wrap(err)
+		}
+
+		for row := range m.Scalars {
+			recovered[2*row].FillBytes(m.Scalars[row][col][:])
+		}
+	}
+
+	return m, nil
+}
+
+// Chunk returns the application data stored in the cell with the given index, cells are indexed row by row
+func (m *Matrix) Chunk(i uint32) []byte {
+	s := m.Scalars[i/m.Cols][i%m.Cols]
+
+	chunk := make([]byte, ChunkSize)
+	for j := range chunk {
+		chunk[j] = s[len(s)-1-j]
+	}
+	return chunk
+}
+
+// AppData returns the data of the app with the given id, as found through the data lookup of the block header. The
+// data is returned as stored in the chunks of the app, including the padding of the app extrinsics.
+func (m *Matrix) AppData(appID uint32) ([]byte, error) {
+	size := uint32(m.lookup.Size.Int64())
+	if size > m.Rows*m.Cols {
+		return nil, ErrInvalidLookup.WithMsg("size %d exceeds the %d cells of the matrix", size, m.Rows*m.Cols)
+	}
+
+	for i, item := range m.lookup.Index {
+		if uint32(item.AppId.Int64()) != appID {
+			continue
+		}
+
+		start, end := uint32(item.Start.Int64()), size
+		if i+1 < len(m.lookup.Index) {
+			end = uint32(m.lookup.Index[i+1].Start.Int64())
+		}
+
+		if start > end || end > size {
+			return nil, ErrInvalidLookup.WithMsg("app %d spans cells %d to %d of %d", appID, start, end, size)
+		}
+
+		data := make([]byte, 0, (end-start)*ChunkSize)
+		for j := start; j < end; j++ {
+			data = append(data, m.Chunk(j)...)
+		}
+		return data, nil
+	}
+
+	return nil, ErrUnknownApp.WithMsg("app %d", appID)
+}
+
+// Reconstructor recovers the data of blocks from cells served by the kate RPC, only cells with a valid proof are used.
+// Rows served by kate_queryRows come without proofs, so they are not used for reconstruction.
+type Reconstructor struct {
+	kate  kate.Kate
+	setup *kzg.TrustedSetup
+}
+
+// NewReconstructor creates a new Reconstructor that verifies cells with the given trusted setup
+func NewReconstructor(k kate.Kate, setup *kzg.TrustedSetup) *Reconstructor {
+	return &Reconstructor{kate: k, setup: setup}
+}
+
+// ReconstructFromCells recovers the data matrix of the block with the given header and hash from the given cells of
+// its extended matrix, fetched with kate_queryProof. Only cells with a valid proof are used, at least half of the cells
+// of every column of the extended matrix must be valid.
+func (r *Reconstructor) ReconstructFromCells(ctx context.Context, header types.Header, blockHash types.Hash,
+	cells []types.Cell) (*Matrix, error) {
+	v, err := kzg.NewHeaderVerifier(r.setup, header)
+	if err != nil {
+		return nil, err
+	}
+
+	proofs, err := r.kate.QueryProofContext(ctx, cells, blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := v.VerifyCells(cells, proofs)
+	if err != nil {
+		return nil, err
+	}
+
+	var valid []CellData
+	for i, res := range results {
+		if res.Valid() {
+			valid = append(valid, CellData{Cell: res.Cell, Scalar: proofs[i].Scalar})
+		}
+	}
+
+	return Reconstruct(header, valid)
+}
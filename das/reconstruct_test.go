@@ -0,0 +1,197 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package das
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/kzg"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/kzg/kzgtest"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// paddedAppData returns the data of the given app as stored in whole chunks
+func paddedAppData(app uint32) []byte {
+	data := testAppData[app]
+	return append(append([]byte{}, data...), make([]byte, (ChunkSize-len(data)%ChunkSize)%ChunkSize)...)
+}
+
+func testCells(b *testBlock, rows ...uint32) []CellData {
+	var cells []CellData
+	for _, r := range rows {
+		for c, s := range b.row(r) {
+			cells = append(cells, CellData{Cell: types.NewCell(r, uint32(c)), Scalar: s})
+		}
+	}
+	return cells
+}
+
+func assertAppData(t *testing.T, m *Matrix) {
+	for app := range testAppData {
+		data, err := m.AppData(app)
+		assert.NoError(t, err)
+		assert.Equal(t, paddedAppData(app), data)
+	}
+}
+
+func TestReconstruct(t *testing.T) {
+	b := mockSrv.dataBlocks[0]
+
+	for _, rows := range [][]uint32{{0, 1, 2, 3}, {0, 2}, {1, 3}, {0, 3}, {3, 2, 1}} {
+		m, err := Reconstruct(b.header(), testCells(b, rows...))
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(2), m.Rows)
+		assert.Equal(t, uint32(4), m.Cols)
+
+		for r := range testData {
+			for c := range testData[r] {
+				assert.Equal(t, testData[r][c].FillBytes(make([]byte, 32)), m.Scalars[r][c][:])
+			}
+		}
+
+		assertAppData(t, m)
+	}
+}
+
+func TestReconstruct_Cells(t *testing.T) {
+	b := mockSrv.dataBlocks[0]
+
+	// every column needs half of its cells, but not necessarily from the same rows
+	cells := testCells(b, 0, 1, 2, 3)
+	var half []CellData
+	for _, c := range cells {
+		if (c.Cell.Row+c.Cell.Col)%2 == 0 {
+			half = append(half, c)
+		}
+	}
+
+	m, err := Reconstruct(b.header(), half)
+	assert.NoError(t, err)
+	assertAppData(t, m)
+
+	_, err = Reconstruct(b.header(), half[1:])
+	assert.ErrorIs(t, err, ErrNotEnoughCells)
+	assert.ErrorIs(t, err, ErrRecoveryFailed)
+}
+
+func TestReconstruct_Errors(t *testing.T) {
+	b := mockSrv.dataBlocks[0]
+	header := b.header()
+
+	_, err := Reconstruct(types.Header{}, nil)
+	assert.Equal(t, kzg.ErrMissingExtension, err)
+
+	_, err = Reconstruct(header, testCells(b, 1))
+	assert.ErrorIs(t, err, ErrNotEnoughCells)
+
+	_, err = Reconstruct(header, append(testCells(b, 0, 2), CellData{Cell: types.NewCell(4, 0)}))
+	assert.ErrorIs(t, err, kzg.ErrCellOutOfRange)
+
+	cells := testCells(b, 0, 2)
+	cells[0].Scalar[0] = 0xff
+	_, err = Reconstruct(header, cells)
+	assert.ErrorIs(t, err, kzg.ErrInvalidScalar)
+
+	withheld := mockSrv.dataBlocks[1]
+	_, err = Reconstruct(header, append(testCells(b, 0, 1), testCells(withheld, 2)...))
+	assert.ErrorIs(t, err, ErrInconsistentCells)
+
+	cells = testCells(b, 0, 2)
+	cells = append(cells, testCells(withheld, 0)...)
+	_, err = Reconstruct(header, cells)
+	assert.ErrorIs(t, err, ErrInconsistentCells)
+
+	// a single altered cell in a complete matrix
+	cells = testCells(b, 0, 1, 2, 3)
+	cells[5].Scalar[31] ^= 1
+	_, err = Reconstruct(header, cells)
+	assert.ErrorIs(t, err, ErrInconsistentCells)
+
+	header.Extension.V3.Commitment.Rows = types.NewUCompactFromUInt(3)
+	_, err = Reconstruct(header, testCells(b, 0, 1, 2))
+	assert.ErrorIs(t, err, kzg.ErrInvalidDimensions)
+}
+
+func TestMatrix_AppData(t *testing.T) {
+	b := mockSrv.dataBlocks[0]
+	m, err := Reconstruct(b.header(), testCells(b, 0, 2))
+	assert.NoError(t, err)
+
+	assert.Equal(t, paddedAppData(0), m.Chunk(0))
+
+	_, err = m.AppData(3)
+	assert.ErrorIs(t, err, ErrUnknownApp)
+
+	m.lookup.Size = types.NewUCompactFromUInt(9)
+	_, err = m.AppData(1)
+	assert.ErrorIs(t, err, ErrInvalidLookup)
+
+	m.lookup.Size = types.NewUCompactFromUInt(4)
+	_, err = m.AppData(1)
+	assert.ErrorIs(t, err, ErrInvalidLookup)
+}
+
+func TestReconstructor_ReconstructFromCells(t *testing.T) {
+	r := NewReconstructor(testKate, testSetup)
+	b := mockSrv.dataBlocks[0]
+
+	var cells []types.Cell
+	for _, c := range testCells(b, 0, 3) {
+		cells = append(cells, c.Cell)
+	}
+
+	m, err := r.ReconstructFromCells(context.Background(), b.header(), b.hash, cells)
+	assert.NoError(t, err)
+	assertAppData(t, m)
+
+	// the cells of the withheld block have invalid proofs and are discarded
+	withheld := mockSrv.dataBlocks[1]
+	_, err = r.ReconstructFromCells(context.Background(), withheld.header(), withheld.hash, cells)
+	assert.ErrorIs(t, err, ErrNotEnoughCells)
+
+	_, err = r.ReconstructFromCells(context.Background(), types.Header{}, b.hash, cells)
+	assert.Equal(t, kzg.ErrMissingExtension, err)
+}
+
+func TestReconstruct_AvailFixture(t *testing.T) {
+	f := kzgtest.LoadAvailFixture(t)
+
+	v, err := kzg.NewHeaderVerifier(f.Setup, f.Header)
+	assert.NoError(t, err)
+
+	res, err := v.VerifyCells(f.Cells, f.Proofs)
+	assert.NoError(t, err)
+
+	cells := make([]CellData, len(res))
+	for i, r := range res {
+		assert.NoError(t, r.Err)
+		cells[i] = CellData{Cell: r.Cell, Scalar: f.Proofs[i].Scalar}
+	}
+
+	m, err := Reconstruct(f.Header, cells)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the app data holds the submitted extrinsic, encoded and padded to whole chunks
+	data, err := m.AppData(f.AppID)
+	assert.NoError(t, err)
+	assert.True(t, bytes.Contains(data, f.AppData), "app data %#x does not contain %#x", data, f.AppData)
+}
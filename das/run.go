@@ -0,0 +1,166 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package das
+
+import (
+	"context"
+	"sync"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/rpc/chain"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+// Block is a block to be sampled
+type Block struct {
+	Hash   types.Hash
+	Header types.Header
+}
+
+// Run samples the blocks received from blocks with a pool of workers and sends their results to the returned channel,
+// in the order in which sampling completes. The channel is closed once blocks is closed and all of its blocks have
+// been sampled, or when ctx is done.
+func (s *Sampler) Run(ctx context.Context, blocks <-chan Block) <-chan Result {
+	results := make(chan Result)
+
+	var wg sync.WaitGroup
+	s.startWorkers(ctx, &wg, blocks, results)
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	return results
+}
+
+func (s *Sampler) startWorkers(ctx context.Context, wg *sync.WaitGroup, blocks <-chan Block, results chan<- Result) {
+	for i := 0; i < s.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case b, ok := <-blocks:
+					if !ok {
+						return
+					}
+
+					select {
+					case results <- s.SampleBlock(ctx, b.Header, b.Hash):
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}()
+	}
+}
+
+// Sampling is the sampling of finalized blocks started by SampleFinalized
+type Sampling struct {
+	results chan Result
+	err     chan error
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+// Results returns the channel that receives the result of every finalized block. It is closed when the sampling is
+// stopped or the subscription failed.
+func (s *Sampling) Results() <-chan Result {
+	return s.results
+}
+
+// Err returns a channel that receives the error of the finalized heads subscription if it ended
+func (s *Sampling) Err() <-chan error {
+	return s.err
+}
+
+// Stop stops sampling and waits until all workers have returned. It can safely be called more than once.
+func (s *Sampling) Stop() {
+	s.cancel()
+	<-s.done
+}
+
+// SampleFinalized subscribes to the finalized heads of c and samples every finalized block with the workers of the
+// sampler, until ctx is done, Stop is called or the subscription fails. The hash of every block is computed from its
+// header rather than looked up from the node, so that the proofs are queried for the block the commitments belong to.
+func (s *Sampler) SampleFinalized(ctx context.Context, c chain.Chain) (*Sampling, error) {
+	sub, err := c.SubscribeFinalizedHeadsContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	sampling := &Sampling{
+		results: make(chan Result),
+		err:     make(chan error, 1),
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+
+	blocks := make(chan Block)
+	var wg sync.WaitGroup
+	s.startWorkers(ctx, &wg, blocks, sampling.results)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(blocks)
+		defer sub.Unsubscribe()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case err := <-sub.Err():
+				sampling.err <- err
+				return
+			case header, ok := <-sub.Chan():
+				if !ok {
+					return
+				}
+
+				hash, err := header.Hash()
+				if err != nil {
+					select {
+					case sampling.results <- Result{BlockNumber: header.Number, Err: err}:
+					case <-ctx.Done():
+						return
+					}
+					continue
+				}
+
+				select {
+				case blocks <- Block{Hash: hash, Header: header}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(sampling.results)
+		close(sampling.done)
+	}()
+
+	return sampling, nil
+}
@@ -0,0 +1,98 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package das
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSampler_Run(t *testing.T) {
+	s := NewSampler(testKate, testSetup, Options{Samples: 3, Workers: 2})
+
+	blocks := make(chan Block, len(mockSrv.blocks))
+	for _, b := range mockSrv.blocks {
+		blocks <- Block{Hash: b.hash, Header: b.header()}
+	}
+	close(blocks)
+
+	available := map[types.BlockNumber]bool{}
+	for res := range s.Run(context.Background(), blocks) {
+		assert.NoError(t, res.Err)
+		available[res.BlockNumber] = res.Available()
+	}
+
+	assert.Equal(t, map[types.BlockNumber]bool{10: true, 11: false, 12: true, 13: true}, available)
+}
+
+func TestSampler_Run_Cancel(t *testing.T) {
+	s := NewSampler(testKate, testSetup, Options{Workers: 2})
+	ctx, cancel := context.WithCancel(context.Background())
+
+	results := s.Run(ctx, make(chan Block))
+	cancel()
+
+	select {
+	case _, ok := <-results:
+		assert.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("results not closed")
+	}
+}
+
+func TestSampler_SampleFinalized(t *testing.T) {
+	s := NewSampler(testKate, testSetup, Options{Samples: 3, Workers: 2})
+
+	sampling, err := s.SampleFinalized(context.Background(), testChain)
+	assert.NoError(t, err)
+
+	results := map[types.BlockNumber]Result{}
+	for len(results) < len(mockSrv.blocks) {
+		select {
+		case res := <-sampling.Results():
+			results[res.BlockNumber] = res
+		case err := <-sampling.Err():
+			t.Fatal(err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("missing results")
+		}
+	}
+
+	sampling.Stop()
+	sampling.Stop()
+
+	_, ok := <-sampling.Results()
+	assert.False(t, ok)
+
+	assert.True(t, results[10].Available())
+	assert.Equal(t, mockSrv.blocks[0].hash, results[10].BlockHash)
+	assert.False(t, results[11].Available())
+	assert.NoError(t, results[11].Err)
+	assert.True(t, results[12].Available())
+	assert.NoError(t, results[13].Err)
+	assert.True(t, results[13].Available())
+
+	for _, b := range mockSrv.blocks {
+		hash, err := b.header().Hash()
+		assert.NoError(t, err)
+		assert.Equal(t, hash, results[b.number].BlockHash)
+	}
+}
@@ -0,0 +1,186 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package das implements data availability sampling of Avail blocks: random cells of the extended data matrix of a
+// block are fetched with their KZG proofs and verified against the commitments in the block header. The data of a
+// block can be reconstructed from any half of the rows of its extended matrix, which extends every column of the data
+// matrix to twice its height, so that it stays available when nodes withhold rows.
+// The confidence of a sample is only meaningful if the proofs are verified with the SRS the network commits with, see
+// package kzg for how far the verifier has been checked against real Avail blocks.
+package das
+
+import (
+	"context"
+	crand "crypto/rand"
+	"encoding/binary"
+	"math"
+	"math/rand"
+	"sync"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/kzg"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/rpc/kate"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+const (
+	// DefaultSamples is the number of cells sampled per block if not set in the options
+	DefaultSamples = 10
+	// DefaultWorkers is the number of blocks sampled concurrently if not set in the options
+	DefaultWorkers = 4
+)
+
+// Options configure a Sampler
+type Options struct {
+	// Samples is the number of cells sampled per block
+	Samples int
+	// Workers is the number of blocks that are sampled concurrently by Run and SampleFinalized
+	Workers int
+	// Rand is the source used to pick the cells. It defaults to a source seeded from crypto/rand, the cells must not be
+	// predictable for the node serving the proofs.
+	Rand rand.Source
+}
+
+// Result is the result of sampling a block
+type Result struct {
+	BlockNumber types.BlockNumber
+	BlockHash   types.Hash
+	// Cells are the results of the verification of the sampled cells
+	Cells []kzg.CellResult
+	// Verified is the number of cells with a valid proof
+	Verified int
+	// Confidence is the probability that the block is available, it is 0 if any cell failed verification
+	Confidence float64
+	// Err is set if the block could not be sampled
+	Err error
+}
+
+// Available returns true if all sampled cells have been verified
+func (r Result) Available() bool {
+	return r.Err == nil && len(r.Cells) > 0 && r.Verified == len(r.Cells)
+}
+
+// Confidence returns the probability that a block is available after the given number of cells of its extended data
+// matrix have been verified. Any half of the extended matrix is enough to reconstruct the data, so every verified cell
+// halves the chance that the data is withheld.
+func Confidence(verified int) float64 {
+	return 1 - math.Pow(0.5, float64(verified))
+}
+
+// Sampler samples blocks with the cells and proofs served by the kate RPC
+type Sampler struct {
+	kate    kate.Kate
+	setup   *kzg.TrustedSetup
+	samples int
+	workers int
+
+	mu   sync.Mutex
+	rand *rand.Rand
+}
+
+// NewSampler creates a new Sampler that verifies cells with the given trusted setup
+func NewSampler(k kate.Kate, setup *kzg.TrustedSetup, opts Options) *Sampler {
+	if opts.Samples <= 0 {
+		opts.Samples = DefaultSamples
+	}
+
+	if opts.Workers <= 0 {
+		opts.Workers = DefaultWorkers
+	}
+
+	if opts.Rand == nil {
+		var seed [8]byte
+		_, _ = crand.Read(seed[:])
+		opts.Rand = rand.NewSource(int64(binary.LittleEndian.Uint64(seed[:])))
+	}
+
+	return &Sampler{
+		kate:    k,
+		setup:   setup,
+		samples: opts.Samples,
+		workers: opts.Workers,
+		rand:    rand.New(opts.Rand), //nolint:gosec
+	}
+}
+
+// SampleBlock samples the block with the given header and hash
+func (s *Sampler) SampleBlock(ctx context.Context, header types.Header, blockHash types.Hash) Result {
+	res := Result{BlockNumber: header.Number, BlockHash: blockHash}
+
+	commitments, err := kzg.HeaderCommitments(header)
+	if err != nil {
+		res.Err = err
+		return res
+	}
+
+	cells := s.randomCells(uint32(len(commitments.Rows)), commitments.Cols)
+
+	proofs, err := s.kate.QueryProofContext(ctx, cells, blockHash)
+	if err != nil {
+		res.Err = err
+		return res
+	}
+
+	res.Cells, err = kzg.NewVerifier(s.setup, commitments).VerifyCells(cells, proofs)
+	if err != nil {
+		res.Err = err
+		return res
+	}
+
+	for _, c := range res.Cells {
+		if c.Valid() {
+			res.Verified++
+		}
+	}
+
+	if res.Verified == len(res.Cells) {
+		res.Confidence = Confidence(res.Verified)
+	}
+
+	return res
+}
+
+// randomCells picks distinct random cells of a matrix with the given dimensions, or all cells if the matrix has less
+// cells than the number of samples
+func (s *Sampler) randomCells(rows, cols uint32) []types.Cell {
+	total := int(rows) * int(cols)
+	cell := func(i int) types.Cell {
+		return types.NewCell(uint32(i)/cols, uint32(i)%cols)
+	}
+
+	if total <= s.samples {
+		cells := make([]types.Cell, total)
+		for i := range cells {
+			cells[i] = cell(i)
+		}
+		return cells
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	picked := make(map[int]struct{}, s.samples)
+	cells := make([]types.Cell, 0, s.samples)
+	for len(cells) < s.samples {
+		i := s.rand.Intn(total)
+		if _, ok := picked[i]; ok {
+			continue
+		}
+		picked[i] = struct{}{}
+		cells = append(cells, cell(i))
+	}
+
+	return cells
+}
@@ -0,0 +1,93 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package das
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/kzg"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfidence(t *testing.T) {
+	assert.Equal(t, 0.0, Confidence(0))
+	assert.Equal(t, 0.5, Confidence(1))
+	assert.Equal(t, 0.875, Confidence(3))
+	assert.InDelta(t, 0.999, Confidence(10), 0.001)
+}
+
+func TestSampler_SampleBlock(t *testing.T) {
+	s := NewSampler(testKate, testSetup, Options{Samples: 6, Rand: rand.NewSource(1)})
+	b := mockSrv.blocks[0]
+
+	res := s.SampleBlock(context.Background(), b.header(), b.hash)
+	assert.NoError(t, res.Err)
+	assert.Equal(t, b.number, res.BlockNumber)
+	assert.Equal(t, b.hash, res.BlockHash)
+	assert.Len(t, res.Cells, 6)
+	assert.Equal(t, 6, res.Verified)
+	assert.Equal(t, Confidence(6), res.Confidence)
+	assert.True(t, res.Available())
+
+	seen := map[types.Cell]bool{}
+	for _, c := range res.Cells {
+		assert.Less(t, uint32(c.Cell.Row), uint32(4))
+		assert.Less(t, uint32(c.Cell.Col), uint32(8))
+		assert.False(t, seen[c.Cell], "cell sampled twice")
+		seen[c.Cell] = true
+	}
+}
+
+func TestSampler_SampleBlock_Withheld(t *testing.T) {
+	s := NewSampler(testKate, testSetup, Options{Samples: 4})
+	b := mockSrv.blocks[1]
+
+	res := s.SampleBlock(context.Background(), b.header(), b.hash)
+	assert.NoError(t, res.Err)
+	assert.Len(t, res.Cells, 4)
+	assert.Equal(t, 0, res.Verified)
+	assert.Equal(t, 0.0, res.Confidence)
+	assert.False(t, res.Available())
+	for _, c := range res.Cells {
+		assert.ErrorIs(t, c.Err, kzg.ErrInvalidProof)
+	}
+}
+
+func TestSampler_SampleBlock_SmallMatrix(t *testing.T) {
+	s := NewSampler(testKate, testSetup, Options{Samples: 100})
+	b := mockSrv.blocks[2]
+
+	res := s.SampleBlock(context.Background(), b.header(), b.hash)
+	assert.NoError(t, res.Err)
+	assert.Len(t, res.Cells, 8)
+	assert.True(t, res.Available())
+}
+
+func TestSampler_SampleBlock_Errors(t *testing.T) {
+	s := NewSampler(testKate, testSetup, Options{})
+
+	res := s.SampleBlock(context.Background(), types.Header{Number: 1}, types.Hash{})
+	assert.Equal(t, kzg.ErrMissingExtension, res.Err)
+	assert.False(t, res.Available())
+
+	res = s.SampleBlock(context.Background(), mockSrv.blocks[0].header(), types.NewHash([]byte{0xff}))
+	assert.Error(t, res.Err)
+	assert.Equal(t, 0.0, res.Confidence)
+}
@@ -0,0 +1,225 @@
+package error
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Error codes of the transaction pool, as returned by the author RPCs of Substrate nodes
+const (
+	codeBadFormat                  = 1001
+	codeInvalidTransaction         = 1010
+	codeUnknownTransactionValidity = 1011
+	codeTemporarilyBanned          = 1012
+	codeAlreadyImported            = 1013
+	codePriorityTooLow             = 1014
+	codeCycleDetected              = 1015
+	codeImmediatelyDropped         = 1016
+	codeUnactionable               = 1017
+	codeNoTagsProvided             = 1018
+	codeInvalidBlockID             = 1019
+)
+
+const (
+	ErrBadFormat                  = Error("extrinsic has invalid format")
+	ErrUnknownTransactionValidity = Error("unknown transaction validity")
+	ErrTemporarilyBanned          = Error("transaction is temporarily banned")
+	ErrAlreadyImported            = Error("transaction already imported")
+	ErrPriorityTooLow             = Error("priority is too low")
+	ErrCycleDetected              = Error("cycle detected")
+	ErrImmediatelyDropped         = Error("transaction immediately dropped")
+	ErrUnactionable               = Error("transaction is unactionable")
+	ErrNoTagsProvided             = Error("transaction does not provide any tags")
+	ErrUnknownBlock               = Error("unknown block")
+
+	errInvalidTransaction = Error("invalid transaction")
+)
+
+// InvalidTransactionReason is the reason the runtime gave for rejecting a transaction as invalid
+type InvalidTransactionReason int
+
+const (
+	InvalidTransactionUnknown InvalidTransactionReason = iota
+	InvalidTransactionCall
+	InvalidTransactionPayment
+	InvalidTransactionFuture
+	InvalidTransactionStale
+	InvalidTransactionBadProof
+	InvalidTransactionAncientBirthBlock
+	InvalidTransactionExhaustsResources
+	InvalidTransactionCustom
+	InvalidTransactionBadMandatory
+	InvalidTransactionMandatoryValidation
+	InvalidTransactionBadSigner
+)
+
+var invalidTransactionReasons = map[InvalidTransactionReason]struct {
+	name string
+	data string
+}{
+	InvalidTransactionCall:              {"call", "Transaction call is not expected"},
+	InvalidTransactionPayment:           {"payment", "Inability to pay some fees (e.g. account balance too low)"},
+	InvalidTransactionFuture:            {"future", "Transaction will be valid in the future"},
+	InvalidTransactionStale:             {"stale", "Transaction is outdated"},
+	InvalidTransactionBadProof:          {"bad proof", "Transaction has a bad signature"},
+	InvalidTransactionAncientBirthBlock: {"ancient birth block", "Transaction has an ancient birth block"},
+	InvalidTransactionExhaustsResources: {"exhausts resources", "Transaction would exhaust the block limits"},
+	InvalidTransactionCustom:            {"custom", "InvalidTransaction custom error"},
+	InvalidTransactionBadMandatory: {
+		"bad mandatory", "A call was labelled as mandatory, but resulted in an Error.",
+	},
+	InvalidTransactionMandatoryValidation: {
+		"mandatory validation", "Transaction dispatch is mandatory; transactions must not be validated.",
+	},
+	InvalidTransactionBadSigner: {"bad signer", "Invalid signing address"},
+}
+
+func (r InvalidTransactionReason) String() string {
+	if reason, ok := invalidTransactionReasons[r]; ok {
+		return reason.name
+	}
+
+	return "unknown"
+}
+
+var customErrorData = regexp.MustCompile(`^Custom error: (\d+)$`)
+
+// parseInvalidTransaction returns the error for the data of an invalid transaction error object
+func parseInvalidTransaction(data interface{}) ErrInvalidTransaction {
+	s, ok := data.(string)
+	if !ok {
+		return ErrInvalidTransaction{}
+	}
+
+	if m := customErrorData.FindStringSubmatch(s); m != nil {
+		if n, err := strconv.ParseUint(m[1], 10, 8); err == nil {
+			return ErrInvalidTransaction{Reason: InvalidTransactionCustom, Custom: uint8(n)}
+		}
+	}
+
+	for r, reason := range invalidTransactionReasons {
+		if reason.data == s {
+			return ErrInvalidTransaction{Reason: r}
+		}
+	}
+
+	return ErrInvalidTransaction{}
+}
+
+// ErrInvalidTransaction is returned when the runtime rejected a transaction as invalid, e.g. because its nonce is
+// stale or the sender cannot pay the fees. Use errors.As to retrieve the reason.
+type ErrInvalidTransaction struct {
+	Reason InvalidTransactionReason
+	// Custom is the error code defined by the runtime, it is only set if Reason is InvalidTransactionCustom
+	Custom uint8
+}
+
+func (e ErrInvalidTransaction) Error() string {
+	if e.Reason == InvalidTransactionCustom {
+		return errInvalidTransaction.WithMsg("custom error %d", e.Custom).Error()
+	}
+
+	return errInvalidTransaction.WithMsg(e.Reason.String()).Error()
+}
+
+// RPCError is an error object returned by the node in response to a JSON-RPC call. It unwraps to the typed error that
+// corresponds to its code and data, such as ErrInvalidTransaction or ErrPriorityTooLow, so that these can be checked
+// with errors.As and errors.Is.
+type RPCError struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+
+	err error
+}
+
+// NewRPCError returns the error for the error object with the given code, message and data
+func NewRPCError(code int, message string, data interface{}) *RPCError {
+	return &RPCError{
+		Code:    code,
+		Message: message,
+		Data:    data,
+		err:     typedRPCError(code, message, data),
+	}
+}
+
+// DecodeRPCError converts an error object returned by the node, which carries an error code and possibly data, to an
+// RPCError. Any other error is returned as it is.
+func DecodeRPCError(err error) error {
+	if _, ok := err.(*RPCError); ok {
+		return err
+	}
+
+	coded, ok := err.(interface{ ErrorCode() int })
+	if !ok {
+		return err
+	}
+
+	var data interface{}
+	if de, ok := err.(interface{ ErrorData() interface{} }); ok {
+		data = de.ErrorData()
+	}
+
+	return NewRPCError(coded.ErrorCode(), err.Error(), data)
+}
+
+func typedRPCError(code int, message string, data interface{}) error {
+	switch code {
+	case codeBadFormat:
+		return ErrBadFormat
+	case codeInvalidTransaction:
+		return parseInvalidTransaction(data)
+	case codeUnknownTransactionValidity:
+		return ErrUnknownTransactionValidity
+	case codeTemporarilyBanned:
+		return ErrTemporarilyBanned
+	case codeAlreadyImported:
+		return ErrAlreadyImported
+	case codePriorityTooLow:
+		return ErrPriorityTooLow
+	case codeCycleDetected:
+		return ErrCycleDetected
+	case codeImmediatelyDropped:
+		return ErrImmediatelyDropped
+	case codeUnactionable:
+		return ErrUnactionable
+	case codeNoTagsProvided:
+		return ErrNoTagsProvided
+	case codeInvalidBlockID:
+		return ErrUnknownBlock
+	}
+
+	// Client errors of the chain and state RPCs share a generic code, they are recognized by the message, e.g.
+	// "UnknownBlock: State already discarded for 0x…" or "Api called for an unknown Block: …"
+	msg := strings.ToLower(message)
+	if strings.Contains(msg, "unknownblock") || strings.Contains(msg, "unknown block") {
+		return ErrUnknownBlock
+	}
+
+	return nil
+}
+
+func (e *RPCError) Error() string {
+	if data, ok := e.Data.(string); ok && data != "" {
+		return fmt.Sprintf("%s: %s", e.Message, data)
+	}
+
+	return e.Message
+}
+
+// ErrorCode returns the JSON-RPC error code
+func (e *RPCError) ErrorCode() int {
+	return e.Code
+}
+
+// ErrorData returns the data of the error object, if any
+func (e *RPCError) ErrorData() interface{} {
+	return e.Data
+}
+
+// Unwrap returns the typed error that corresponds to the error object, or nil if there is none
+func (e *RPCError) Unwrap() error {
+	return e.err
+}
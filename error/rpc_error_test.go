@@ -0,0 +1,96 @@
+package error
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type codedError struct {
+	code int
+	msg  string
+}
+
+func (e *codedError) Error() string  { return e.msg }
+func (e *codedError) ErrorCode() int { return e.code }
+
+func TestNewRPCError_InvalidTransaction(t *testing.T) {
+	for _, test := range []struct {
+		data interface{}
+		exp  ErrInvalidTransaction
+	}{
+		{"Transaction is outdated", ErrInvalidTransaction{Reason: InvalidTransactionStale}},
+		{"Transaction has a bad signature", ErrInvalidTransaction{Reason: InvalidTransactionBadProof}},
+		{"Transaction would exhaust the block limits", ErrInvalidTransaction{Reason: InvalidTransactionExhaustsResources}},
+		{
+			"Inability to pay some fees (e.g. account balance too low)",
+			ErrInvalidTransaction{Reason: InvalidTransactionPayment},
+		},
+		{"Custom error: 3", ErrInvalidTransaction{Reason: InvalidTransactionCustom, Custom: 3}},
+		{"Custom error: 300", ErrInvalidTransaction{}},
+		{"something new", ErrInvalidTransaction{}},
+		{nil, ErrInvalidTransaction{}},
+	} {
+		err := NewRPCError(1010, "Invalid Transaction", test.data)
+
+		var invalid ErrInvalidTransaction
+		assert.True(t, errors.As(err, &invalid))
+		assert.Equal(t, test.exp, invalid)
+		assert.ErrorIs(t, err, test.exp)
+	}
+}
+
+func TestNewRPCError_Typed(t *testing.T) {
+	for _, test := range []struct {
+		code int
+		msg  string
+		exp  error
+	}{
+		{1001, "Extrinsic has invalid format", ErrBadFormat},
+		{1011, "Unknown Transaction Validity", ErrUnknownTransactionValidity},
+		{1012, "Transaction is temporarily banned", ErrTemporarilyBanned},
+		{1013, "Transaction Already Imported", ErrAlreadyImported},
+		{1014, "Priority is too low: (1 vs 2)", ErrPriorityTooLow},
+		{1015, "Cycle Detected", ErrCycleDetected},
+		{1016, "Immediately Dropped", ErrImmediatelyDropped},
+		{1017, "Unactionable", ErrUnactionable},
+		{1018, "No tags provided", ErrNoTagsProvided},
+		{1019, "Invalid block ID", ErrUnknownBlock},
+		{4003, "UnknownBlock: State already discarded for 0x01", ErrUnknownBlock},
+		{1000, "Client error: Api called for an unknown Block: Header was not found", ErrUnknownBlock},
+	} {
+		assert.ErrorIs(t, NewRPCError(test.code, test.msg, nil), test.exp, test.msg)
+	}
+
+	err := NewRPCError(-32601, "Method not found", nil)
+	assert.NoError(t, err.Unwrap())
+	assert.False(t, errors.Is(err, ErrUnknownBlock))
+}
+
+func TestDecodeRPCError(t *testing.T) {
+	assert.NoError(t, DecodeRPCError(nil))
+
+	plain := errors.New("connection refused")
+	assert.Equal(t, plain, DecodeRPCError(plain))
+
+	err := DecodeRPCError(&codedError{1014, "Priority is too low"})
+	assert.EqualError(t, err, "Priority is too low")
+	assert.ErrorIs(t, err, ErrPriorityTooLow)
+
+	assert.Equal(t, err, DecodeRPCError(err))
+}
+
+func TestRPCError_JSON(t *testing.T) {
+	b, err := json.Marshal(NewRPCError(1010, "Invalid Transaction", "Transaction is outdated"))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"code":1010,"message":"Invalid Transaction","data":"Transaction is outdated"}`, string(b))
+}
+
+func TestErrInvalidTransaction_Error(t *testing.T) {
+	assert.EqualError(t, ErrInvalidTransaction{Reason: InvalidTransactionStale}, "invalid transaction: stale")
+	assert.EqualError(t, ErrInvalidTransaction{Reason: InvalidTransactionCustom, Custom: 2},
+		"invalid transaction: custom error 2")
+	assert.EqualError(t, ErrInvalidTransaction{}, "invalid transaction: unknown")
+}
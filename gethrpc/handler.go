@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -284,9 +285,9 @@ func (h *handler) handleResponse(msg *jsonrpcMessage) {
 	}
 	var subID string
 	if op.err = json.Unmarshal(msg.Result, &subID); op.err == nil {
-		op.sub.subid = subID
+		op.sub.SetID(subID)
 		go op.sub.start()
-		h.clientSubs[op.sub.subid] = op.sub
+		h.clientSubs[subID] = op.sub
 	}
 }
 
@@ -325,7 +326,7 @@ func (h *handler) handleCall(cp *callProc, msg *jsonrpcMessage) *jsonrpcMessage
 	callb = h.reg.callback(msg.Method)
 	// }
 	if callb == nil {
-		return msg.errorResponse(&methodNotFoundError{method: msg.Method})
+		return h.handleSubscribe(cp, msg)
 	}
 	args, err := parsePositionalArguments(msg.Params, callb.argTypes)
 	if err != nil {
@@ -368,6 +369,35 @@ func (h *handler) handleCall(cp *callProc, msg *jsonrpcMessage) *jsonrpcMessage
 // 	return h.runMethod(ctx, msg, callb, args)
 // }
 
+// handleSubscribe processes calls to subscription callbacks, which are registered under their method name just like
+// regular callbacks. Notifications are sent with the "<namespace>_subscription" method, clients match them by the
+// subscription ID only.
+func (h *handler) handleSubscribe(cp *callProc, msg *jsonrpcMessage) *jsonrpcMessage {
+	elem := strings.SplitN(msg.Method, serviceMethodSeparator, 2)
+	if len(elem) != 2 {
+		return msg.errorResponse(&methodNotFoundError{method: msg.Method})
+	}
+	callb := h.reg.subscription(elem[0], elem[1])
+	if callb == nil {
+		return msg.errorResponse(&methodNotFoundError{method: msg.Method})
+	}
+	if !h.allowSubscribe {
+		return msg.errorResponse(ErrNotificationsUnsupported)
+	}
+
+	args, err := parsePositionalArguments(msg.Params, callb.argTypes)
+	if err != nil {
+		return msg.errorResponse(&invalidParamsError{err.Error()})
+	}
+
+	// Install notifier in context so the subscription handler can find it.
+	n := &Notifier{h: h, namespace: elem[0], subscribeMethodSuffix: elem[1], notificationMethodSuffix: "_subscription"}
+	cp.notifiers = append(cp.notifiers, n)
+	ctx := context.WithValue(cp.ctx, notifierKey{}, n)
+
+	return h.runMethod(ctx, msg, callb, args)
+}
+
 // runMethod runs the Go callback for an RPC method.
 func (h *handler) runMethod(ctx context.Context, msg *jsonrpcMessage, callb *callback, args []reflect.Value) *jsonrpcMessage {
 	result, err := callb.call(ctx, msg.Method, args)
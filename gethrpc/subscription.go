@@ -26,6 +26,7 @@ import (
 	"errors"
 	"math/rand"
 	"reflect"
+	"strconv"
 	"sync"
 	"time"
 )
@@ -70,6 +71,11 @@ func encodeID(b []byte) ID {
 	return ID(uint32(b[0])<<24 | uint32(b[1])<<16 | uint32(b[2])<<8 | uint32(b[3]))
 }
 
+// String returns the decimal representation of the ID, which is how it is sent to clients.
+func (id ID) String() string {
+	return strconv.FormatUint(uint64(id), 10)
+}
+
 type notifierKey struct{}
 
 // NotifierFromContext returns the Notifier value stored in ctx, if any.
@@ -168,7 +174,7 @@ func (n *Notifier) activate() error {
 }
 
 func (n *Notifier) send(sub *Subscription, data json.RawMessage) error {
-	params, _ := json.Marshal(&subscriptionResult{ID: string(sub.ID), Result: data})
+	params, _ := json.Marshal(&subscriptionResult{ID: sub.ID.String(), Result: data})
 	ctx := context.Background()
 	return n.h.conn.Write(ctx, &jsonrpcMessage{
 		Version: vsn,
@@ -195,7 +201,7 @@ func (s *Subscription) Err() <-chan error {
 
 // MarshalJSON marshals a subscription as its ID.
 func (s *Subscription) MarshalJSON() ([]byte, error) {
-	return json.Marshal(s.ID)
+	return json.Marshal(s.ID.String())
 }
 
 // ClientSubscription is a subscription established through the Client's Subscribe or
@@ -209,12 +215,17 @@ type ClientSubscription struct {
 	unsubscribeMethodSuffix  string
 	notificationMethodSuffix string
 	subid                    string
+	subidMu                  sync.Mutex
 	in                       chan json.RawMessage
 
 	quitOnce sync.Once     // ensures quit is closed once
 	quit     chan struct{} // quit is closed when the subscription exits
 	errOnce  sync.Once     // ensures err is closed once
 	err      chan error
+
+	// set for managed subscriptions only, see NewManagedSubscription
+	onUnsubscribe func()
+	reconnected   chan struct{}
 }
 
 func newClientSubscription(c *Client, namespace, subscribeMethodSuffix, unsubscribeMethodSuffix,
@@ -234,6 +245,21 @@ func newClientSubscription(c *Client, namespace, subscribeMethodSuffix, unsubscr
 	return sub
 }
 
+// NewManagedSubscription creates a ClientSubscription that is not bound to a single server subscription. It is
+// meant for clients that transparently re-establish subscriptions on new connections and deliver the notifications
+// on the same channel.
+//
+// onUnsubscribe is called once when Unsubscribe is called on the subscription, Terminate ends the subscription with
+// an error and MarkReconnected signals a gap in the notifications.
+func NewManagedSubscription(onUnsubscribe func()) *ClientSubscription {
+	return &ClientSubscription{
+		quit:          make(chan struct{}),
+		err:           make(chan error, 1),
+		onUnsubscribe: onUnsubscribe,
+		reconnected:   make(chan struct{}, 1),
+	}
+}
+
 // Err returns the subscription error channel. The intended use of Err is to schedule
 // resubscription when the client connection is closed unexpectedly.
 //
@@ -246,6 +272,43 @@ func (sub *ClientSubscription) Err() <-chan error {
 	return sub.err
 }
 
+// Reconnected returns a channel that receives a value whenever a managed subscription has been re-established on a
+// new connection. Notifications sent by the server while the connection was down are lost. Multiple reconnects that
+// happen before the value is received are coalesced.
+//
+// The channel never receives a value for subscriptions that are not managed.
+func (sub *ClientSubscription) Reconnected() <-chan struct{} {
+	return sub.reconnected
+}
+
+// MarkReconnected signals on the Reconnected channel that the managed subscription has been re-established.
+func (sub *ClientSubscription) MarkReconnected() {
+	select {
+	case sub.reconnected <- struct{}{}:
+	default:
+	}
+}
+
+// ID returns the ID the server assigned to the subscription. For managed subscriptions it returns the ID set with
+// SetID, which changes whenever the subscription is re-established.
+func (sub *ClientSubscription) ID() string {
+	sub.subidMu.Lock()
+	defer sub.subidMu.Unlock()
+	return sub.subid
+}
+
+// SetID sets the ID returned by ID, for managed subscriptions.
+func (sub *ClientSubscription) SetID(id string) {
+	sub.subidMu.Lock()
+	defer sub.subidMu.Unlock()
+	sub.subid = id
+}
+
+// Terminate ends the subscription with the given error, which is delivered on the Err channel.
+func (sub *ClientSubscription) Terminate(err error) {
+	sub.quitWithError(err, false)
+}
+
 // Unsubscribe unsubscribes the notification and closes the error channel.
 // It can safely be called more than once.
 func (sub *ClientSubscription) Unsubscribe() {
@@ -330,6 +393,10 @@ func (sub *ClientSubscription) unmarshal(result json.RawMessage) (interface{}, e
 }
 
 func (sub *ClientSubscription) requestUnsubscribe() error {
+	if sub.onUnsubscribe != nil {
+		sub.onUnsubscribe()
+		return nil
+	}
 	var result interface{}
 	return sub.client.Call(&result, sub.namespace+"_"+sub.unsubscribeMethodSuffix, sub.subid)
 }
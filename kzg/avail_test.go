@@ -0,0 +1,39 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kzg_test
+
+import (
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/kzg"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/kzg/kzgtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifier_AvailFixture(t *testing.T) {
+	f := kzgtest.LoadAvailFixture(t)
+	assert.NotEmpty(t, f.Cells)
+
+	v, err := kzg.NewHeaderVerifier(f.Setup, f.Header)
+	assert.NoError(t, err)
+
+	res, err := v.VerifyCells(f.Cells, f.Proofs)
+	assert.NoError(t, err)
+	for _, r := range res {
+		assert.NoError(t, r.Err)
+	}
+}
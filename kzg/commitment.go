@@ -0,0 +1,107 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kzg verifies the KZG proofs of cells of the Avail data matrix against the row commitments in the kate
+// commitment of block headers, so that data availability sampling does not need to trust the node serving the proofs.
+// The verification is only as sound as the trusted setup it is given, which must be the SRS the network commits with,
+// as loaded with LoadPublicParameters. The package is tested against matrices committed with a setup generated in the
+// tests. No real Avail block is checked in, TestVerifier_AvailFixture verifies one once its fixture, described in
+// kzgtest.AvailFixture, is placed in testdata/avail.
+package kzg
+
+import (
+	"math/big"
+	"math/bits"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/ethereum/go-ethereum/crypto/bls12381"
+)
+
+// scalarModulus is the order of the BLS12-381 groups, the modulus of the scalar field the data matrix is made of
+var scalarModulus, _ = new(big.Int).SetString("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16)
+
+// twoAdicity is the largest power of two that divides the order of the multiplicative group of the scalar field
+const twoAdicity = 32
+
+// rootOfUnity is a primitive root of unity of order 2^32, derived from the multiplicative generator 7
+var rootOfUnity = new(big.Int).Exp(big.NewInt(7), new(big.Int).Rsh(new(big.Int).Sub(scalarModulus, big.NewInt(1)),
+	twoAdicity), scalarModulus)
+
+// Commitments are the KZG commitments of the rows of the extended data matrix of a block
+type Commitments struct {
+	// Rows are the commitments to the polynomials of the rows, in order
+	Rows []*bls12381.PointG1
+	// Cols is the number of columns of the matrix, which is the size of the evaluation domain of the row polynomials
+	Cols uint32
+}
+
+// ParseCommitments parses the row commitments of the kate commitment of a block. The commitment holds a compressed G1
+// point for each row of the extended matrix.
+func ParseCommitments(c types.KateCommitment) (*Commitments, error) {
+	cols := uint32(c.Cols.Int64())
+	if cols == 0 || cols&(cols-1) != 0 {
+		return nil, ErrInvalidDimensions.WithMsg("columns must be a power of two, got %d", cols)
+	}
+
+	if len(c.Commitment) == 0 || len(c.Commitment)%G1Size != 0 {
+		return nil, ErrInvalidCommitment.WithMsg("expected a multiple of %d bytes, got %d", G1Size, len(c.Commitment))
+	}
+
+	raw := make([]byte, len(c.Commitment))
+	for i, b := range c.Commitment {
+		raw[i] = byte(b)
+	}
+
+	rows := make([]*bls12381.PointG1, len(raw)/G1Size)
+	for i := range rows {
+		p, err := DecompressG1(raw[i*G1Size : (i+1)*G1Size])
+		if err != nil {
+			return nil, ErrInvalidCommitment.WithMsg("row %d", i).Wrap(err)
+		}
+		rows[i] = p
+	}
+
+	return &Commitments{Rows: rows, Cols: cols}, nil
+}
+
+// HeaderCommitments parses the row commitments of the kate commitment in the extension of header
+func HeaderCommitments(header types.Header) (*Commitments, error) {
+	c := header.Extension.V3.Commitment
+	if len(c.Commitment) == 0 {
+		return nil, ErrMissingExtension
+	}
+	return ParseCommitments(c)
+}
+
+// ScalarModulus returns the modulus of the scalar field the data matrix is made of
+func ScalarModulus() *big.Int {
+	return new(big.Int).Set(scalarModulus)
+}
+
+// RootOfUnity returns the primitive root of unity of order n that generates the evaluation domain of size n, n must be
+// a power of two
+func RootOfUnity(n uint32) *big.Int {
+	// the order of rootOfUnity is 2^32
+	return new(big.Int).Exp(rootOfUnity, new(big.Int).Lsh(big.NewInt(1), twoAdicity-uint(bits.Len32(n-1))),
+		scalarModulus)
+}
+
+// evaluationPoint returns the point at which the row polynomials are evaluated for the given column, the column-th
+// power of the root of unity of the order of the number of columns
+func (c *Commitments) evaluationPoint(col uint32) *big.Int {
+	omega := RootOfUnity(c.Cols)
+	return omega.Exp(omega, big.NewInt(int64(col)), scalarModulus)
+}
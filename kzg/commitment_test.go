@@ -0,0 +1,45 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kzg
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvaluationPoint(t *testing.T) {
+	for _, cols := range []uint32{1, 2, 4, 256} {
+		c := &Commitments{Cols: cols}
+		one := big.NewInt(1)
+		assert.Equal(t, one, c.evaluationPoint(0))
+		omega := c.evaluationPoint(1)
+		assert.Equal(t, one, new(big.Int).Exp(omega, big.NewInt(int64(cols)), scalarModulus))
+		if cols > 1 {
+			assert.NotEqual(t, one, new(big.Int).Exp(omega, big.NewInt(int64(cols/2)), scalarModulus))
+		}
+	}
+}
+
+func TestRootOfUnity(t *testing.T) {
+	for _, n := range []uint32{1, 2, 16} {
+		omega := RootOfUnity(2 * n)
+		assert.Equal(t, RootOfUnity(n), omega.Mul(omega, omega).Mod(omega, scalarModulus))
+	}
+	assert.Equal(t, scalarModulus, ScalarModulus())
+}
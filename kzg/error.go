@@ -0,0 +1,15 @@
+package kzg
+
+import libErr "github.com/centrifuge/go-substrate-rpc-client/v4/error"
+
+const (
+	ErrInvalidPoint       = libErr.Error("invalid point")
+	ErrInvalidSetup       = libErr.Error("invalid trusted setup")
+	ErrInvalidCommitment  = libErr.Error("invalid kate commitment")
+	ErrInvalidDimensions  = libErr.Error("invalid matrix dimensions")
+	ErrCellOutOfRange     = libErr.Error("cell out of range")
+	ErrInvalidScalar      = libErr.Error("invalid scalar")
+	ErrInvalidProof       = libErr.Error("invalid cell proof")
+	ErrProofCountMismatch = libErr.Error("number of proofs does not match the number of cells")
+	ErrMissingExtension   = libErr.Error("header has no kate commitment")
+)
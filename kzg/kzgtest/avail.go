@@ -0,0 +1,98 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kzgtest
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/kzg"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+// AvailFixture is a real Avail block with cell proofs served for it, read from kzg/testdata/avail:
+//   - public_params.data: the SRS of the network, in the format read by kzg.ParsePublicParameters
+//   - header.json: the response of chain_getHeader for the block
+//   - proof.json: {"cells": [{"row": 0, "col": 0}, ...], "proofs": <the response of kate_queryProof for the cells>}
+//   - app_data.json: {"appId": 1, "data": "0x..."}, the extrinsic submitted to the app in the block
+//
+// The cells must cover at least half of the rows of the extended matrix, so that the block can be reconstructed.
+type AvailFixture struct {
+	Setup  *kzg.TrustedSetup
+	Header types.Header
+	Cells  []types.Cell
+	Proofs []types.CellProof
+	AppID  uint32
+	// AppData is the extrinsic submitted to the app
+	AppData []byte
+}
+
+// AvailFixtureDir returns the directory the Avail fixture is read from
+func AvailFixtureDir() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "..", "testdata", "avail")
+}
+
+// LoadAvailFixture loads the Avail fixture, the test is skipped if it is not present
+func LoadAvailFixture(t testing.TB) *AvailFixture {
+	dir := AvailFixtureDir()
+	setupPath := filepath.Join(dir, "public_params.data")
+	if _, err := os.Stat(setupPath); os.IsNotExist(err) {
+		t.Skipf("no Avail fixture in %s", dir)
+	}
+
+	setup, err := kzg.LoadPublicParameters(setupPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f := &AvailFixture{Setup: setup}
+	readJSON(t, filepath.Join(dir, "header.json"), &f.Header)
+
+	var proof struct {
+		Cells  []types.Cell      `json:"cells"`
+		Proofs []types.CellProof `json:"proofs"`
+	}
+	readJSON(t, filepath.Join(dir, "proof.json"), &proof)
+	f.Cells, f.Proofs = proof.Cells, proof.Proofs
+
+	var appData struct {
+		AppID uint32 `json:"appId"`
+		Data  string `json:"data"`
+	}
+	readJSON(t, filepath.Join(dir, "app_data.json"), &appData)
+	f.AppID = appData.AppID
+	if f.AppData, err = codec.HexDecodeString(appData.Data); err != nil {
+		t.Fatal(err)
+	}
+
+	return f
+}
+
+func readJSON(t testing.TB, path string, v interface{}) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		t.Fatal(err)
+	}
+}
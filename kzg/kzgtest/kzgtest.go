@@ -0,0 +1,114 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kzgtest provides a trusted setup with a known secret and data matrices committed with it, to test the
+// verification of cell proofs without an Avail node
+package kzgtest
+
+import (
+	"math/big"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/kzg"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/ethereum/go-ethereum/crypto/bls12381"
+)
+
+// Tau is the secret of the trusted setup returned by Setup
+var Tau = big.NewInt(0x5eed)
+
+// Setup returns the trusted setup with the secret Tau
+func Setup() *kzg.TrustedSetup {
+	g2 := bls12381.NewG2()
+	setup, err := kzg.NewTrustedSetup(kzg.CompressG2(g2.One()), kzg.CompressG2(g2.MulScalar(g2.New(), g2.One(), Tau)))
+	if err != nil {
+		panic(err)
+	}
+	return setup
+}
+
+// Matrix is an extended data matrix given by the coefficients of its row polynomials
+type Matrix struct {
+	Rows [][]*big.Int
+	Cols uint32
+}
+
+// NewMatrix returns a matrix with the given dimensions, matrices with different seeds have different contents
+func NewMatrix(rows int, cols uint32, seed int64) *Matrix {
+	m := &Matrix{Cols: cols}
+	for r := 0; r < rows; r++ {
+		var coeffs []*big.Int
+		for c := uint32(0); c < cols; c++ {
+			coeffs = append(coeffs, big.NewInt(seed*1e6+int64(r)*1000+int64(c)*31+7))
+		}
+		m.Rows = append(m.Rows, coeffs)
+	}
+	return m
+}
+
+// Eval evaluates the polynomial of the row at x
+func (m *Matrix) Eval(row int, x *big.Int) *big.Int {
+	modulus := kzg.ScalarModulus()
+	res := new(big.Int)
+	for i := len(m.Rows[row]) - 1; i >= 0; i-- {
+		res.Mul(res, x).Add(res, m.Rows[row][i]).Mod(res, modulus)
+	}
+	return res
+}
+
+// Scalar returns the content of the cell, the evaluation of the polynomial of its row at the point of its column
+func (m *Matrix) Scalar(cell types.Cell) types.KateScalar {
+	var s types.KateScalar
+	m.Eval(int(cell.Row), m.point(uint32(cell.Col))).FillBytes(s[:])
+	return s
+}
+
+func (m *Matrix) point(col uint32) *big.Int {
+	omega := kzg.RootOfUnity(m.Cols)
+	return omega.Exp(omega, big.NewInt(int64(col)), kzg.ScalarModulus())
+}
+
+// Commitment returns the kate commitment of the matrix, the matrix is taken as extended to twice the number of rows
+// of the original matrix
+func (m *Matrix) Commitment() types.KateCommitment {
+	g1 := bls12381.NewG1()
+	var raw []types.U8
+	for r := range m.Rows {
+		for _, b := range kzg.CompressG1(g1.MulScalar(g1.New(), g1.One(), m.Eval(r, Tau))) {
+			raw = append(raw, types.U8(b))
+		}
+	}
+	return types.KateCommitment{
+		Rows:       types.NewUCompactFromUInt(uint64(len(m.Rows) / 2)),
+		Cols:       types.NewUCompactFromUInt(uint64(m.Cols)),
+		Commitment: raw,
+	}
+}
+
+// Proof returns the content of the cell and its proof (p(tau) - p(z)) / (tau - z) in G1
+func (m *Matrix) Proof(cell types.Cell) types.CellProof {
+	modulus := kzg.ScalarModulus()
+	z := m.point(uint32(cell.Col))
+	y := m.Eval(int(cell.Row), z)
+
+	q := new(big.Int).Sub(m.Eval(int(cell.Row), Tau), y)
+	q.Mul(q, new(big.Int).ModInverse(new(big.Int).Sub(Tau, z), modulus)).Mod(q, modulus)
+
+	g1 := bls12381.NewG1()
+	var res types.CellProof
+	y.FillBytes(res.Scalar[:])
+	copy(res.Proof[:], kzg.CompressG1(g1.MulScalar(g1.New(), g1.One(), q)))
+	return res
+}
@@ -0,0 +1,280 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kzg
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/crypto/bls12381"
+)
+
+const (
+	// G1Size is the size of a compressed G1 point
+	G1Size = 48
+	// G2Size is the size of a compressed G2 point
+	G2Size = 96
+
+	fpSize = 48
+
+	// flags in the most significant bits of compressed points, as defined by zcash
+	compressionFlag = 1 << 7
+	infinityFlag    = 1 << 6
+	sortFlag        = 1 << 5
+	flagMask        = compressionFlag | infinityFlag | sortFlag
+)
+
+// fieldModulus is the modulus of the base field of BLS12-381
+var fieldModulus, _ = new(big.Int).SetString("1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabff"+
+	"feb153ffffb9feffffffffaaab", 16)
+
+// halfFieldModulus is (p-1)/2, field elements above it are the lexicographically largest of the pair y, -y
+var halfFieldModulus = new(big.Int).Rsh(fieldModulus, 1)
+
+// CompressG1 encodes p in the compressed zcash format, the encoding of commitments and proofs read by DecompressG1
+func CompressG1(p *bls12381.PointG1) []byte {
+	g1 := bls12381.NewG1()
+	out := make([]byte, G1Size)
+	if g1.IsZero(p) {
+		out[0] = compressionFlag | infinityFlag
+		return out
+	}
+
+	raw := g1.ToBytes(p)
+	copy(out, raw[:fpSize])
+	out[0] |= compressionFlag
+	if new(big.Int).SetBytes(raw[fpSize:]).Cmp(halfFieldModulus) > 0 {
+		out[0] |= sortFlag
+	}
+	return out
+}
+
+// CompressG2 encodes p in the compressed zcash format, the encoding of the trusted setup read by DecompressG2
+func CompressG2(p *bls12381.PointG2) []byte {
+	g2 := bls12381.NewG2()
+	out := make([]byte, G2Size)
+	if g2.IsZero(p) {
+		out[0] = compressionFlag | infinityFlag
+		return out
+	}
+
+	raw := g2.ToBytes(p)
+	copy(out, raw[:2*fpSize])
+	out[0] |= compressionFlag
+	y := fp2{new(big.Int).SetBytes(raw[3*fpSize:]), new(big.Int).SetBytes(raw[2*fpSize : 3*fpSize])}
+	if y.lexicographicallyLargest() {
+		out[0] |= sortFlag
+	}
+	return out
+}
+
+// DecompressG1 decodes a G1 point in the compressed zcash format and checks that it is in the correct subgroup
+func DecompressG1(b []byte) (*bls12381.PointG1, error) {
+	g1 := bls12381.NewG1()
+
+	flags, x, err := splitCompressed(b, G1Size)
+	if err != nil {
+		return nil, err
+	}
+
+	if flags&infinityFlag != 0 {
+		if x.Sign() != 0 || flags&sortFlag != 0 {
+			return nil, ErrInvalidPoint.WithMsg("non-canonical point at infinity")
+		}
+		return g1.Zero(), nil
+	}
+
+	// y^2 = x^3 + 4
+	rhs := new(big.Int).Exp(x, big.NewInt(3), fieldModulus)
+	rhs.Add(rhs, big.NewInt(4)).Mod(rhs, fieldModulus)
+
+	y := new(big.Int).ModSqrt(rhs, fieldModulus)
+	if y == nil {
+		return nil, ErrInvalidPoint.WithMsg("x is not on the curve")
+	}
+
+	if (y.Cmp(halfFieldModulus) > 0) != (flags&sortFlag != 0) {
+		y.Sub(fieldModulus, y)
+	}
+
+	uncompressed := make([]byte, 2*fpSize)
+	x.FillBytes(uncompressed[:fpSize])
+	y.FillBytes(uncompressed[fpSize:])
+
+	p, err := g1.FromBytes(uncompressed)
+	if err != nil {
+		return nil, ErrInvalidPoint.Wrap(err)
+	}
+
+	if !g1.InCorrectSubgroup(p) {
+		return nil, ErrInvalidPoint.WithMsg("point is not in the correct subgroup")
+	}
+
+	return p, nil
+}
+
+// DecompressG2 decodes a G2 point in the compressed zcash format and checks that it is in the correct subgroup
+func DecompressG2(b []byte) (*bls12381.PointG2, error) {
+	g2 := bls12381.NewG2()
+
+	flags, x1, err := splitCompressed(b, G2Size)
+	if err != nil {
+		return nil, err
+	}
+
+	// the compressed form holds the coefficient of u first
+	x1.Rsh(x1, 8*fpSize)
+	x0 := new(big.Int).SetBytes(b[fpSize:G2Size])
+	if x0.Cmp(fieldModulus) >= 0 || x1.Cmp(fieldModulus) >= 0 {
+		return nil, ErrInvalidPoint.WithMsg("coordinate is not a field element")
+	}
+
+	if flags&infinityFlag != 0 {
+		if x0.Sign() != 0 || x1.Sign() != 0 || flags&sortFlag != 0 {
+			return nil, ErrInvalidPoint.WithMsg("non-canonical point at infinity")
+		}
+		return g2.Zero(), nil
+	}
+
+	// y^2 = x^3 + 4(u+1)
+	x := fp2{x0, x1}
+	rhs := x.mul(x).mul(x)
+	rhs = fp2{mod(new(big.Int).Add(rhs.c0, big.NewInt(4))), mod(new(big.Int).Add(rhs.c1, big.NewInt(4)))}
+
+	y, ok := rhs.sqrt()
+	if !ok {
+		return nil, ErrInvalidPoint.WithMsg("x is not on the curve")
+	}
+
+	if y.lexicographicallyLargest() != (flags&sortFlag != 0) {
+		y = y.neg()
+	}
+
+	uncompressed := make([]byte, 4*fpSize)
+	x1.FillBytes(uncompressed[:fpSize])
+	x0.FillBytes(uncompressed[fpSize : 2*fpSize])
+	y.c1.FillBytes(uncompressed[2*fpSize : 3*fpSize])
+	y.c0.FillBytes(uncompressed[3*fpSize:])
+
+	p, err := g2.FromBytes(uncompressed)
+	if err != nil {
+		return nil, ErrInvalidPoint.Wrap(err)
+	}
+
+	if !g2.InCorrectSubgroup(p) {
+		return nil, ErrInvalidPoint.WithMsg("point is not in the correct subgroup")
+	}
+
+	return p, nil
+}
+
+// splitCompressed checks the length and flags of a compressed point and returns the flags and the remaining bytes as
+// an integer
+func splitCompressed(b []byte, size int) (byte, *big.Int, error) {
+	if len(b) != size {
+		return 0, nil, ErrInvalidPoint.WithMsg("expected %d bytes, got %d", size, len(b))
+	}
+
+	flags := b[0] & flagMask
+	if flags&compressionFlag == 0 {
+		return 0, nil, ErrInvalidPoint.WithMsg("point is not compressed")
+	}
+
+	tmp := make([]byte, size)
+	copy(tmp, b)
+	tmp[0] &^= flagMask
+	x := new(big.Int).SetBytes(tmp)
+
+	if size == G1Size && x.Cmp(fieldModulus) >= 0 {
+		return 0, nil, ErrInvalidPoint.WithMsg("coordinate is not a field element")
+	}
+
+	return flags, x, nil
+}
+
+func mod(a *big.Int) *big.Int {
+	return a.Mod(a, fieldModulus)
+}
+
+// fp2 is an element c0 + c1*u of the quadratic extension of the base field, with u^2 = -1
+type fp2 struct {
+	c0, c1 *big.Int
+}
+
+func (a fp2) mul(b fp2) fp2 {
+	c0 := new(big.Int).Sub(new(big.Int).Mul(a.c0, b.c0), new(big.Int).Mul(a.c1, b.c1))
+	c1 := new(big.Int).Add(new(big.Int).Mul(a.c0, b.c1), new(big.Int).Mul(a.c1, b.c0))
+	return fp2{mod(c0), mod(c1)}
+}
+
+func (a fp2) neg() fp2 {
+	return fp2{mod(new(big.Int).Neg(a.c0)), mod(new(big.Int).Neg(a.c1))}
+}
+
+func (a fp2) equal(b fp2) bool {
+	return a.c0.Cmp(b.c0) == 0 && a.c1.Cmp(b.c1) == 0
+}
+
+func (a fp2) lexicographicallyLargest() bool {
+	if a.c1.Sign() != 0 {
+		return a.c1.Cmp(halfFieldModulus) > 0
+	}
+	return a.c0.Cmp(halfFieldModulus) > 0
+}
+
+// sqrt returns a square root of a, using the norm to reduce it to square roots in the base field
+func (a fp2) sqrt() (fp2, bool) {
+	var res fp2
+	if a.c1.Sign() == 0 {
+		if s := new(big.Int).ModSqrt(a.c0, fieldModulus); s != nil {
+			res = fp2{s, new(big.Int)}
+		} else if s := new(big.Int).ModSqrt(mod(new(big.Int).Neg(a.c0)), fieldModulus); s != nil {
+			res = fp2{new(big.Int), s}
+		} else {
+			return fp2{}, false
+		}
+	} else {
+		norm := mod(new(big.Int).Add(new(big.Int).Mul(a.c0, a.c0), new(big.Int).Mul(a.c1, a.c1)))
+		gamma := new(big.Int).ModSqrt(norm, fieldModulus)
+		if gamma == nil {
+			return fp2{}, false
+		}
+
+		half := new(big.Int).ModInverse(big.NewInt(2), fieldModulus)
+		delta := mod(new(big.Int).Mul(new(big.Int).Add(a.c0, gamma), half))
+		x0 := new(big.Int).ModSqrt(delta, fieldModulus)
+		if x0 == nil {
+			delta = mod(new(big.Int).Mul(new(big.Int).Sub(a.c0, gamma), half))
+			x0 = new(big.Int).ModSqrt(delta, fieldModulus)
+			if x0 == nil {
+				return fp2{}, false
+			}
+		}
+		if x0.Sign() == 0 {
+			return fp2{}, false
+		}
+
+		x1 := new(big.Int).ModInverse(new(big.Int).Lsh(x0, 1), fieldModulus)
+		x1.Mul(x1, a.c1)
+		res = fp2{x0, mod(x1)}
+	}
+
+	if !res.mul(res).equal(a) {
+		return fp2{}, false
+	}
+
+	return res, true
+}
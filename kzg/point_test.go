@@ -0,0 +1,103 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kzg
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+	"github.com/ethereum/go-ethereum/crypto/bls12381"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecompressG1(t *testing.T) {
+	g1 := bls12381.NewG1()
+
+	p, err := DecompressG1(codec.MustHexDecodeString("0x97f1d3a73197d7942695638c4fa9ac0fc3688c4f9774b905a14e3a3f1" +
+		"71bac586c55e83ff97a1aeffb3af00adb22c6bb"))
+	assert.NoError(t, err)
+	assert.True(t, g1.Equal(g1.One(), p))
+
+	for i := int64(1); i < 20; i++ {
+		q := g1.MulScalar(g1.New(), g1.One(), big.NewInt(i*7919))
+		p, err := DecompressG1(CompressG1(q))
+		assert.NoError(t, err)
+		assert.True(t, g1.Equal(q, p))
+
+		neg := g1.Neg(g1.New(), q)
+		p, err = DecompressG1(CompressG1(neg))
+		assert.NoError(t, err)
+		assert.True(t, g1.Equal(neg, p))
+	}
+
+	p, err = DecompressG1(CompressG1(g1.Zero()))
+	assert.NoError(t, err)
+	assert.True(t, g1.IsZero(p))
+}
+
+func TestDecompressG1_Invalid(t *testing.T) {
+	valid := CompressG1(bls12381.NewG1().One())
+
+	_, err := DecompressG1(valid[1:])
+	assert.ErrorIs(t, err, ErrInvalidPoint)
+
+	uncompressed := append([]byte{}, valid...)
+	uncompressed[0] &^= compressionFlag
+	_, err = DecompressG1(uncompressed)
+	assert.ErrorIs(t, err, ErrInvalidPoint)
+
+	infinity := append([]byte{}, valid...)
+	infinity[0] |= infinityFlag
+	_, err = DecompressG1(infinity)
+	assert.ErrorIs(t, err, ErrInvalidPoint)
+
+	// x = 0 is not on the curve, 4 is not a square
+	notOnCurve := make([]byte, G1Size)
+	notOnCurve[0] = compressionFlag
+	_, err = DecompressG1(notOnCurve)
+	assert.ErrorIs(t, err, ErrInvalidPoint)
+}
+
+func TestDecompressG2(t *testing.T) {
+	g2 := bls12381.NewG2()
+
+	p, err := DecompressG2(codec.MustHexDecodeString("0x93e02b6052719f607dacd3a088274f65596bd0d09920b61ab5da61bbd" +
+		"c7f5049334cf11213945d57e5ac7d055d042b7e024aa2b2f08f0a91260805272dc51051c6e47ad4fa403b02b4510b647ae3d1770bac03" +
+		"26a805bbefd48056c8c121bdb8"))
+	assert.NoError(t, err)
+	assert.True(t, g2.Equal(g2.One(), p))
+
+	for i := int64(1); i < 10; i++ {
+		q := g2.MulScalar(g2.New(), g2.One(), big.NewInt(i*104729))
+		p, err := DecompressG2(CompressG2(q))
+		assert.NoError(t, err)
+		assert.True(t, g2.Equal(q, p))
+
+		neg := g2.Neg(g2.New(), q)
+		p, err = DecompressG2(CompressG2(neg))
+		assert.NoError(t, err)
+		assert.True(t, g2.Equal(neg, p))
+	}
+
+	p, err = DecompressG2(CompressG2(g2.Zero()))
+	assert.NoError(t, err)
+	assert.True(t, g2.IsZero(p))
+
+	_, err = DecompressG2(CompressG1(bls12381.NewG1().One()))
+	assert.ErrorIs(t, err, ErrInvalidPoint)
+}
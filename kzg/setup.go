@@ -0,0 +1,182 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kzg
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+	"github.com/ethereum/go-ethereum/crypto/bls12381"
+)
+
+// TrustedSetup holds the parameters of the KZG trusted setup that are needed to verify proofs: the generators of G1
+// and G2, and the secret of the setup in G2
+type TrustedSetup struct {
+	g1    *bls12381.PointG1
+	g2    *bls12381.PointG2
+	tauG2 *bls12381.PointG2
+}
+
+// NewTrustedSetup creates a TrustedSetup from the compressed G2 generator and the compressed secret in G2
+func NewTrustedSetup(g2, tauG2 []byte) (*TrustedSetup, error) {
+	g, err := DecompressG2(g2)
+	if err != nil {
+		return nil, ErrInvalidSetup.Wrap(err)
+	}
+
+	tau, err := DecompressG2(tauG2)
+	if err != nil {
+		return nil, ErrInvalidSetup.Wrap(err)
+	}
+
+	return &TrustedSetup{g1: bls12381.NewG1().One(), g2: g, tauG2: tau}, nil
+}
+
+// openingKeySize is the size of the opening key at the start of serialized public parameters: the compressed G1
+// generator, the compressed G2 generator and the compressed secret in G2
+const openingKeySize = G1Size + 2*G2Size
+
+// LoadPublicParameters loads the trusted setup from the public parameters in the file at path, see
+// ParsePublicParameters for the format
+func LoadPublicParameters(path string) (*TrustedSetup, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ParsePublicParameters(f)
+}
+
+// ParsePublicParameters parses a trusted setup from public parameters in the binary format Avail nodes load their SRS
+// from, the serialization of dusk-plonk's PublicParameters used for files such as pp_1024.data of the kate crate. The
+// opening key comes first: the compressed G1 generator, the compressed G2 generator and the compressed secret in G2.
+// It is followed by the commit key, the compressed powers of the secret in G1 starting with the generator. Only the
+// opening key is needed to verify proofs, the commit key is checked for its size and its first point.
+func ParsePublicParameters(r io.Reader) (*TrustedSetup, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(b) < openingKeySize || (len(b)-openingKeySize)%G1Size != 0 {
+		return nil, ErrInvalidSetup.WithMsg("expected an opening key of %d bytes followed by G1 points of %d bytes, "+
+			"got %d bytes", openingKeySize, G1Size, len(b))
+	}
+
+	g1, err := DecompressG1(b[:G1Size])
+	if err != nil {
+		return nil, ErrInvalidSetup.Wrap(err)
+	}
+
+	setup, err := NewTrustedSetup(b[G1Size:G1Size+G2Size], b[G1Size+G2Size:openingKeySize])
+	if err != nil {
+		return nil, err
+	}
+
+	if commitKey := b[openingKeySize:]; len(commitKey) > 0 {
+		first, err := DecompressG1(commitKey[:G1Size])
+		if err != nil {
+			return nil, ErrInvalidSetup.Wrap(err)
+		}
+		if !bls12381.NewG1().Equal(first, g1) {
+			return nil, ErrInvalidSetup.WithMsg("commit key does not start with the G1 generator of the opening key")
+		}
+	}
+
+	setup.g1 = g1
+	return setup, nil
+}
+
+// LoadTrustedSetup loads the trusted setup from the file at path, see ParseTrustedSetup for the format
+func LoadTrustedSetup(path string) (*TrustedSetup, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ParseTrustedSetup(f)
+}
+
+// ParseTrustedSetup parses a trusted setup in the text format used by c-kzg: the number of G1 points and the number of
+// G2 points on the first two lines, followed by the hex encoded compressed G1 points and then the hex encoded
+// compressed G2 points, one per line. The powers of the secret in G2 must start with the generator, only the first
+// two of them are used. The public parameters Avail nodes load are read with ParsePublicParameters.
+func ParseTrustedSetup(r io.Reader) (*TrustedSetup, error) {
+	var lines []string
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		if line := strings.TrimSpace(s.Text()); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(lines) < 2 {
+		return nil, ErrInvalidSetup.WithMsg("missing point counts")
+	}
+
+	n1, err := strconv.Atoi(lines[0])
+	if err != nil {
+		return nil, ErrInvalidSetup.Wrap(err)
+	}
+
+	n2, err := strconv.Atoi(lines[1])
+	if err != nil {
+		return nil, ErrInvalidSetup.Wrap(err)
+	}
+
+	if n1 < 0 || n2 < 2 || len(lines) != 2+n1+n2 {
+		return nil, ErrInvalidSetup.WithMsg("expected %d G1 and %d G2 points, got %d lines", n1, n2, len(lines)-2)
+	}
+
+	g2, err := hexPoint(lines[2+n1], G2Size)
+	if err != nil {
+		return nil, err
+	}
+
+	tauG2, err := hexPoint(lines[3+n1], G2Size)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTrustedSetup(g2, tauG2)
+}
+
+func hexPoint(s string, size int) ([]byte, error) {
+	if !strings.HasPrefix(s, "0x") {
+		s = "0x" + s
+	}
+
+	b, err := codec.HexDecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidSetup.Wrap(err)
+	}
+
+	if len(b) != size {
+		return nil, ErrInvalidSetup.WithMsg("expected point of %d bytes, got %d", size, len(b))
+	}
+
+	return b, nil
+}
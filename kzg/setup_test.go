@@ -0,0 +1,125 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kzg
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto/bls12381"
+	"github.com/stretchr/testify/assert"
+)
+
+func testSetupFile(g1s, g2s [][]byte) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%d\n%d\n", len(g1s), len(g2s))
+	for _, p := range append(g1s, g2s...) {
+		fmt.Fprintf(&sb, "%x\n", p)
+	}
+	return sb.String()
+}
+
+func TestLoadTrustedSetup(t *testing.T) {
+	g1, g2 := bls12381.NewG1(), bls12381.NewG2()
+	tau := big.NewInt(0x5eed)
+	tauG2 := g2.MulScalar(g2.New(), g2.One(), tau)
+	tau2G2 := g2.MulScalar(g2.New(), tauG2, tau)
+
+	path := filepath.Join(t.TempDir(), "trusted_setup.txt")
+	content := testSetupFile([][]byte{CompressG1(g1.One())},
+		[][]byte{CompressG2(g2.One()), CompressG2(tauG2), CompressG2(tau2G2)})
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	setup, err := LoadTrustedSetup(path)
+	assert.NoError(t, err)
+	assert.True(t, g2.Equal(g2.One(), setup.g2))
+	assert.True(t, g2.Equal(tauG2, setup.tauG2))
+
+	_, err = LoadTrustedSetup(filepath.Join(t.TempDir(), "missing.txt"))
+	assert.Error(t, err)
+}
+
+func TestParseTrustedSetup_Invalid(t *testing.T) {
+	g2 := bls12381.NewG2().One()
+
+	for name, content := range map[string]string{
+		"empty":        "",
+		"bad count":    "x\n2\n",
+		"missing line": testSetupFile(nil, [][]byte{CompressG2(g2)}),
+		"wrong count":  "1\n2\n" + fmt.Sprintf("%x\n%x\n", CompressG2(g2), CompressG2(g2)),
+		"short point":  testSetupFile(nil, [][]byte{CompressG2(g2), CompressG2(g2)[:48]}),
+		"bad point":    testSetupFile(nil, [][]byte{CompressG2(g2), make([]byte, G2Size)}),
+	} {
+		_, err := ParseTrustedSetup(strings.NewReader(content))
+		assert.ErrorIs(t, err, ErrInvalidSetup, name)
+	}
+}
+
+func testPublicParameters(tau *big.Int, powers int) []byte {
+	g1, g2 := bls12381.NewG1(), bls12381.NewG2()
+
+	b := append(CompressG1(g1.One()), CompressG2(g2.One())...)
+	b = append(b, CompressG2(g2.MulScalar(g2.New(), g2.One(), tau))...)
+
+	p := g1.One()
+	for i := 0; i < powers; i++ {
+		b = append(b, CompressG1(p)...)
+		p = g1.MulScalar(g1.New(), p, tau)
+	}
+	return b
+}
+
+func TestLoadPublicParameters(t *testing.T) {
+	g1, g2 := bls12381.NewG1(), bls12381.NewG2()
+	tau := big.NewInt(0x5eed)
+
+	path := filepath.Join(t.TempDir(), "pp_4.data")
+	assert.NoError(t, os.WriteFile(path, testPublicParameters(tau, 4), 0o600))
+
+	setup, err := LoadPublicParameters(path)
+	assert.NoError(t, err)
+	assert.True(t, g1.Equal(g1.One(), setup.g1))
+	assert.True(t, g2.Equal(g2.One(), setup.g2))
+	assert.True(t, g2.Equal(g2.MulScalar(g2.New(), g2.One(), tau), setup.tauG2))
+
+	_, err = LoadPublicParameters(filepath.Join(t.TempDir(), "missing.data"))
+	assert.Error(t, err)
+}
+
+func TestParsePublicParameters_Invalid(t *testing.T) {
+	valid := testPublicParameters(big.NewInt(0x5eed), 2)
+
+	otherFirst := append([]byte{}, valid...)
+	copy(otherFirst[openingKeySize:], otherFirst[openingKeySize+G1Size:])
+
+	for name, b := range map[string][]byte{
+		"empty":              nil,
+		"short opening key":  valid[:openingKeySize-1],
+		"partial commit key": valid[:openingKeySize+G1Size-1],
+		"bad g1":             append(make([]byte, G1Size), valid[G1Size:]...),
+		"bad tau":            append(append([]byte{}, valid[:G1Size+G2Size]...), make([]byte, G2Size)...),
+		"other first power":  otherFirst,
+	} {
+		_, err := ParsePublicParameters(bytes.NewReader(b))
+		assert.ErrorIs(t, err, ErrInvalidSetup, name)
+	}
+}
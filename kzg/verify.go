@@ -0,0 +1,108 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kzg
+
+import (
+	"math/big"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/ethereum/go-ethereum/crypto/bls12381"
+)
+
+// CellResult is the result of the verification of a cell proof
+type CellResult struct {
+	Cell types.Cell
+	// Err is nil if the proof is valid, ErrInvalidProof if it does not match the commitment of the row, or the reason
+	// why the proof could not be verified
+	Err error
+}
+
+// Valid returns true if the proof of the cell is valid
+func (r CellResult) Valid() bool {
+	return r.Err == nil
+}
+
+// Verifier verifies cell proofs as returned by kate_queryProof against the row commitments of a block
+type Verifier struct {
+	setup       *TrustedSetup
+	commitments *Commitments
+}
+
+// NewVerifier creates a Verifier for the block with the given commitments
+func NewVerifier(setup *TrustedSetup, commitments *Commitments) *Verifier {
+	return &Verifier{setup: setup, commitments: commitments}
+}
+
+// NewHeaderVerifier creates a Verifier for the block with the given header
+func NewHeaderVerifier(setup *TrustedSetup, header types.Header) (*Verifier, error) {
+	c, err := HeaderCommitments(header)
+	if err != nil {
+		return nil, err
+	}
+	return NewVerifier(setup, c), nil
+}
+
+// VerifyCell verifies that the content of a cell and its proof match the commitment of the row of the cell
+func (v *Verifier) VerifyCell(cell types.Cell, proof types.CellProof) error {
+	if int(cell.Row) >= len(v.commitments.Rows) || uint32(cell.Col) >= v.commitments.Cols {
+		return ErrCellOutOfRange.WithMsg("cell (%d, %d), matrix of %d rows and %d columns", cell.Row, cell.Col,
+			len(v.commitments.Rows), v.commitments.Cols)
+	}
+
+	y := new(big.Int).SetBytes(proof.Scalar[:])
+	if y.Cmp(scalarModulus) >= 0 {
+		return ErrInvalidScalar.WithMsg("scalar of cell (%d, %d) exceeds the field modulus", cell.Row, cell.Col)
+	}
+
+	pi, err := DecompressG1(proof.Proof[:])
+	if err != nil {
+		return ErrInvalidProof.Wrap(err)
+	}
+
+	z := v.commitments.evaluationPoint(uint32(cell.Col))
+	g1 := bls12381.NewG1()
+
+	// e(C - y*G1 + z*pi, G2) == e(pi, tau*G2)
+	lhs := g1.New()
+	g1.MulScalar(lhs, v.setup.g1, y)
+	g1.Sub(lhs, v.commitments.Rows[cell.Row], lhs)
+	zPi := g1.New()
+	g1.MulScalar(zPi, pi, z)
+	g1.Add(lhs, lhs, zPi)
+
+	ok := bls12381.NewPairingEngine().AddPair(lhs, v.setup.g2).AddPairInv(pi, v.setup.tauG2).Check()
+	if !ok {
+		return ErrInvalidProof.WithMsg("cell (%d, %d)", cell.Row, cell.Col)
+	}
+
+	return nil
+}
+
+// VerifyCells verifies the proofs of the given cells, the proofs must be in the order of the cells as returned by
+// kate_queryProof. A result is returned for every cell.
+func (v *Verifier) VerifyCells(cells []types.Cell, proofs []types.CellProof) ([]CellResult, error) {
+	if len(cells) != len(proofs) {
+		return nil, ErrProofCountMismatch.WithMsg("%d cells, %d proofs", len(cells), len(proofs))
+	}
+
+	res := make([]CellResult, len(cells))
+	for i, cell := range cells {
+		res[i] = CellResult{Cell: cell, Err: v.VerifyCell(cell, proofs[i])}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,123 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kzg_test
+
+import (
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/kzg"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/kzg/kzgtest"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/ethereum/go-ethereum/crypto/bls12381"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCommitments(t *testing.T) {
+	m := kzgtest.NewMatrix(4, 8, 0)
+	c, err := kzg.ParseCommitments(m.Commitment())
+	assert.NoError(t, err)
+	assert.Len(t, c.Rows, 4)
+	assert.Equal(t, uint32(8), c.Cols)
+
+	header := types.Header{Extension: types.HeaderExtensionEnum{V3: types.V3HeaderExtension{
+		Commitment: m.Commitment(),
+	}}}
+	c, err = kzg.HeaderCommitments(header)
+	assert.NoError(t, err)
+	assert.Len(t, c.Rows, 4)
+
+	_, err = kzg.HeaderCommitments(types.Header{})
+	assert.Equal(t, kzg.ErrMissingExtension, err)
+
+	invalid := m.Commitment()
+	invalid.Commitment = invalid.Commitment[1:]
+	_, err = kzg.ParseCommitments(invalid)
+	assert.ErrorIs(t, err, kzg.ErrInvalidCommitment)
+
+	invalid = m.Commitment()
+	invalid.Commitment[kzg.G1Size] = 0x80 // compression flag without a valid x coordinate
+	_, err = kzg.ParseCommitments(invalid)
+	assert.ErrorIs(t, err, kzg.ErrInvalidCommitment)
+
+	invalid = m.Commitment()
+	invalid.Cols = types.NewUCompactFromUInt(6)
+	_, err = kzg.ParseCommitments(invalid)
+	assert.ErrorIs(t, err, kzg.ErrInvalidDimensions)
+}
+
+func TestVerifier_VerifyCells(t *testing.T) {
+	m := kzgtest.NewMatrix(4, 8, 0)
+	header := types.Header{Extension: types.HeaderExtensionEnum{V3: types.V3HeaderExtension{
+		Commitment: m.Commitment(),
+	}}}
+	v, err := kzg.NewHeaderVerifier(kzgtest.Setup(), header)
+	assert.NoError(t, err)
+
+	cells := []types.Cell{types.NewCell(0, 0), types.NewCell(1, 5), types.NewCell(3, 7), types.NewCell(2, 3)}
+	var proofs []types.CellProof
+	for _, c := range cells {
+		proofs = append(proofs, m.Proof(c))
+	}
+
+	// the content of a cell was altered, and a proof is for another cell
+	proofs[1].Scalar[31]++
+	proofs[3] = m.Proof(types.NewCell(2, 4))
+
+	res, err := v.VerifyCells(cells, proofs)
+	assert.NoError(t, err)
+	assert.Len(t, res, 4)
+	for i, r := range res {
+		assert.Equal(t, cells[i], r.Cell)
+	}
+	assert.True(t, res[0].Valid())
+	assert.ErrorIs(t, res[1].Err, kzg.ErrInvalidProof)
+	assert.True(t, res[2].Valid())
+	assert.ErrorIs(t, res[3].Err, kzg.ErrInvalidProof)
+
+	_, err = v.VerifyCells(cells, proofs[:3])
+	assert.ErrorIs(t, err, kzg.ErrProofCountMismatch)
+}
+
+func TestVerifier_VerifyCell_Invalid(t *testing.T) {
+	m := kzgtest.NewMatrix(2, 4, 0)
+	c, err := kzg.ParseCommitments(m.Commitment())
+	assert.NoError(t, err)
+	v := kzg.NewVerifier(kzgtest.Setup(), c)
+
+	assert.NoError(t, v.VerifyCell(types.NewCell(1, 3), m.Proof(types.NewCell(1, 3))))
+
+	// proof of another row
+	assert.ErrorIs(t, v.VerifyCell(types.NewCell(0, 3), m.Proof(types.NewCell(1, 3))), kzg.ErrInvalidProof)
+
+	assert.ErrorIs(t, v.VerifyCell(types.NewCell(2, 0), m.Proof(types.NewCell(1, 0))), kzg.ErrCellOutOfRange)
+	assert.ErrorIs(t, v.VerifyCell(types.NewCell(0, 4), m.Proof(types.NewCell(0, 0))), kzg.ErrCellOutOfRange)
+
+	p := m.Proof(types.NewCell(0, 1))
+	p.Scalar = types.KateScalar{0: 0xff}
+	assert.ErrorIs(t, v.VerifyCell(types.NewCell(0, 1), p), kzg.ErrInvalidScalar)
+
+	p = m.Proof(types.NewCell(0, 1))
+	p.Proof = types.KateProof{}
+	assert.ErrorIs(t, v.VerifyCell(types.NewCell(0, 1), p), kzg.ErrInvalidProof)
+
+	// a proof made with another secret
+	g2 := kzg.CompressG2(bls12381.NewG2().One())
+	other, err := kzg.NewTrustedSetup(g2, g2)
+	assert.NoError(t, err)
+	assert.ErrorIs(t, kzg.NewVerifier(other, c).VerifyCell(types.NewCell(0, 1), m.Proof(types.NewCell(0, 1))),
+		kzg.ErrInvalidProof)
+}
@@ -26,12 +26,18 @@ type SubstrateAPI struct {
 	Client client.Client
 }
 
-func NewSubstrateAPI(url string) (*SubstrateAPI, error) {
-	cl, err := client.Connect(url)
+func NewSubstrateAPI(url string, opts ...client.Option) (*SubstrateAPI, error) {
+	cl, err := client.Connect(url, opts...)
 	if err != nil {
 		return nil, err
 	}
 
+	return NewSubstrateAPIFromClient(cl)
+}
+
+// NewSubstrateAPIFromClient creates the API on top of an existing client, such as a pool created with
+// client.ConnectPool
+func NewSubstrateAPIFromClient(cl client.Client) (*SubstrateAPI, error) {
 	newRPC, err := rpc.NewRPC(cl)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,10 @@
+package mmr
+
+import libErr "github.com/centrifuge/go-substrate-rpc-client/v4/error"
+
+const (
+	ErrCorruptedProof    = libErr.Error("corrupted proof")
+	ErrLeafCountMismatch = libErr.Error("number of leaves does not match the proof")
+	ErrInvalidLeafIndex  = libErr.Error("leaf index out of range")
+	ErrRootMismatch      = libErr.Error("root mismatch")
+)
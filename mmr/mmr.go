@@ -0,0 +1,104 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mmr implements the verification of proofs of the Merkle Mountain Range maintained by the Substrate MMR
+// pallet, with keccak256 as the hashing function (as used for BEEFY).
+package mmr
+
+import (
+	"math/bits"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+	"golang.org/x/crypto/sha3"
+)
+
+// LeafHash returns the hash of an encoded leaf, as it is stored in the MMR
+func LeafHash(leaf types.MMREncodableOpaqueLeaf) types.H256 {
+	return keccak(leaf)
+}
+
+// HashLeaf encodes leaf and returns its hash, as it is stored in the MMR
+func HashLeaf(leaf types.MMRLeaf) (types.H256, error) {
+	enc, err := codec.Encode(leaf)
+	if err != nil {
+		return types.H256{}, err
+	}
+	return keccak(enc), nil
+}
+
+// merge returns the hash of an inner node with the given children
+func merge(left, right types.H256) types.H256 {
+	return keccak(left[:], right[:])
+}
+
+func keccak(data ...[]byte) types.H256 {
+	h := sha3.NewLegacyKeccak256()
+	for _, d := range data {
+		h.Write(d) //nolint:errcheck
+	}
+	var res types.H256
+	copy(res[:], h.Sum(nil))
+	return res
+}
+
+// leafIndexToPos returns the position of the leaf with the given index in the MMR
+func leafIndexToPos(index uint64) uint64 {
+	return mmrSize(index+1) - uint64(bits.TrailingZeros64(index+1)) - 1
+}
+
+// mmrSize returns the number of nodes of a MMR with the given number of leaves
+func mmrSize(leafCount uint64) uint64 {
+	return 2*leafCount - uint64(bits.OnesCount64(leafCount))
+}
+
+// peaks returns the positions of the peaks of a MMR with the given number of leaves, from left to right. Every set bit
+// of the leaf count is a perfect binary tree with that many leaves.
+func peaks(leafCount uint64) []uint64 {
+	var res []uint64
+	var offset uint64
+	for h := 63; h >= 0; h-- {
+		if leafCount&(1<<uint(h)) == 0 {
+			continue
+		}
+		size := uint64(1)<<uint(h+1) - 1
+		offset += size
+		res = append(res, offset-1)
+	}
+	return res
+}
+
+// posHeight returns the height of the node at the given position, leaves have a height of 0
+func posHeight(pos uint64) uint32 {
+	pos++
+	for !allOnes(pos) {
+		// jump to the node at the same height in the left sibling tree
+		pos -= uint64(1)<<uint(bits.Len64(pos)-1) - 1
+	}
+	return uint32(bits.Len64(pos) - 1)
+}
+
+func allOnes(n uint64) bool {
+	return n != 0 && bits.OnesCount64(n) == bits.Len64(n)
+}
+
+func parentOffset(height uint32) uint64 {
+	return 2 << height
+}
+
+func siblingOffset(height uint32) uint64 {
+	return 2<<height - 1
+}
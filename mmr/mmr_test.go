@@ -0,0 +1,78 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mmr
+
+import (
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLeafIndexToPos(t *testing.T) {
+	//       14
+	//    6      13
+	//  2   5   9   12   17
+	// 0 1 3 4 7 8 10 11 15 16 18
+	expected := []uint64{0, 1, 3, 4, 7, 8, 10, 11, 15, 16, 18}
+	for i, pos := range expected {
+		assert.Equal(t, pos, leafIndexToPos(uint64(i)))
+	}
+}
+
+func TestMMRSize(t *testing.T) {
+	assert.Equal(t, uint64(1), mmrSize(1))
+	assert.Equal(t, uint64(3), mmrSize(2))
+	assert.Equal(t, uint64(4), mmrSize(3))
+	assert.Equal(t, uint64(15), mmrSize(8))
+	assert.Equal(t, uint64(19), mmrSize(11))
+}
+
+func TestPeaks(t *testing.T) {
+	assert.Equal(t, []uint64{0}, peaks(1))
+	assert.Equal(t, []uint64{2, 3}, peaks(3))
+	assert.Equal(t, []uint64{14}, peaks(8))
+	assert.Equal(t, []uint64{14, 17, 18}, peaks(11))
+}
+
+func TestPosHeight(t *testing.T) {
+	expected := map[uint64]uint32{0: 0, 1: 0, 2: 1, 5: 1, 6: 2, 13: 2, 14: 3, 17: 1, 18: 0}
+	for pos, height := range expected {
+		assert.Equal(t, height, posHeight(pos), "position %d", pos)
+	}
+}
+
+func TestHashLeaf(t *testing.T) {
+	leaf := types.MMRLeaf{
+		ParentNumberAndHash:   types.ParentNumberAndHash{ParentNumber: 2000, Hash: types.Hash{1, 2, 3}},
+		BeefyNextAuthoritySet: types.BeefyNextAuthoritySet{ID: 1, Len: 3, Root: types.H256{4, 5, 6}},
+	}
+
+	enc, err := codec.Encode(leaf)
+	assert.NoError(t, err)
+
+	h, err := HashLeaf(leaf)
+	assert.NoError(t, err)
+	assert.Equal(t, LeafHash(enc), h)
+}
+
+func TestLeafHash(t *testing.T) {
+	// keccak256 of the empty string
+	assert.Equal(t, types.NewH256(codec.MustHexDecodeString(
+		"0xc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470")), LeafHash(nil))
+}
@@ -0,0 +1,216 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mmr
+
+import (
+	"sort"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+// VerifyProof verifies that leaf is part of the MMR with the given root
+func VerifyProof(root types.H256, leaf types.MMREncodableOpaqueLeaf, proof types.MMRProof) error {
+	return VerifyBatchProof(root, []types.MMREncodableOpaqueLeaf{leaf}, types.MMRBatchProof{
+		LeafIndices: []types.U64{proof.LeafIndex},
+		LeafCount:   proof.LeafCount,
+		Items:       proof.Items,
+	})
+}
+
+// VerifyLeavesProof verifies that the leaves of a proof returned by mmr_generateProof are part of the MMR with the given
+// root
+func VerifyLeavesProof(root types.H256, proof types.MMRLeavesProof) error {
+	return VerifyBatchProof(root, proof.Leaves, proof.Proof)
+}
+
+// VerifyBatchProof verifies that all leaves are part of the MMR with the given root. The leaves must be given in the
+// order of the leaf indices of the proof.
+func VerifyBatchProof(root types.H256, leaves []types.MMREncodableOpaqueLeaf, proof types.MMRBatchProof) error {
+	calculated, err := CalculateRoot(leaves, proof)
+	if err != nil {
+		return err
+	}
+
+	if calculated != root {
+		return ErrRootMismatch
+	}
+
+	return nil
+}
+
+// CalculateRoot calculates the root of the MMR from the leaves and the proof. The leaves must be given in the order of
+// the leaf indices of the proof.
+func CalculateRoot(leaves []types.MMREncodableOpaqueLeaf, proof types.MMRBatchProof) (types.H256, error) {
+	if len(leaves) == 0 || len(leaves) != len(proof.LeafIndices) {
+		return types.H256{}, ErrLeafCountMismatch
+	}
+
+	nodes := make([]node, 0, len(leaves))
+	for i, leaf := range leaves {
+		index := uint64(proof.LeafIndices[i])
+		if index >= uint64(proof.LeafCount) {
+			return types.H256{}, ErrInvalidLeafIndex.WithMsg("leaf index %d, leaf count %d", index, proof.LeafCount)
+		}
+		nodes = append(nodes, node{pos: leafIndexToPos(index), hash: LeafHash(leaf)})
+	}
+
+	sort.SliceStable(nodes, func(i, j int) bool { return nodes[i].pos < nodes[j].pos })
+	nodes = dedup(nodes)
+
+	items := &proofItems{items: proof.Items}
+	var peakHashes []types.H256
+peaksLoop:
+	for _, peak := range peaks(uint64(proof.LeafCount)) {
+		n := 0
+		for n < len(nodes) && nodes[n].pos <= peak {
+			n++
+		}
+		peakNodes := nodes[:n]
+		nodes = nodes[n:]
+
+		var peakHash types.H256
+		switch {
+		case len(peakNodes) == 1 && peakNodes[0].pos == peak:
+			// the leaf is the peak
+			peakHash = peakNodes[0].hash
+		case len(peakNodes) == 0:
+			// the next item is either the peak itself or the bagged peaks right of it, if there are none left all
+			// peaks are accounted for
+			h, ok := items.next()
+			if !ok {
+				break peaksLoop
+			}
+			peakHash = h
+		default:
+			h, err := peakRoot(peakNodes, peak, items)
+			if err != nil {
+				return types.H256{}, err
+			}
+			peakHash = h
+		}
+		peakHashes = append(peakHashes, peakHash)
+	}
+
+	if len(nodes) != 0 || !items.done() || len(peakHashes) == 0 {
+		return types.H256{}, ErrCorruptedProof
+	}
+
+	// bag the peaks from right to left
+	for len(peakHashes) > 1 {
+		right := peakHashes[len(peakHashes)-1]
+		left := peakHashes[len(peakHashes)-2]
+		peakHashes = append(peakHashes[:len(peakHashes)-2], merge(right, left))
+	}
+
+	return peakHashes[0], nil
+}
+
+// peakRoot calculates the root of the perfect binary tree with the given peak from the given nodes, taking missing
+// siblings from the proof items
+func peakRoot(nodes []node, peak uint64, items *proofItems) (types.H256, error) {
+	queue := make([]node, len(nodes))
+	copy(queue, nodes)
+
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+
+		if cur.pos == peak {
+			if len(queue) != 0 {
+				return types.H256{}, ErrCorruptedProof
+			}
+			return cur.hash, nil
+		}
+
+		var parent node
+		if posHeight(cur.pos+1) > cur.height {
+			// cur is a right child
+			sibling, err := siblingHash(cur.pos-siblingOffset(cur.height), &queue, items)
+			if err != nil {
+				return types.H256{}, err
+			}
+			parent = node{pos: cur.pos + 1, hash: merge(sibling, cur.hash)}
+		} else {
+			// cur is a left child
+			sibling, err := siblingHash(cur.pos+siblingOffset(cur.height), &queue, items)
+			if err != nil {
+				return types.H256{}, err
+			}
+			parent = node{pos: cur.pos + parentOffset(cur.height), hash: merge(cur.hash, sibling)}
+		}
+
+		if parent.pos > peak {
+			return types.H256{}, ErrCorruptedProof
+		}
+		parent.height = cur.height + 1
+		queue = append(queue, parent)
+	}
+
+	return types.H256{}, ErrCorruptedProof
+}
+
+// siblingHash returns the hash of the sibling at pos, either from the queue of nodes that are known or from the proof
+func siblingHash(pos uint64, queue *[]node, items *proofItems) (types.H256, error) {
+	if len(*queue) > 0 && (*queue)[0].pos == pos {
+		h := (*queue)[0].hash
+		*queue = (*queue)[1:]
+		return h, nil
+	}
+
+	h, ok := items.next()
+	if !ok {
+		return types.H256{}, ErrCorruptedProof
+	}
+	return h, nil
+}
+
+// node is a node of the MMR with its position and height
+type node struct {
+	pos    uint64
+	height uint32
+	hash   types.H256
+}
+
+// dedup removes nodes with the same position from sorted nodes, keeping the first one
+func dedup(nodes []node) []node {
+	res := nodes[:0]
+	for _, n := range nodes {
+		if len(res) > 0 && res[len(res)-1].pos == n.pos {
+			continue
+		}
+		res = append(res, n)
+	}
+	return res
+}
+
+// proofItems iterates over the items of a proof
+type proofItems struct {
+	items []types.H256
+	i     int
+}
+
+func (p *proofItems) next() (types.H256, bool) {
+	if p.i >= len(p.items) {
+		return types.H256{}, false
+	}
+	p.i++
+	return p.items[p.i-1], true
+}
+
+func (p *proofItems) done() bool {
+	return p.i >= len(p.items)
+}
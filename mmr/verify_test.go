@@ -0,0 +1,248 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mmr
+
+import (
+	"encoding/binary"
+	"sort"
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// testMMR is a MMR that keeps all of its nodes in memory, used to generate proofs like the MMR pallet does
+type testMMR struct {
+	nodes  []types.H256
+	leaves []types.MMREncodableOpaqueLeaf
+}
+
+func newTestMMR(leafCount int) *testMMR {
+	m := &testMMR{}
+	for i := 0; i < leafCount; i++ {
+		leaf := make(types.MMREncodableOpaqueLeaf, 8)
+		binary.LittleEndian.PutUint64(leaf, uint64(i))
+		m.push(leaf)
+	}
+	return m
+}
+
+func (m *testMMR) push(leaf types.MMREncodableOpaqueLeaf) {
+	m.leaves = append(m.leaves, leaf)
+	m.nodes = append(m.nodes, LeafHash(leaf))
+	for height := uint32(0); posHeight(uint64(len(m.nodes))) > height; height++ {
+		right := uint64(len(m.nodes)) - 1
+		m.nodes = append(m.nodes, merge(m.nodes[right-siblingOffset(height)], m.nodes[right]))
+	}
+}
+
+func (m *testMMR) root() types.H256 {
+	ps := peaks(uint64(len(m.leaves)))
+	root := m.nodes[ps[len(ps)-1]]
+	for i := len(ps) - 2; i >= 0; i-- {
+		root = merge(root, m.nodes[ps[i]])
+	}
+	return root
+}
+
+func (m *testMMR) proof(indices ...uint64) types.MMRBatchProof {
+	var positions []uint64
+	for _, i := range indices {
+		positions = append(positions, leafIndexToPos(i))
+	}
+	sort.Slice(positions, func(i, j int) bool { return positions[i] < positions[j] })
+
+	var items []types.H256
+	baggingTrack := 0
+	for _, peak := range peaks(uint64(len(m.leaves))) {
+		n := 0
+		for n < len(positions) && positions[n] <= peak {
+			n++
+		}
+		peakPositions := positions[:n]
+		positions = positions[n:]
+
+		if len(peakPositions) == 0 {
+			baggingTrack++
+			items = append(items, m.nodes[peak])
+			continue
+		}
+		baggingTrack = 0
+
+		type queued struct {
+			pos    uint64
+			height uint32
+		}
+		var queue []queued
+		for _, p := range peakPositions {
+			if len(queue) == 0 || queue[len(queue)-1].pos != p {
+				queue = append(queue, queued{pos: p})
+			}
+		}
+		for len(queue) > 0 {
+			cur := queue[0]
+			queue = queue[1:]
+			if cur.pos == peak {
+				break
+			}
+
+			sib, parent := cur.pos+siblingOffset(cur.height), cur.pos+parentOffset(cur.height)
+			if posHeight(cur.pos+1) > cur.height {
+				sib, parent = cur.pos-siblingOffset(cur.height), cur.pos+1
+			}
+			if len(queue) > 0 && queue[0].pos == sib {
+				queue = queue[1:]
+			} else {
+				items = append(items, m.nodes[sib])
+			}
+			if parent < peak {
+				queue = append(queue, queued{pos: parent, height: cur.height + 1})
+			}
+		}
+	}
+
+	if baggingTrack > 1 {
+		rhs := items[len(items)-baggingTrack:]
+		bagged := rhs[len(rhs)-1]
+		for i := len(rhs) - 2; i >= 0; i-- {
+			bagged = merge(bagged, rhs[i])
+		}
+		items = append(items[:len(items)-baggingTrack], bagged)
+	}
+
+	leafIndices := make([]types.U64, len(indices))
+	for i, index := range indices {
+		leafIndices[i] = types.U64(index)
+	}
+	return types.MMRBatchProof{LeafIndices: leafIndices, LeafCount: types.U64(len(m.leaves)), Items: items}
+}
+
+func (m *testMMR) leavesFor(indices ...uint64) []types.MMREncodableOpaqueLeaf {
+	leaves := make([]types.MMREncodableOpaqueLeaf, len(indices))
+	for i, index := range indices {
+		leaves[i] = m.leaves[index]
+	}
+	return leaves
+}
+
+func TestCalculateRoot_ThreeLeaves(t *testing.T) {
+	m := newTestMMR(3)
+	l0, l1, l2 := LeafHash(m.leaves[0]), LeafHash(m.leaves[1]), LeafHash(m.leaves[2])
+	root := merge(l2, merge(l0, l1))
+	assert.Equal(t, root, m.root())
+
+	root, err := CalculateRoot(m.leavesFor(0), types.MMRBatchProof{
+		LeafIndices: []types.U64{0},
+		LeafCount:   3,
+		Items:       []types.H256{l1, l2},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, m.root(), root)
+
+	root, err = CalculateRoot(m.leavesFor(2), types.MMRBatchProof{
+		LeafIndices: []types.U64{2},
+		LeafCount:   3,
+		Items:       []types.H256{merge(l0, l1)},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, m.root(), root)
+}
+
+func TestVerifyProof(t *testing.T) {
+	for leafCount := 1; leafCount <= 40; leafCount++ {
+		m := newTestMMR(leafCount)
+		for i := 0; i < leafCount; i++ {
+			proof := m.proof(uint64(i))
+			err := VerifyProof(m.root(), m.leaves[i], types.MMRProof{
+				LeafIndex: proof.LeafIndices[0],
+				LeafCount: proof.LeafCount,
+				Items:     proof.Items,
+			})
+			assert.NoError(t, err, "leaf %d of %d", i, leafCount)
+		}
+	}
+}
+
+func TestVerifyBatchProof(t *testing.T) {
+	for leafCount := 1; leafCount <= 20; leafCount++ {
+		m := newTestMMR(leafCount)
+		for i := 0; i < leafCount; i++ {
+			for j := i + 1; j < leafCount; j++ {
+				indices := []uint64{uint64(j), uint64(i)}
+				err := VerifyBatchProof(m.root(), m.leavesFor(indices...), m.proof(indices...))
+				assert.NoError(t, err, "leaves %v of %d", indices, leafCount)
+			}
+		}
+	}
+
+	m := newTestMMR(11)
+	indices := []uint64{0, 3, 5, 8, 9, 10}
+	assert.NoError(t, VerifyBatchProof(m.root(), m.leavesFor(indices...), m.proof(indices...)))
+
+	// duplicate leaves are ignored
+	indices = []uint64{4, 4, 7}
+	assert.NoError(t, VerifyBatchProof(m.root(), m.leavesFor(indices...), m.proof(indices...)))
+}
+
+func TestVerifyLeavesProof(t *testing.T) {
+	m := newTestMMR(11)
+	proof := types.MMRLeavesProof{
+		BlockHash: types.H256{1},
+		Leaves:    m.leavesFor(2, 6),
+		Proof:     m.proof(2, 6),
+	}
+	assert.NoError(t, VerifyLeavesProof(m.root(), proof))
+	assert.Equal(t, ErrRootMismatch, VerifyLeavesProof(types.H256{1}, proof))
+}
+
+func TestVerifyBatchProof_Invalid(t *testing.T) {
+	m := newTestMMR(11)
+	root := m.root()
+	proof := m.proof(1, 9)
+	leaves := m.leavesFor(1, 9)
+
+	// wrong leaf
+	assert.Equal(t, ErrRootMismatch, VerifyBatchProof(root, m.leavesFor(1, 8), proof))
+
+	// leaves in the wrong order
+	assert.Equal(t, ErrRootMismatch, VerifyBatchProof(root, m.leavesFor(9, 1), proof))
+
+	// tampered item
+	tampered := proof
+	tampered.Items = append([]types.H256{}, proof.Items...)
+	tampered.Items[0][0]++
+	assert.Equal(t, ErrRootMismatch, VerifyBatchProof(root, leaves, tampered))
+
+	// missing item
+	missing := proof
+	missing.Items = proof.Items[:len(proof.Items)-1]
+	assert.Error(t, VerifyBatchProof(root, leaves, missing))
+
+	// additional item
+	additional := proof
+	additional.Items = append(append([]types.H256{}, proof.Items...), types.H256{})
+	assert.Equal(t, ErrCorruptedProof, VerifyBatchProof(root, leaves, additional))
+
+	// number of leaves does not match
+	assert.Equal(t, ErrLeafCountMismatch, VerifyBatchProof(root, leaves[:1], proof))
+	assert.Equal(t, ErrLeafCountMismatch, VerifyBatchProof(root, nil, types.MMRBatchProof{LeafCount: 11}))
+
+	// leaf index out of range
+	outOfRange := proof
+	outOfRange.LeafIndices = []types.U64{1, 11}
+	assert.ErrorIs(t, VerifyBatchProof(root, leaves, outOfRange), ErrInvalidLeafIndex)
+}
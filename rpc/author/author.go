@@ -19,14 +19,29 @@
 package author
 
 import (
+	"context"
+
 	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
 )
 
 type Author interface {
 	SubmitAndWatchExtrinsic(xt types.Extrinsic) (*ExtrinsicStatusSubscription, error)
+	SubmitAndWatchExtrinsicContext(ctx context.Context, xt types.Extrinsic) (*ExtrinsicStatusSubscription, error)
 	PendingExtrinsics() ([]types.Extrinsic, error)
+	PendingExtrinsicsContext(ctx context.Context) ([]types.Extrinsic, error)
 	SubmitExtrinsic(xt types.Extrinsic) (types.Hash, error)
+	SubmitExtrinsicContext(ctx context.Context, xt types.Extrinsic) (types.Hash, error)
+	InsertKey(keyType, suri string, publicKey []byte) error
+	InsertKeyContext(ctx context.Context, keyType, suri string, publicKey []byte) error
+	RotateKeys() (types.Bytes, error)
+	RotateKeysContext(ctx context.Context) (types.Bytes, error)
+	HasKey(publicKey []byte, keyType string) (bool, error)
+	HasKeyContext(ctx context.Context, publicKey []byte, keyType string) (bool, error)
+	HasSessionKeys(sessionKeys types.Bytes) (bool, error)
+	HasSessionKeysContext(ctx context.Context, sessionKeys types.Bytes) (bool, error)
+	RemoveExtrinsic(bytesOrHash []types.ExtrinsicOrHash) ([]types.Hash, error)
+	RemoveExtrinsicContext(ctx context.Context, bytesOrHash []types.ExtrinsicOrHash) ([]types.Hash, error)
 }
 
 // author exposes methods for authoring of network items
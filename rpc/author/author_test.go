@@ -0,0 +1,100 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package author
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/rpcmocksrv"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+	"golang.org/x/crypto/blake2b"
+)
+
+var testAuthor Author
+
+func TestMain(m *testing.M) {
+	s := rpcmocksrv.New()
+	err := s.RegisterName("author", &mockSrv)
+	if err != nil {
+		panic(err)
+	}
+
+	cl, err := client.Connect(s.URL)
+	if err != nil {
+		panic(err)
+	}
+	testAuthor = NewAuthor(cl)
+
+	os.Exit(m.Run())
+}
+
+// MockSrv holds data and methods exposed by the RPC Mock Server used in integration tests
+type MockSrv struct {
+	keystore    map[string]string
+	sessionKeys types.Bytes
+}
+
+func (s *MockSrv) InsertKey(keyType, suri, public string) {
+	s.keystore[keyType+public] = suri
+}
+
+// RotateKeys returns the session keys of the runtime in MetadataV14Data, which are four keys of 32 bytes
+func (s *MockSrv) RotateKeys() string {
+	return codec.HexEncodeToString(s.sessionKeys)
+}
+
+func (s *MockSrv) HasKey(public, keyType string) bool {
+	_, ok := s.keystore[keyType+public]
+	return ok
+}
+
+func (s *MockSrv) HasSessionKeys(sessionKeys string) bool {
+	return sessionKeys == codec.HexEncodeToString(s.sessionKeys)
+}
+
+func (s *MockSrv) RemoveExtrinsic(bytesOrHash []types.ExtrinsicOrHash) ([]types.Hash, error) {
+	hashes := make([]types.Hash, len(bytesOrHash))
+	for i, xt := range bytesOrHash {
+		if xt.IsHash {
+			hashes[i] = xt.AsHash
+			continue
+		}
+		enc, err := codec.Encode(xt.AsExtrinsic)
+		if err != nil {
+			return nil, err
+		}
+		hashes[i] = blake2b.Sum256(enc)
+	}
+	return hashes, nil
+}
+
+// mockSrv sets default data used in tests. This data might become stale when substrate is updated – just run the tests
+// against real servers and update the values stored here. To do that, replace s.URL with
+// config.Default().RPCURL
+var mockSrv = MockSrv{
+	keystore: map[string]string{},
+	sessionKeys: bytes.Join([][]byte{
+		bytes.Repeat([]byte{0x01}, 32),
+		bytes.Repeat([]byte{0x02}, 32),
+		bytes.Repeat([]byte{0x03}, 32),
+		bytes.Repeat([]byte{0x04}, 32),
+	}, nil),
+}
@@ -0,0 +1,41 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package author
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+// HasKey returns true if the keystore of the node has the private key for the given public key and key type ID. The
+// node must be started with the rpc-methods unsafe flag.
+func (a *author) HasKey(publicKey []byte, keyType string) (bool, error) {
+	return a.HasKeyContext(context.Background(), publicKey, keyType)
+}
+
+// HasKeyContext returns true if the keystore of the node has the private key for the given public key and key type
+// ID, the call is aborted when ctx is done
+func (a *author) HasKeyContext(ctx context.Context, publicKey []byte, keyType string) (bool, error) {
+	var res bool
+	err := a.client.CallContext(ctx, &res, "author_hasKey", codec.HexEncodeToString(publicKey), keyType)
+	if err != nil {
+		return false, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,42 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package author
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+// HasSessionKeys returns true if the keystore of the node has the private keys for all of the given session keys, as
+// returned by RotateKeys. The node must be started with the rpc-methods unsafe flag.
+func (a *author) HasSessionKeys(sessionKeys types.Bytes) (bool, error) {
+	return a.HasSessionKeysContext(context.Background(), sessionKeys)
+}
+
+// HasSessionKeysContext returns true if the keystore of the node has the private keys for all of the given session
+// keys, the call is aborted when ctx is done
+func (a *author) HasSessionKeysContext(ctx context.Context, sessionKeys types.Bytes) (bool, error) {
+	var res bool
+	err := a.client.CallContext(ctx, &res, "author_hasSessionKeys", codec.HexEncodeToString(sessionKeys))
+	if err != nil {
+		return false, err
+	}
+
+	return res, nil
+}
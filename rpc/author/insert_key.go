@@ -0,0 +1,35 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package author
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+// InsertKey inserts a key into the keystore of the node. KeyType is the four letter key type ID, e.g. "babe" or
+// "gran", suri the secret URI of the key and publicKey its public key. The node must be started with the rpc-methods
+// unsafe flag.
+func (a *author) InsertKey(keyType, suri string, publicKey []byte) error {
+	return a.InsertKeyContext(context.Background(), keyType, suri, publicKey)
+}
+
+// InsertKeyContext inserts a key into the keystore of the node, the call is aborted when ctx is done
+func (a *author) InsertKeyContext(ctx context.Context, keyType, suri string, publicKey []byte) error {
+	return a.client.CallContext(ctx, nil, "author_insertKey", keyType, suri, codec.HexEncodeToString(publicKey))
+}
@@ -17,14 +17,22 @@
 package author
 
 import (
+	"context"
+
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
 )
 
 // PendingExtrinsics returns all pending extrinsics, potentially grouped by sender
 func (a *author) PendingExtrinsics() ([]types.Extrinsic, error) {
+	return a.PendingExtrinsicsContext(context.Background())
+}
+
+// PendingExtrinsicsContext returns all pending extrinsics, potentially grouped by sender, the call is aborted when
+// ctx is done
+func (a *author) PendingExtrinsicsContext(ctx context.Context) ([]types.Extrinsic, error) {
 	var res []string
-	err := a.client.Call(&res, "author_pendingExtrinsics")
+	err := a.client.CallContext(ctx, &res, "author_pendingExtrinsics")
 	if err != nil {
 		return nil, err
 	}
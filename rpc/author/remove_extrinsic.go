@@ -0,0 +1,44 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package author
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+// RemoveExtrinsic removes the given extrinsics from the transaction pool, together with all extrinsics that depend on
+// them, and returns the hashes of the removed extrinsics. Use types.NewExtrinsicOrHashFromHash and
+// types.NewExtrinsicOrHashFromExtrinsic to identify the extrinsics. The node must be started with the rpc-methods
+// unsafe flag.
+func (a *author) RemoveExtrinsic(bytesOrHash []types.ExtrinsicOrHash) ([]types.Hash, error) {
+	return a.RemoveExtrinsicContext(context.Background(), bytesOrHash)
+}
+
+// RemoveExtrinsicContext removes the given extrinsics from the transaction pool, together with all extrinsics that
+// depend on them, and returns the hashes of the removed extrinsics. The call is aborted when ctx is done.
+func (a *author) RemoveExtrinsicContext(ctx context.Context, bytesOrHash []types.ExtrinsicOrHash) (
+	[]types.Hash, error) {
+	var res []types.Hash
+	err := a.client.CallContext(ctx, &res, "author_removeExtrinsic", bytesOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
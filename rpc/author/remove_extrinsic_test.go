@@ -0,0 +1,38 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package author
+
+import (
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/blake2b"
+)
+
+func TestAuthor_RemoveExtrinsic(t *testing.T) {
+	enc, err := codec.Encode(types.ExamplaryExtrinsic)
+	assert.NoError(t, err)
+
+	hashes, err := testAuthor.RemoveExtrinsic([]types.ExtrinsicOrHash{
+		types.NewExtrinsicOrHashFromHash(types.Hash{0x01}),
+		types.NewExtrinsicOrHashFromExtrinsic(types.ExamplaryExtrinsic),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []types.Hash{{0x01}, blake2b.Sum256(enc)}, hashes)
+}
@@ -0,0 +1,151 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package author
+
+import (
+	libErr "github.com/centrifuge/go-substrate-rpc-client/v4/error"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+const (
+	ErrSessionKeysTypeNotFound = libErr.Error("session keys type not found")
+	ErrSessionKeysLength       = libErr.Error("invalid session keys length")
+	ErrUnsupportedKeyType      = libErr.Error("unsupported public key type")
+)
+
+// SessionKey is the public key of one of the keys in the SessionKeys of the runtime
+type SessionKey struct {
+	// Name is the field name in SessionKeys, e.g. grandpa, babe or im_online
+	Name string
+	// TypeName is the type of the public key as given in the metadata
+	TypeName string
+	// PublicKey is the public key, its length depends on the crypto scheme of the key type
+	PublicKey types.Bytes
+}
+
+// DecodeSessionKeys splits the opaque session keys, as returned by RotateKeys, into the public keys of the SessionKeys
+// type in the metadata, which must be of version 14
+func DecodeSessionKeys(meta *types.Metadata, sessionKeys types.Bytes) ([]SessionKey, error) {
+	if meta.Version != 14 {
+		return nil, ErrSessionKeysTypeNotFound.WithMsg("unsupported metadata version %d", meta.Version)
+	}
+
+	lookup := meta.AsMetadataV14.EfficientLookup
+	sessionKeysType, err := findSessionKeysType(&meta.AsMetadataV14)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]SessionKey, len(sessionKeysType.Def.Composite.Fields))
+	rest := sessionKeys
+	for i, field := range sessionKeysType.Def.Composite.Fields {
+		size, err := encodedSize(lookup, field.Type.Int64())
+		if err != nil {
+			return nil, err
+		}
+		if len(rest) < size {
+			return nil, ErrSessionKeysLength.WithMsg("missing %s key", field.Name)
+		}
+
+		keys[i] = SessionKey{
+			Name:      string(field.Name),
+			TypeName:  string(field.TypeName),
+			PublicKey: append(types.Bytes{}, rest[:size]...),
+		}
+		rest = rest[size:]
+	}
+
+	if len(rest) != 0 {
+		return nil, ErrSessionKeysLength.WithMsg("%d trailing bytes", len(rest))
+	}
+
+	return keys, nil
+}
+
+// findSessionKeysType returns the value type of Session.NextKeys, or the type with the name SessionKeys if the
+// runtime does not use the session pallet
+func findSessionKeysType(meta *types.MetadataV14) (*types.Si1Type, error) {
+	var typ *types.Si1Type
+	for _, pallet := range meta.Pallets {
+		if pallet.Name != "Session" || !pallet.HasStorage {
+			continue
+		}
+		for _, item := range pallet.Storage.Items {
+			if item.Name == "NextKeys" && item.Type.IsMap {
+				typ = meta.EfficientLookup[item.Type.AsMap.Value.Int64()]
+			}
+		}
+	}
+
+	if typ == nil {
+		for _, t := range meta.Lookup.Types {
+			path := t.Type.Path
+			if len(path) > 0 && path[len(path)-1] == "SessionKeys" {
+				typ = &t.Type
+				break
+			}
+		}
+	}
+
+	if typ == nil || !typ.Def.IsComposite {
+		return nil, ErrSessionKeysTypeNotFound
+	}
+
+	return typ, nil
+}
+
+// encodedSize returns the size of an encoded public key of the given type. Public keys are fixed size byte arrays,
+// which are usually wrapped in one or more composites.
+func encodedSize(lookup map[int64]*types.Si1Type, typeID int64) (int, error) {
+	typ, ok := lookup[typeID]
+	if !ok {
+		return 0, ErrUnsupportedKeyType.WithMsg("type %d not found", typeID)
+	}
+
+	switch def := typ.Def; {
+	case def.IsComposite:
+		var size int
+		for _, field := range def.Composite.Fields {
+			fieldSize, err := encodedSize(lookup, field.Type.Int64())
+			if err != nil {
+				return 0, err
+			}
+			size += fieldSize
+		}
+		return size, nil
+	case def.IsArray:
+		size, err := encodedSize(lookup, def.Array.Type.Int64())
+		if err != nil {
+			return 0, err
+		}
+		return int(def.Array.Len) * size, nil
+	case def.IsTuple:
+		var size int
+		for _, elem := range def.Tuple {
+			elemSize, err := encodedSize(lookup, elem.Int64())
+			if err != nil {
+				return 0, err
+			}
+			size += elemSize
+		}
+		return size, nil
+	case def.IsPrimitive && def.Primitive.Si0TypeDefPrimitive == types.IsU8:
+		return 1, nil
+	default:
+		return 0, ErrUnsupportedKeyType.WithMsg("%v is not a fixed size type", typ.Path)
+	}
+}
@@ -0,0 +1,50 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package author
+
+import (
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeSessionKeys(t *testing.T) {
+	var meta types.Metadata
+	err := codec.DecodeFromHex(types.MetadataV14Data, &meta)
+	assert.NoError(t, err)
+
+	keys, err := DecodeSessionKeys(&meta, mockSrv.sessionKeys)
+	assert.NoError(t, err)
+	assert.Len(t, keys, 4)
+
+	for i, name := range []string{"grandpa", "babe", "im_online", "authority_discovery"} {
+		assert.Equal(t, name, keys[i].Name)
+		assert.Equal(t, mockSrv.sessionKeys[32*i:32*(i+1)], keys[i].PublicKey)
+	}
+	assert.Equal(t, "<Grandpa as $crate::BoundToRuntimeAppPublic>::Public", keys[0].TypeName)
+
+	_, err = DecodeSessionKeys(&meta, mockSrv.sessionKeys[:100])
+	assert.ErrorIs(t, err, ErrSessionKeysLength)
+
+	_, err = DecodeSessionKeys(&meta, append(mockSrv.sessionKeys, 0x00))
+	assert.ErrorIs(t, err, ErrSessionKeysLength)
+
+	_, err = DecodeSessionKeys(types.ExamplaryMetadataV13, mockSrv.sessionKeys)
+	assert.ErrorIs(t, err, ErrSessionKeysTypeNotFound)
+}
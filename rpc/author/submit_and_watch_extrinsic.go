@@ -18,26 +18,22 @@ package author
 
 import (
 	"context"
-	"sync"
 
-	"github.com/centrifuge/go-substrate-rpc-client/v4/config"
-	gethrpc "github.com/centrifuge/go-substrate-rpc-client/v4/gethrpc"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
 )
 
 // ExtrinsicStatusSubscription is a subscription established through one of the Client's subscribe methods.
 type ExtrinsicStatusSubscription struct {
-	sub      *gethrpc.ClientSubscription
-	channel  chan types.ExtrinsicStatus
-	quitOnce sync.Once // ensures quit is closed once
+	sub *client.BufferedSubscription[types.ExtrinsicStatus]
 }
 
 // Chan returns the subscription channel.
 //
 // The channel is closed when Unsubscribe is called on the subscription.
 func (s *ExtrinsicStatusSubscription) Chan() <-chan types.ExtrinsicStatus {
-	return s.channel
+	return s.sub.Chan()
 }
 
 // Err returns the subscription error channel. The intended use of Err is to schedule
@@ -52,33 +48,49 @@ func (s *ExtrinsicStatusSubscription) Err() <-chan error {
 	return s.sub.Err()
 }
 
-// Unsubscribe unsubscribes the notification and closes the error channel.
+// Reconnected returns a channel that receives a value whenever the subscription has been re-established after the
+// connection to the node was lost. Notifications may have been missed in between. It only ever receives values if
+// the subscription was created through a reconnecting client, see client.ConnectWithReconnect.
+func (s *ExtrinsicStatusSubscription) Reconnected() <-chan struct{} {
+	return s.sub.Reconnected()
+}
+
+// Dropped returns the number of notifications that have been dropped because the channel was full, see
+// client.SubscriptionOptions
+func (s *ExtrinsicStatusSubscription) Dropped() uint64 {
+	return s.sub.Dropped()
+}
+
+// Unsubscribe unsubscribes the notification and closes the error and notification channels.
 // It can safely be called more than once.
 func (s *ExtrinsicStatusSubscription) Unsubscribe() {
 	s.sub.Unsubscribe()
-	s.quitOnce.Do(func() {
-		close(s.channel)
-	})
 }
 
 // SubmitAndWatchExtrinsic will submit and subscribe to watch an extrinsic until unsubscribed, returning a subscription
 // that will receive server notifications containing the extrinsic status updates.
 func (a *author) SubmitAndWatchExtrinsic(xt types.Extrinsic) (*ExtrinsicStatusSubscription, error) { //nolint:lll
-	ctx, cancel := context.WithTimeout(context.Background(), config.Default().SubscribeTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), client.ConfigOf(a.client).SubscribeTimeout)
 	defer cancel()
 
-	c := make(chan types.ExtrinsicStatus)
+	return a.SubmitAndWatchExtrinsicContext(ctx, xt)
+}
 
+// SubmitAndWatchExtrinsicContext will submit and subscribe to watch an extrinsic until unsubscribed, returning a
+// subscription that will receive server notifications containing the extrinsic status updates. The context bounds
+// the subscription request only, the subscription itself lives until it is unsubscribed.
+func (a *author) SubmitAndWatchExtrinsicContext(ctx context.Context, xt types.Extrinsic) (
+	*ExtrinsicStatusSubscription, error) {
 	enc, err := codec.EncodeToHex(xt)
 	if err != nil {
 		return nil, err
 	}
 
-	sub, err := a.client.Subscribe(ctx, "author", "submitAndWatchExtrinsic", "unwatchExtrinsic", "extrinsicUpdate",
-		c, enc)
+	sub, err := client.SubscribeBuffered[types.ExtrinsicStatus](ctx, a.client, "author", "submitAndWatchExtrinsic",
+		"unwatchExtrinsic", "extrinsicUpdate", enc)
 	if err != nil {
 		return nil, err
 	}
 
-	return &ExtrinsicStatusSubscription{sub: sub, channel: c}, nil
+	return &ExtrinsicStatusSubscription{sub: sub}, nil
 }
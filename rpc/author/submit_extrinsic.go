@@ -17,19 +17,29 @@
 package author
 
 import (
+	"context"
+
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
 )
 
-// SubmitExtrinsic will submit a fully formatted extrinsic for block inclusion
+// SubmitExtrinsic will submit a fully formatted extrinsic for block inclusion. If the transaction pool rejects the
+// extrinsic, the error can be inspected with errors.As and errors.Is, e.g. for a libErr.ErrInvalidTransaction or
+// libErr.ErrPriorityTooLow.
 func (a *author) SubmitExtrinsic(xt types.Extrinsic) (types.Hash, error) {
+	return a.SubmitExtrinsicContext(context.Background(), xt)
+}
+
+// SubmitExtrinsicContext will submit a fully formatted extrinsic for block inclusion, the call is aborted when ctx
+// is done
+func (a *author) SubmitExtrinsicContext(ctx context.Context, xt types.Extrinsic) (types.Hash, error) {
 	enc, err := codec.EncodeToHex(xt)
 	if err != nil {
 		return types.Hash{}, err
 	}
 
 	var res string
-	err = a.client.Call(&res, "author_submitExtrinsic", enc)
+	err = a.client.CallContext(ctx, &res, "author_submitExtrinsic", enc)
 	if err != nil {
 		return types.Hash{}, err
 	}
@@ -18,25 +18,21 @@ package beefy
 
 import (
 	"context"
-	"sync"
 
-	"github.com/centrifuge/go-substrate-rpc-client/v4/config"
-	gethrpc "github.com/centrifuge/go-substrate-rpc-client/v4/gethrpc"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
 )
 
 // JustificationsSubscription is a subscription established through one of the Client's subscribe methods.
 type JustificationsSubscription struct {
-	sub      *gethrpc.ClientSubscription
-	channel  chan types.SignedCommitment
-	quitOnce sync.Once // ensures quit is closed once
+	sub *client.BufferedSubscription[types.SignedCommitment]
 }
 
 // Chan returns the subscription channel.
 //
 // The channel is closed when Unsubscribe is called on the subscription.
 func (s *JustificationsSubscription) Chan() <-chan types.SignedCommitment {
-	return s.channel
+	return s.sub.Chan()
 }
 
 // Err returns the subscription error channel. The intended use of Err is to schedule
@@ -51,28 +47,43 @@ func (s *JustificationsSubscription) Err() <-chan error {
 	return s.sub.Err()
 }
 
-// Unsubscribe unsubscribes the notification and closes the error channel.
+// Reconnected returns a channel that receives a value whenever the subscription has been re-established after the
+// connection to the node was lost. Notifications may have been missed in between. It only ever receives values if
+// the subscription was created through a reconnecting client, see client.ConnectWithReconnect.
+func (s *JustificationsSubscription) Reconnected() <-chan struct{} {
+	return s.sub.Reconnected()
+}
+
+// Dropped returns the number of notifications that have been dropped because the channel was full, see
+// client.SubscriptionOptions
+func (s *JustificationsSubscription) Dropped() uint64 {
+	return s.sub.Dropped()
+}
+
+// Unsubscribe unsubscribes the notification and closes the error and notification channels.
 // It can safely be called more than once.
 func (s *JustificationsSubscription) Unsubscribe() {
 	s.sub.Unsubscribe()
-	s.quitOnce.Do(func() {
-		close(s.channel)
-	})
 }
 
 // SubscribeJustifications subscribes beefy justifications, returning a subscription that will
 // receive server notifications containing the Header.
 func (b *beefy) SubscribeJustifications() (*JustificationsSubscription, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), config.Default().SubscribeTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), client.ConfigOf(b.client).SubscribeTimeout)
 	defer cancel()
 
-	ch := make(chan types.SignedCommitment)
+	return b.SubscribeJustificationsContext(ctx)
+}
 
-	sub, err := b.client.Subscribe(ctx, "beefy", "subscribeJustifications", "unsubscribeJustifications",
-		"justifications", ch)
+// SubscribeJustificationsContext subscribes beefy justifications, returning a subscription that will receive server
+// notifications containing the SignedCommitment. The context bounds the subscription request only, the subscription
+// itself lives until it is unsubscribed.
+func (b *beefy) SubscribeJustificationsContext(ctx context.Context) (*JustificationsSubscription, error) {
+	sub, err := client.SubscribeBuffered[types.SignedCommitment](ctx, b.client, "beefy", "subscribeJustifications",
+		"unsubscribeJustifications", "justifications")
 	if err != nil {
 		return nil, err
 	}
 
-	return &JustificationsSubscription{sub: sub, channel: ch}, nil
+	return &JustificationsSubscription{sub: sub}, nil
 }
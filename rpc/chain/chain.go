@@ -19,20 +19,35 @@
 package chain
 
 import (
+	"context"
+
 	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
 )
 
 type Chain interface {
 	SubscribeFinalizedHeads() (*FinalizedHeadsSubscription, error)
+	SubscribeFinalizedHeadsContext(ctx context.Context) (*FinalizedHeadsSubscription, error)
 	SubscribeNewHeads() (*NewHeadsSubscription, error)
+	SubscribeNewHeadsContext(ctx context.Context) (*NewHeadsSubscription, error)
 	GetBlockHash(blockNumber uint64) (types.Hash, error)
+	GetBlockHashContext(ctx context.Context, blockNumber uint64) (types.Hash, error)
 	GetBlockHashLatest() (types.Hash, error)
+	GetBlockHashLatestContext(ctx context.Context) (types.Hash, error)
 	GetFinalizedHead() (types.Hash, error)
+	GetFinalizedHeadContext(ctx context.Context) (types.Hash, error)
 	GetBlock(blockHash types.Hash) (*types.SignedBlock, error)
+	GetBlockContext(ctx context.Context, blockHash types.Hash) (*types.SignedBlock, error)
 	GetBlockLatest() (*types.SignedBlock, error)
+	GetBlockLatestContext(ctx context.Context) (*types.SignedBlock, error)
 	GetHeader(blockHash types.Hash) (*types.Header, error)
+	GetHeaderContext(ctx context.Context, blockHash types.Hash) (*types.Header, error)
 	GetHeaderLatest() (*types.Header, error)
+	GetHeaderLatestContext(ctx context.Context) (*types.Header, error)
+	GetBlockHashes(from, to uint64) (hashes []types.Hash, errs []error, err error)
+	GetBlockHashesContext(ctx context.Context, from, to uint64) (hashes []types.Hash, errs []error, err error)
+	GetHeaders(blockHashes []types.Hash) (headers []*types.Header, errs []error, err error)
+	GetHeadersContext(ctx context.Context, blockHashes []types.Hash) (headers []*types.Header, errs []error, err error)
 }
 
 // chain exposes methods for retrieval of chain data
@@ -1,6 +1,8 @@
 package generic
 
 import (
+	"context"
+
 	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
 	libErr "github.com/centrifuge/go-substrate-rpc-client/v4/error"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
@@ -21,7 +23,9 @@ type Chain[
 	B GenericSignedBlock[A, S, P],
 ] interface {
 	GetBlock(blockHash types.Hash) (B, error)
+	GetBlockContext(ctx context.Context, blockHash types.Hash) (B, error)
 	GetBlockLatest() (B, error)
+	GetBlockLatestContext(ctx context.Context) (B, error)
 }
 
 // genericChain implements the Chain interface.
@@ -67,12 +71,22 @@ func NewDefaultChain(client client.Client) DefaultChain {
 
 // GetBlock retrieves a generic block B found at blockHash.
 func (g *genericChain[A, S, P, B]) GetBlock(blockHash types.Hash) (B, error) {
-	return g.getBlock(&blockHash)
+	return g.getBlock(context.Background(), &blockHash)
+}
+
+// GetBlockContext retrieves a generic block B found at blockHash, the call is aborted when ctx is done.
+func (g *genericChain[A, S, P, B]) GetBlockContext(ctx context.Context, blockHash types.Hash) (B, error) {
+	return g.getBlock(ctx, &blockHash)
 }
 
 // GetBlockLatest returns the latest generic block B.
 func (g *genericChain[A, S, P, B]) GetBlockLatest() (B, error) {
-	return g.getBlock(nil)
+	return g.getBlock(context.Background(), nil)
+}
+
+// GetBlockLatestContext returns the latest generic block B, the call is aborted when ctx is done.
+func (g *genericChain[A, S, P, B]) GetBlockLatestContext(ctx context.Context) (B, error) {
+	return g.getBlock(ctx, nil)
 }
 
 const (
@@ -80,10 +94,10 @@ const (
 )
 
 // getBlock retrieves the generic block B.
-func (g *genericChain[A, S, P, B]) getBlock(blockHash *types.Hash) (B, error) {
+func (g *genericChain[A, S, P, B]) getBlock(ctx context.Context, blockHash *types.Hash) (B, error) {
 	block := new(B)
 
-	if err := client.CallWithBlockHash(g.client, block, getBlockMethod, blockHash); err != nil {
+	if err := client.CallWithBlockHashContext(ctx, g.client, block, getBlockMethod, blockHash); err != nil {
 		return *block, ErrGetBlockCall.Wrap(err)
 	}
 
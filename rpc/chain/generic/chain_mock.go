@@ -3,6 +3,8 @@
 package generic
 
 import (
+	context "context"
+
 	types "github.com/centrifuge/go-substrate-rpc-client/v4/types"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -33,6 +35,27 @@ func (_m *ChainMock[A, S, P, B]) GetBlock(blockHash types.Hash) (B, error) {
 	return r0, r1
 }
 
+// GetBlockContext provides a mock function with given fields: ctx, blockHash
+func (_m *ChainMock[A, S, P, B]) GetBlockContext(ctx context.Context, blockHash types.Hash) (B, error) {
+	ret := _m.Called(ctx, blockHash)
+
+	var r0 B
+	if rf, ok := ret.Get(0).(func(context.Context, types.Hash) B); ok {
+		r0 = rf(ctx, blockHash)
+	} else {
+		r0 = ret.Get(0).(B)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.Hash) error); ok {
+		r1 = rf(ctx, blockHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBlockLatest provides a mock function with given fields:
 func (_m *ChainMock[A, S, P, B]) GetBlockLatest() (B, error) {
 	ret := _m.Called()
@@ -54,6 +77,27 @@ func (_m *ChainMock[A, S, P, B]) GetBlockLatest() (B, error) {
 	return r0, r1
 }
 
+// GetBlockLatestContext provides a mock function with given fields: ctx
+func (_m *ChainMock[A, S, P, B]) GetBlockLatestContext(ctx context.Context) (B, error) {
+	ret := _m.Called(ctx)
+
+	var r0 B
+	if rf, ok := ret.Get(0).(func(context.Context) B); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(B)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type NewChainMockT interface {
 	mock.TestingT
 	Cleanup(func())
@@ -17,27 +17,39 @@
 package chain
 
 import (
+	"context"
+
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
 )
 
 // GetBlockHash returns the block hash for a specific block height
 func (c *chain) GetBlockHash(blockNumber uint64) (types.Hash, error) {
-	return c.getBlockHash(&blockNumber)
+	return c.getBlockHash(context.Background(), &blockNumber)
+}
+
+// GetBlockHashContext returns the block hash for a specific block height, the call is aborted when ctx is done
+func (c *chain) GetBlockHashContext(ctx context.Context, blockNumber uint64) (types.Hash, error) {
+	return c.getBlockHash(ctx, &blockNumber)
 }
 
 // GetBlockHashLatest returns the latest block hash
 func (c *chain) GetBlockHashLatest() (types.Hash, error) {
-	return c.getBlockHash(nil)
+	return c.getBlockHash(context.Background(), nil)
+}
+
+// GetBlockHashLatestContext returns the latest block hash, the call is aborted when ctx is done
+func (c *chain) GetBlockHashLatestContext(ctx context.Context) (types.Hash, error) {
+	return c.getBlockHash(ctx, nil)
 }
 
-func (c *chain) getBlockHash(blockNumber *uint64) (types.Hash, error) {
+func (c *chain) getBlockHash(ctx context.Context, blockNumber *uint64) (types.Hash, error) {
 	var res string
 	var err error
 
 	if blockNumber == nil {
-		err = c.client.Call(&res, "chain_getBlockHash")
+		err = c.client.CallContext(ctx, &res, "chain_getBlockHash")
 	} else {
-		err = c.client.Call(&res, "chain_getBlockHash", *blockNumber)
+		err = c.client.CallContext(ctx, &res, "chain_getBlockHash", *blockNumber)
 	}
 
 	if err != nil {
@@ -0,0 +1,89 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chain
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
+	libErr "github.com/centrifuge/go-substrate-rpc-client/v4/error"
+	gethrpc "github.com/centrifuge/go-substrate-rpc-client/v4/gethrpc"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+const (
+	ErrBlockRangeTooLarge = libErr.Error("block range too large")
+)
+
+// MaxBlockHashRange is the maximum number of block hashes GetBlockHashes fetches in a single call
+const MaxBlockHashRange = 100 * client.DefaultBatchSize
+
+// GetBlockHashes returns the block hashes for all block heights from `from` to `to`, both inclusive, using batch
+// requests instead of one round trip per block. The hashes and errors are returned in the order of the block heights,
+// an error for a single block height, e.g. one that does not exist yet, is reported in errs only. At most
+// MaxBlockHashRange block heights can be requested, ErrBlockRangeTooLarge is returned otherwise. The block heights are
+// sent in batches of client.DefaultBatchSize. The returned error is set if a batch failed as a whole, the hashes
+// fetched by earlier batches are still returned and the block heights that were not fetched have the error in errs.
+func (c *chain) GetBlockHashes(from, to uint64) (hashes []types.Hash, errs []error, err error) {
+	return c.getBlockHashes(context.Background(), from, to)
+}
+
+// GetBlockHashesContext returns the block hashes for all block heights from `from` to `to`, see GetBlockHashes. The
+// call is aborted when ctx is done.
+func (c *chain) GetBlockHashesContext(ctx context.Context, from, to uint64) (
+	hashes []types.Hash, errs []error, err error) {
+	return c.getBlockHashes(ctx, from, to)
+}
+
+func (c *chain) getBlockHashes(ctx context.Context, from, to uint64) ([]types.Hash, []error, error) {
+	if to < from {
+		return nil, nil, nil
+	}
+
+	if to-from >= MaxBlockHashRange {
+		return nil, nil, ErrBlockRangeTooLarge.WithMsg("%d to %d exceeds %d blocks", from, to, MaxBlockHashRange)
+	}
+
+	count := to - from + 1
+
+	res := make([]string, count)
+	batch := make([]gethrpc.BatchElem, count)
+
+	for i := range batch {
+		batch[i] = gethrpc.BatchElem{
+			Method: "chain_getBlockHash",
+			Args:   []interface{}{from + uint64(i)},
+			Result: &res[i],
+		}
+	}
+
+	batchErr := client.BatchCallInChunks(ctx, c.client, batch, client.DefaultBatchSize)
+
+	hashes := make([]types.Hash, count)
+	errs := make([]error, count)
+
+	for i, elem := range batch {
+		if elem.Error != nil {
+			errs[i] = elem.Error
+			continue
+		}
+
+		hashes[i], errs[i] = types.NewHashFromHexString(res[i])
+	}
+
+	return hashes, errs, batchErr
+}
@@ -0,0 +1,48 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chain
+
+import (
+	"math"
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChain_GetBlockHashes(t *testing.T) {
+	hashes, errs, err := testChain.GetBlockHashes(1, 3)
+	assert.NoError(t, err)
+	assert.Len(t, hashes, 3)
+	assert.Equal(t, []error{nil, nil, nil}, errs)
+
+	for i, hash := range hashes {
+		blk, err := testChain.GetBlock(hash)
+		assert.NoError(t, err)
+		assert.Equal(t, types.BlockNumber(i+1), blk.Block.Header.Number)
+	}
+}
+
+func TestChain_GetBlockHashes_RangeTooLarge(t *testing.T) {
+	hashes, errs, err := testChain.GetBlockHashes(0, math.MaxUint64)
+	assert.ErrorIs(t, err, ErrBlockRangeTooLarge)
+	assert.Nil(t, hashes)
+	assert.Nil(t, errs)
+
+	_, _, err = testChain.GetBlockHashes(1, MaxBlockHashRange+1)
+	assert.ErrorIs(t, err, ErrBlockRangeTooLarge)
+}
@@ -0,0 +1,80 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chain
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
+	libErr "github.com/centrifuge/go-substrate-rpc-client/v4/error"
+	gethrpc "github.com/centrifuge/go-substrate-rpc-client/v4/gethrpc"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+const (
+	ErrHeaderNotFound = libErr.Error("header not found")
+)
+
+// GetHeaders retrieves the headers for the given blocks, using batch requests instead of one round trip per block.
+// The headers and errors are returned in the order of the hashes, an error for a single block is reported in errs
+// only, ErrHeaderNotFound if the node does not know the block. The hashes are sent in batches of
+// client.DefaultBatchSize. The returned error is set if a batch failed as a whole, the headers fetched by earlier
+// batches are still returned and the blocks that were not fetched have the error in errs.
+func (c *chain) GetHeaders(blockHashes []types.Hash) (headers []*types.Header, errs []error, err error) {
+	return c.getHeaders(context.Background(), blockHashes)
+}
+
+// GetHeadersContext retrieves the headers for the given blocks, see GetHeaders. The call is aborted when ctx is done.
+func (c *chain) GetHeadersContext(ctx context.Context, blockHashes []types.Hash) (
+	headers []*types.Header, errs []error, err error) {
+	return c.getHeaders(ctx, blockHashes)
+}
+
+func (c *chain) getHeaders(ctx context.Context, blockHashes []types.Hash) ([]*types.Header, []error, error) {
+	headers := make([]*types.Header, len(blockHashes))
+	batch := make([]gethrpc.BatchElem, len(blockHashes))
+
+	for i, blockHash := range blockHashes {
+		hexHash, err := codec.Hex(blockHash)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		batch[i] = gethrpc.BatchElem{
+			Method: "chain_getHeader",
+			Args:   []interface{}{hexHash},
+			Result: &headers[i],
+		}
+	}
+
+	batchErr := client.BatchCallInChunks(ctx, c.client, batch, client.DefaultBatchSize)
+
+	errs := make([]error, len(blockHashes))
+
+	for i, elem := range batch {
+		switch {
+		case elem.Error != nil:
+			errs[i] = elem.Error
+			headers[i] = nil
+		case headers[i] == nil:
+			errs[i] = ErrHeaderNotFound.WithMsg("%s", blockHashes[i].Hex())
+		}
+	}
+
+	return headers, errs, batchErr
+}
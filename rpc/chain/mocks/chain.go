@@ -3,7 +3,10 @@
 package mocks
 
 import (
+	context "context"
+
 	chain "github.com/centrifuge/go-substrate-rpc-client/v4/rpc/chain"
+
 	mock "github.com/stretchr/testify/mock"
 
 	types "github.com/centrifuge/go-substrate-rpc-client/v4/types"
@@ -37,6 +40,29 @@ func (_m *Chain) GetBlock(blockHash types.Hash) (*types.SignedBlock, error) {
 	return r0, r1
 }
 
+// GetBlockContext provides a mock function with given fields: ctx, blockHash
+func (_m *Chain) GetBlockContext(ctx context.Context, blockHash types.Hash) (*types.SignedBlock, error) {
+	ret := _m.Called(ctx, blockHash)
+
+	var r0 *types.SignedBlock
+	if rf, ok := ret.Get(0).(func(context.Context, types.Hash) *types.SignedBlock); ok {
+		r0 = rf(ctx, blockHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.SignedBlock)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.Hash) error); ok {
+		r1 = rf(ctx, blockHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBlockHash provides a mock function with given fields: blockNumber
 func (_m *Chain) GetBlockHash(blockNumber uint64) (types.Hash, error) {
 	ret := _m.Called(blockNumber)
@@ -60,6 +86,29 @@ func (_m *Chain) GetBlockHash(blockNumber uint64) (types.Hash, error) {
 	return r0, r1
 }
 
+// GetBlockHashContext provides a mock function with given fields: ctx, blockNumber
+func (_m *Chain) GetBlockHashContext(ctx context.Context, blockNumber uint64) (types.Hash, error) {
+	ret := _m.Called(ctx, blockNumber)
+
+	var r0 types.Hash
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) types.Hash); ok {
+		r0 = rf(ctx, blockNumber)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Hash)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, blockNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBlockHashLatest provides a mock function with given fields:
 func (_m *Chain) GetBlockHashLatest() (types.Hash, error) {
 	ret := _m.Called()
@@ -83,6 +132,93 @@ func (_m *Chain) GetBlockHashLatest() (types.Hash, error) {
 	return r0, r1
 }
 
+// GetBlockHashLatestContext provides a mock function with given fields: ctx
+func (_m *Chain) GetBlockHashLatestContext(ctx context.Context) (types.Hash, error) {
+	ret := _m.Called(ctx)
+
+	var r0 types.Hash
+	if rf, ok := ret.Get(0).(func(context.Context) types.Hash); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Hash)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBlockHashes provides a mock function with given fields: from, to
+func (_m *Chain) GetBlockHashes(from uint64, to uint64) ([]types.Hash, []error, error) {
+	ret := _m.Called(from, to)
+
+	var r0 []types.Hash
+	if rf, ok := ret.Get(0).(func(uint64, uint64) []types.Hash); ok {
+		r0 = rf(from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Hash)
+		}
+	}
+
+	var r1 []error
+	if rf, ok := ret.Get(1).(func(uint64, uint64) []error); ok {
+		r1 = rf(from, to)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]error)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(uint64, uint64) error); ok {
+		r2 = rf(from, to)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetBlockHashesContext provides a mock function with given fields: ctx, from, to
+func (_m *Chain) GetBlockHashesContext(ctx context.Context, from uint64, to uint64) ([]types.Hash, []error, error) {
+	ret := _m.Called(ctx, from, to)
+
+	var r0 []types.Hash
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) []types.Hash); ok {
+		r0 = rf(ctx, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Hash)
+		}
+	}
+
+	var r1 []error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) []error); ok {
+		r1 = rf(ctx, from, to)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]error)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, uint64, uint64) error); ok {
+		r2 = rf(ctx, from, to)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // GetBlockLatest provides a mock function with given fields:
 func (_m *Chain) GetBlockLatest() (*types.SignedBlock, error) {
 	ret := _m.Called()
@@ -106,6 +242,29 @@ func (_m *Chain) GetBlockLatest() (*types.SignedBlock, error) {
 	return r0, r1
 }
 
+// GetBlockLatestContext provides a mock function with given fields: ctx
+func (_m *Chain) GetBlockLatestContext(ctx context.Context) (*types.SignedBlock, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *types.SignedBlock
+	if rf, ok := ret.Get(0).(func(context.Context) *types.SignedBlock); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.SignedBlock)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetFinalizedHead provides a mock function with given fields:
 func (_m *Chain) GetFinalizedHead() (types.Hash, error) {
 	ret := _m.Called()
@@ -129,6 +288,29 @@ func (_m *Chain) GetFinalizedHead() (types.Hash, error) {
 	return r0, r1
 }
 
+// GetFinalizedHeadContext provides a mock function with given fields: ctx
+func (_m *Chain) GetFinalizedHeadContext(ctx context.Context) (types.Hash, error) {
+	ret := _m.Called(ctx)
+
+	var r0 types.Hash
+	if rf, ok := ret.Get(0).(func(context.Context) types.Hash); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Hash)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetHeader provides a mock function with given fields: blockHash
 func (_m *Chain) GetHeader(blockHash types.Hash) (*types.Header, error) {
 	ret := _m.Called(blockHash)
@@ -152,6 +334,29 @@ func (_m *Chain) GetHeader(blockHash types.Hash) (*types.Header, error) {
 	return r0, r1
 }
 
+// GetHeaderContext provides a mock function with given fields: ctx, blockHash
+func (_m *Chain) GetHeaderContext(ctx context.Context, blockHash types.Hash) (*types.Header, error) {
+	ret := _m.Called(ctx, blockHash)
+
+	var r0 *types.Header
+	if rf, ok := ret.Get(0).(func(context.Context, types.Hash) *types.Header); ok {
+		r0 = rf(ctx, blockHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Header)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.Hash) error); ok {
+		r1 = rf(ctx, blockHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetHeaderLatest provides a mock function with given fields:
 func (_m *Chain) GetHeaderLatest() (*types.Header, error) {
 	ret := _m.Called()
@@ -175,6 +380,93 @@ func (_m *Chain) GetHeaderLatest() (*types.Header, error) {
 	return r0, r1
 }
 
+// GetHeaderLatestContext provides a mock function with given fields: ctx
+func (_m *Chain) GetHeaderLatestContext(ctx context.Context) (*types.Header, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *types.Header
+	if rf, ok := ret.Get(0).(func(context.Context) *types.Header); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Header)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetHeaders provides a mock function with given fields: blockHashes
+func (_m *Chain) GetHeaders(blockHashes []types.Hash) ([]*types.Header, []error, error) {
+	ret := _m.Called(blockHashes)
+
+	var r0 []*types.Header
+	if rf, ok := ret.Get(0).(func([]types.Hash) []*types.Header); ok {
+		r0 = rf(blockHashes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Header)
+		}
+	}
+
+	var r1 []error
+	if rf, ok := ret.Get(1).(func([]types.Hash) []error); ok {
+		r1 = rf(blockHashes)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]error)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func([]types.Hash) error); ok {
+		r2 = rf(blockHashes)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetHeadersContext provides a mock function with given fields: ctx, blockHashes
+func (_m *Chain) GetHeadersContext(ctx context.Context, blockHashes []types.Hash) ([]*types.Header, []error, error) {
+	ret := _m.Called(ctx, blockHashes)
+
+	var r0 []*types.Header
+	if rf, ok := ret.Get(0).(func(context.Context, []types.Hash) []*types.Header); ok {
+		r0 = rf(ctx, blockHashes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Header)
+		}
+	}
+
+	var r1 []error
+	if rf, ok := ret.Get(1).(func(context.Context, []types.Hash) []error); ok {
+		r1 = rf(ctx, blockHashes)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]error)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, []types.Hash) error); ok {
+		r2 = rf(ctx, blockHashes)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // SubscribeFinalizedHeads provides a mock function with given fields:
 func (_m *Chain) SubscribeFinalizedHeads() (*chain.FinalizedHeadsSubscription, error) {
 	ret := _m.Called()
@@ -198,6 +490,29 @@ func (_m *Chain) SubscribeFinalizedHeads() (*chain.FinalizedHeadsSubscription, e
 	return r0, r1
 }
 
+// SubscribeFinalizedHeadsContext provides a mock function with given fields: ctx
+func (_m *Chain) SubscribeFinalizedHeadsContext(ctx context.Context) (*chain.FinalizedHeadsSubscription, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *chain.FinalizedHeadsSubscription
+	if rf, ok := ret.Get(0).(func(context.Context) *chain.FinalizedHeadsSubscription); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*chain.FinalizedHeadsSubscription)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SubscribeNewHeads provides a mock function with given fields:
 func (_m *Chain) SubscribeNewHeads() (*chain.NewHeadsSubscription, error) {
 	ret := _m.Called()
@@ -221,6 +536,29 @@ func (_m *Chain) SubscribeNewHeads() (*chain.NewHeadsSubscription, error) {
 	return r0, r1
 }
 
+// SubscribeNewHeadsContext provides a mock function with given fields: ctx
+func (_m *Chain) SubscribeNewHeadsContext(ctx context.Context) (*chain.NewHeadsSubscription, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *chain.NewHeadsSubscription
+	if rf, ok := ret.Get(0).(func(context.Context) *chain.NewHeadsSubscription); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*chain.NewHeadsSubscription)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type NewChainT interface {
 	mock.TestingT
 	Cleanup(func())
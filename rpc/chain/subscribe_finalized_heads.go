@@ -18,25 +18,21 @@ package chain
 
 import (
 	"context"
-	"sync"
 
-	"github.com/centrifuge/go-substrate-rpc-client/v4/config"
-	gethrpc "github.com/centrifuge/go-substrate-rpc-client/v4/gethrpc"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
 )
 
 // FinalizedHeadsSubscription is a subscription established through one of the Client's subscribe methods.
 type FinalizedHeadsSubscription struct {
-	sub      *gethrpc.ClientSubscription
-	channel  chan types.Header
-	quitOnce sync.Once // ensures quit is closed once
+	sub *client.BufferedSubscription[types.Header]
 }
 
 // Chan returns the subscription channel.
 //
 // The channel is closed when Unsubscribe is called on the subscription.
 func (s *FinalizedHeadsSubscription) Chan() <-chan types.Header {
-	return s.channel
+	return s.sub.Chan()
 }
 
 // Err returns the subscription error channel. The intended use of Err is to schedule
@@ -51,28 +47,43 @@ func (s *FinalizedHeadsSubscription) Err() <-chan error {
 	return s.sub.Err()
 }
 
-// Unsubscribe unsubscribes the notification and closes the error channel.
+// Reconnected returns a channel that receives a value whenever the subscription has been re-established after the
+// connection to the node was lost. Notifications may have been missed in between. It only ever receives values if
+// the subscription was created through a reconnecting client, see client.ConnectWithReconnect.
+func (s *FinalizedHeadsSubscription) Reconnected() <-chan struct{} {
+	return s.sub.Reconnected()
+}
+
+// Dropped returns the number of notifications that have been dropped because the channel was full, see
+// client.SubscriptionOptions
+func (s *FinalizedHeadsSubscription) Dropped() uint64 {
+	return s.sub.Dropped()
+}
+
+// Unsubscribe unsubscribes the notification and closes the error and notification channels.
 // It can safely be called more than once.
 func (s *FinalizedHeadsSubscription) Unsubscribe() {
 	s.sub.Unsubscribe()
-	s.quitOnce.Do(func() {
-		close(s.channel)
-	})
 }
 
 // SubscribeFinalizedHeads subscribes the best finalized headers, returning a subscription that will
 // receive server notifications containing the Header.
 func (c *chain) SubscribeFinalizedHeads() (*FinalizedHeadsSubscription, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), config.Default().SubscribeTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), client.ConfigOf(c.client).SubscribeTimeout)
 	defer cancel()
 
-	ch := make(chan types.Header)
+	return c.SubscribeFinalizedHeadsContext(ctx)
+}
 
-	sub, err := c.client.Subscribe(ctx, "chain", "subscribeFinalizedHeads", "unsubscribeFinalizedHeads",
-		"finalizedHead", ch)
+// SubscribeFinalizedHeadsContext subscribes the best finalized headers, returning a subscription that will
+// receive server notifications containing the Header. The context bounds the subscription request only,
+// the subscription itself lives until it is unsubscribed.
+func (c *chain) SubscribeFinalizedHeadsContext(ctx context.Context) (*FinalizedHeadsSubscription, error) {
+	sub, err := client.SubscribeBuffered[types.Header](ctx, c.client, "chain", "subscribeFinalizedHeads",
+		"unsubscribeFinalizedHeads", "finalizedHead")
 	if err != nil {
 		return nil, err
 	}
 
-	return &FinalizedHeadsSubscription{sub: sub, channel: ch}, nil
+	return &FinalizedHeadsSubscription{sub: sub}, nil
 }
@@ -18,25 +18,21 @@ package chain
 
 import (
 	"context"
-	"sync"
 
-	"github.com/centrifuge/go-substrate-rpc-client/v4/config"
-	gethrpc "github.com/centrifuge/go-substrate-rpc-client/v4/gethrpc"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
 )
 
 // NewHeadsSubscription is a subscription established through one of the Client's subscribe methods.
 type NewHeadsSubscription struct {
-	sub      *gethrpc.ClientSubscription
-	channel  chan types.Header
-	quitOnce sync.Once // ensures quit is closed once
+	sub *client.BufferedSubscription[types.Header]
 }
 
 // Chan returns the subscription channel.
 //
 // The channel is closed when Unsubscribe is called on the subscription.
 func (s *NewHeadsSubscription) Chan() <-chan types.Header {
-	return s.channel
+	return s.sub.Chan()
 }
 
 // Err returns the subscription error channel. The intended use of Err is to schedule
@@ -51,27 +47,43 @@ func (s *NewHeadsSubscription) Err() <-chan error {
 	return s.sub.Err()
 }
 
-// Unsubscribe unsubscribes the notification and closes the error channel.
+// Reconnected returns a channel that receives a value whenever the subscription has been re-established after the
+// connection to the node was lost. Notifications may have been missed in between. It only ever receives values if
+// the subscription was created through a reconnecting client, see client.ConnectWithReconnect.
+func (s *NewHeadsSubscription) Reconnected() <-chan struct{} {
+	return s.sub.Reconnected()
+}
+
+// Dropped returns the number of notifications that have been dropped because the channel was full, see
+// client.SubscriptionOptions
+func (s *NewHeadsSubscription) Dropped() uint64 {
+	return s.sub.Dropped()
+}
+
+// Unsubscribe unsubscribes the notification and closes the error and notification channels.
 // It can safely be called more than once.
 func (s *NewHeadsSubscription) Unsubscribe() {
 	s.sub.Unsubscribe()
-	s.quitOnce.Do(func() {
-		close(s.channel)
-	})
 }
 
 // SubscribeNewHeads subscribes the best headers, returning a subscription that will
 // receive server notifications containing the Header.
 func (c *chain) SubscribeNewHeads() (*NewHeadsSubscription, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), config.Default().SubscribeTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), client.ConfigOf(c.client).SubscribeTimeout)
 	defer cancel()
 
-	ch := make(chan types.Header)
+	return c.SubscribeNewHeadsContext(ctx)
+}
 
-	sub, err := c.client.Subscribe(ctx, "chain", "subscribeNewHead", "unsubscribeNewHead", "newHead", ch)
+// SubscribeNewHeadsContext subscribes the best headers, returning a subscription that will
+// receive server notifications containing the Header. The context bounds the subscription request only,
+// the subscription itself lives until it is unsubscribed.
+func (c *chain) SubscribeNewHeadsContext(ctx context.Context) (*NewHeadsSubscription, error) {
+	sub, err := client.SubscribeBuffered[types.Header](ctx, c.client, "chain", "subscribeNewHead", "unsubscribeNewHead",
+		"newHead")
 	if err != nil {
 		return nil, err
 	}
 
-	return &NewHeadsSubscription{sub: sub, channel: ch}, nil
+	return &NewHeadsSubscription{sub: sub}, nil
 }
@@ -0,0 +1,36 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chainhead
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+// Body starts retrieving the extrinsics of a pinned block, which are reported by an operationBodyDone event
+func (c *chainHead) Body(followSubscription string, blockHash types.Hash) (OperationStarted, error) {
+	return c.BodyContext(context.Background(), followSubscription, blockHash)
+}
+
+// BodyContext starts retrieving the extrinsics of a pinned block, see Body. The call is aborted when ctx is done.
+func (c *chainHead) BodyContext(ctx context.Context, followSubscription string, blockHash types.Hash) (
+	OperationStarted, error) {
+	var res OperationStarted
+	err := c.client.CallContext(ctx, &res, "chainHead_v1_body", followSubscription, blockHash.Hex())
+	return res, err
+}
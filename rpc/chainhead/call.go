@@ -0,0 +1,40 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chainhead
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+// Call starts calling a runtime API function with the SCALE encoded parameters on the state of a pinned block, e.g.
+// "Core_version". The output is reported by an operationCallDone event.
+func (c *chainHead) Call(followSubscription string, blockHash types.Hash, function string, callParameters []byte) (
+	OperationStarted, error) {
+	return c.CallContext(context.Background(), followSubscription, blockHash, function, callParameters)
+}
+
+// CallContext starts calling a runtime API function, see Call. The call is aborted when ctx is done.
+func (c *chainHead) CallContext(ctx context.Context, followSubscription string, blockHash types.Hash, function string,
+	callParameters []byte) (OperationStarted, error) {
+	var res OperationStarted
+	err := c.client.CallContext(ctx, &res, "chainHead_v1_call", followSubscription, blockHash.Hex(), function,
+		codec.HexEncodeToString(callParameters))
+	return res, err
+}
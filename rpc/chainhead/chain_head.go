@@ -0,0 +1,62 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate mockery --name ChainHead --filename chain_head.go
+
+package chainhead
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+// ChainHead exposes the chainHead_v1 methods of the new JSON-RPC interface, which follow the chain and query pinned
+// blocks. All methods but Follow take the ID of a follow subscription, see FollowSubscription.ID, and only work on the
+// node that holds that subscription, so they must not be used with a client pool.
+type ChainHead interface {
+	Follow(withRuntime bool) (*FollowSubscription, error)
+	FollowContext(ctx context.Context, withRuntime bool) (*FollowSubscription, error)
+	Header(followSubscription string, blockHash types.Hash) (*types.Header, error)
+	HeaderContext(ctx context.Context, followSubscription string, blockHash types.Hash) (*types.Header, error)
+	Body(followSubscription string, blockHash types.Hash) (OperationStarted, error)
+	BodyContext(ctx context.Context, followSubscription string, blockHash types.Hash) (OperationStarted, error)
+	Call(followSubscription string, blockHash types.Hash, function string, callParameters []byte) (
+		OperationStarted, error)
+	CallContext(ctx context.Context, followSubscription string, blockHash types.Hash, function string,
+		callParameters []byte) (OperationStarted, error)
+	Storage(followSubscription string, blockHash types.Hash, items []StorageQueryItem, childTrie []byte) (
+		OperationStarted, error)
+	StorageContext(ctx context.Context, followSubscription string, blockHash types.Hash, items []StorageQueryItem,
+		childTrie []byte) (OperationStarted, error)
+	Continue(followSubscription string, operationID string) error
+	ContinueContext(ctx context.Context, followSubscription string, operationID string) error
+	StopOperation(followSubscription string, operationID string) error
+	StopOperationContext(ctx context.Context, followSubscription string, operationID string) error
+	Unpin(followSubscription string, blockHashes ...types.Hash) error
+	UnpinContext(ctx context.Context, followSubscription string, blockHashes ...types.Hash) error
+}
+
+// chainHead exposes methods for following the chain
+type chainHead struct {
+	client client.Client
+}
+
+// NewChainHead creates a new chainHead struct
+func NewChainHead(cl client.Client) ChainHead {
+	return &chainHead{cl}
+}
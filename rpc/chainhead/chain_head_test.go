@@ -0,0 +1,170 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chainhead
+
+import (
+	"context"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
+	gethrpc "github.com/centrifuge/go-substrate-rpc-client/v4/gethrpc"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/rpcmocksrv"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+var testChainHead ChainHead
+
+func TestMain(m *testing.M) {
+	s := rpcmocksrv.New()
+	err := s.RegisterName("chainHead", &mockSrv)
+	if err != nil {
+		panic(err)
+	}
+
+	cl, err := client.Connect(s.URL)
+	if err != nil {
+		panic(err)
+	}
+	testChainHead = NewChainHead(cl)
+
+	os.Exit(m.Run())
+}
+
+// MockSrv holds data and methods exposed by the RPC Mock Server used in integration tests
+type MockSrv struct {
+	mu sync.Mutex
+
+	finalizedHash types.Hash
+	bestHash      types.Hash
+	header        types.Header
+	runtime       RuntimeSpec
+
+	unpinned  []string
+	continued []string
+	stopped   []string
+}
+
+// V1_follow sends the initialized, newBlock, bestBlockChanged and finalized events
+func (s *MockSrv) V1_follow(ctx context.Context, withRuntime bool) (*gethrpc.Subscription, error) { //nolint:revive
+	n, ok := gethrpc.NotifierFromContext(ctx)
+	if !ok {
+		return nil, gethrpc.ErrNotificationsUnsupported
+	}
+	sub := n.CreateSubscription()
+
+	var runtime interface{}
+	if withRuntime {
+		runtime = map[string]interface{}{"type": "valid", "spec": s.runtime}
+	}
+
+	events := []interface{}{
+		map[string]interface{}{"event": "initialized", "finalizedBlockHashes": []string{s.finalizedHash.Hex()},
+			"finalizedBlockRuntime": runtime},
+		map[string]interface{}{"event": "newBlock", "blockHash": s.bestHash.Hex(),
+			"parentBlockHash": s.finalizedHash.Hex()},
+		map[string]interface{}{"event": "bestBlockChanged", "bestBlockHash": s.bestHash.Hex()},
+		map[string]interface{}{"event": "finalized", "finalizedBlockHashes": []string{s.bestHash.Hex()},
+			"prunedBlockHashes": []string{}},
+	}
+
+	go func() {
+		for _, event := range events {
+			if err := n.Notify(sub.ID, event); err != nil {
+				return
+			}
+		}
+	}()
+
+	return sub, nil
+}
+
+func (s *MockSrv) V1_unfollow(followSubscription string) { //nolint:revive
+}
+
+func (s *MockSrv) V1_header(followSubscription string, blockHash string) (*string, error) { //nolint:revive
+	if blockHash != s.bestHash.Hex() {
+		return nil, nil
+	}
+
+	enc, err := codec.EncodeToHex(s.header)
+	return &enc, err
+}
+
+func (s *MockSrv) V1_body(followSubscription string, blockHash string) OperationStarted { //nolint:revive
+	return OperationStarted{Result: OperationResultStarted, OperationID: "body-" + blockHash[:6]}
+}
+
+func (s *MockSrv) V1_call(followSubscription string, blockHash string, function string, //nolint:revive
+	callParameters string) OperationStarted {
+	if function == "" {
+		return OperationStarted{Result: OperationResultLimitReached}
+	}
+	return OperationStarted{Result: OperationResultStarted, OperationID: function + "-" + callParameters}
+}
+
+func (s *MockSrv) V1_storage(followSubscription string, blockHash string, items []StorageQueryItem, //nolint:revive
+	childTrie *string) OperationStarted {
+	id := "storage"
+	if childTrie != nil {
+		id += "-" + *childTrie
+	}
+	return OperationStarted{Result: OperationResultStarted, OperationID: id, DiscardedItems: uint32(len(items) - 1)}
+}
+
+func (s *MockSrv) V1_continue(followSubscription string, operationID string) { //nolint:revive
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.continued = append(s.continued, operationID)
+}
+
+func (s *MockSrv) V1_stopOperation(followSubscription string, operationID string) { //nolint:revive
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stopped = append(s.stopped, operationID)
+}
+
+func (s *MockSrv) V1_unpin(followSubscription string, blockHashes []string) { //nolint:revive
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.unpinned = append(s.unpinned, blockHashes...)
+}
+
+// mockSrv sets default data used in tests. This data might become stale when substrate is updated – just run the tests
+// against real servers and update the values stored here. To do that, replace s.URL with
+// config.Default().RPCURL
+var mockSrv = MockSrv{
+	finalizedHash: types.NewHash(codec.MustHexDecodeString(
+		"0x1a2b3c0000000000000000000000000000000000000000000000000000000001")),
+	bestHash: types.NewHash(codec.MustHexDecodeString(
+		"0x4d5e6f0000000000000000000000000000000000000000000000000000000002")),
+	header: types.Header{
+		ParentHash: types.NewHash(codec.MustHexDecodeString(
+			"0x1a2b3c0000000000000000000000000000000000000000000000000000000001")),
+		Number: 42,
+	},
+	runtime: RuntimeSpec{
+		SpecName:           "polkadot",
+		ImplName:           "parity-polkadot",
+		SpecVersion:        1000000,
+		ImplVersion:        0,
+		TransactionVersion: 25,
+		Apis:               map[string]uint32{"0xdf6acb689907609b": 4},
+	},
+}
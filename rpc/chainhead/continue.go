@@ -0,0 +1,31 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chainhead
+
+import (
+	"context"
+)
+
+// Continue resumes a storage operation that is waiting after an operationWaitingForContinue event
+func (c *chainHead) Continue(followSubscription string, operationID string) error {
+	return c.ContinueContext(context.Background(), followSubscription, operationID)
+}
+
+// ContinueContext resumes a storage operation, see Continue. The call is aborted when ctx is done.
+func (c *chainHead) ContinueContext(ctx context.Context, followSubscription string, operationID string) error {
+	return c.client.CallContext(ctx, nil, "chainHead_v1_continue", followSubscription, operationID)
+}
@@ -0,0 +1,273 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chainhead
+
+import (
+	"encoding/json"
+
+	libErr "github.com/centrifuge/go-substrate-rpc-client/v4/error"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+const ErrUnknownFollowEvent = libErr.Error("unknown follow event")
+
+// HexBytes are bytes that are hex encoded in JSON, as used for keys, values and SCALE encoded data
+type HexBytes []byte
+
+// UnmarshalJSON decodes a hex string
+func (h *HexBytes) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	bz, err := codec.HexDecodeString(s)
+	if err != nil {
+		return err
+	}
+
+	*h = bz
+	return nil
+}
+
+// MarshalJSON encodes the bytes as hex string
+func (h HexBytes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(codec.HexEncodeToString(h))
+}
+
+// FollowEventType is the type of a follow event
+type FollowEventType string
+
+const (
+	EventInitialized                 FollowEventType = "initialized"
+	EventNewBlock                    FollowEventType = "newBlock"
+	EventBestBlockChanged            FollowEventType = "bestBlockChanged"
+	EventFinalized                   FollowEventType = "finalized"
+	EventOperationBodyDone           FollowEventType = "operationBodyDone"
+	EventOperationCallDone           FollowEventType = "operationCallDone"
+	EventOperationStorageItems       FollowEventType = "operationStorageItems"
+	EventOperationStorageDone        FollowEventType = "operationStorageDone"
+	EventOperationWaitingForContinue FollowEventType = "operationWaitingForContinue"
+	EventOperationInaccessible       FollowEventType = "operationInaccessible"
+	EventOperationError              FollowEventType = "operationError"
+	EventStop                        FollowEventType = "stop"
+)
+
+// FollowEvent is a notification of a follow subscription. Type tells which of the event fields is set, stop events
+// have no fields. After a stop event the subscription has ended and all blocks are unpinned, a new one has to be
+// created.
+type FollowEvent struct {
+	Type FollowEventType
+
+	Initialized                 *Initialized
+	NewBlock                    *NewBlock
+	BestBlockChanged            *BestBlockChanged
+	Finalized                   *Finalized
+	OperationBodyDone           *OperationBodyDone
+	OperationCallDone           *OperationCallDone
+	OperationStorageItems       *OperationStorageItems
+	OperationStorageDone        *Operation
+	OperationWaitingForContinue *Operation
+	OperationInaccessible       *Operation
+	OperationError              *OperationError
+}
+
+// UnmarshalJSON decodes the event into the field that matches its type
+func (e *FollowEvent) UnmarshalJSON(b []byte) error {
+	var tag struct {
+		Event FollowEventType `json:"event"`
+	}
+	if err := json.Unmarshal(b, &tag); err != nil {
+		return err
+	}
+
+	*e = FollowEvent{Type: tag.Event}
+
+	var target interface{}
+
+	switch tag.Event {
+	case EventInitialized:
+		e.Initialized = &Initialized{}
+		target = e.Initialized
+	case EventNewBlock:
+		e.NewBlock = &NewBlock{}
+		target = e.NewBlock
+	case EventBestBlockChanged:
+		e.BestBlockChanged = &BestBlockChanged{}
+		target = e.BestBlockChanged
+	case EventFinalized:
+		e.Finalized = &Finalized{}
+		target = e.Finalized
+	case EventOperationBodyDone:
+		e.OperationBodyDone = &OperationBodyDone{}
+		target = e.OperationBodyDone
+	case EventOperationCallDone:
+		e.OperationCallDone = &OperationCallDone{}
+		target = e.OperationCallDone
+	case EventOperationStorageItems:
+		e.OperationStorageItems = &OperationStorageItems{}
+		target = e.OperationStorageItems
+	case EventOperationStorageDone:
+		e.OperationStorageDone = &Operation{}
+		target = e.OperationStorageDone
+	case EventOperationWaitingForContinue:
+		e.OperationWaitingForContinue = &Operation{}
+		target = e.OperationWaitingForContinue
+	case EventOperationInaccessible:
+		e.OperationInaccessible = &Operation{}
+		target = e.OperationInaccessible
+	case EventOperationError:
+		e.OperationError = &OperationError{}
+		target = e.OperationError
+	case EventStop:
+		return nil
+	default:
+		return ErrUnknownFollowEvent.WithMsg("%q", string(tag.Event))
+	}
+
+	return json.Unmarshal(b, target)
+}
+
+// Initialized is the first event of a follow subscription, it contains the current finalized block and its
+// descendants that have been finalized with it, oldest first
+type Initialized struct {
+	FinalizedBlockHashes []types.Hash `json:"finalizedBlockHashes"`
+	// FinalizedBlockRuntime is only set if the subscription was created with runtime updates
+	FinalizedBlockRuntime *RuntimeEvent `json:"finalizedBlockRuntime"`
+}
+
+// NewBlock announces a new block, which is pinned until it is unpinned
+type NewBlock struct {
+	BlockHash       types.Hash `json:"blockHash"`
+	ParentBlockHash types.Hash `json:"parentBlockHash"`
+	// NewRuntime is only set if the subscription was created with runtime updates and the block changes the runtime
+	NewRuntime *RuntimeEvent `json:"newRuntime"`
+}
+
+// BestBlockChanged announces a new best block
+type BestBlockChanged struct {
+	BestBlockHash types.Hash `json:"bestBlockHash"`
+}
+
+// Finalized announces newly finalized blocks, oldest first, and the blocks that will never be finalized
+type Finalized struct {
+	FinalizedBlockHashes []types.Hash `json:"finalizedBlockHashes"`
+	PrunedBlockHashes    []types.Hash `json:"prunedBlockHashes"`
+}
+
+// RuntimeType tells whether a runtime can be used
+type RuntimeType string
+
+const (
+	RuntimeValid   RuntimeType = "valid"
+	RuntimeInvalid RuntimeType = "invalid"
+)
+
+// RuntimeEvent describes the runtime of a block, Spec is set for valid runtimes and Error for invalid ones
+type RuntimeEvent struct {
+	Type  RuntimeType  `json:"type"`
+	Spec  *RuntimeSpec `json:"spec"`
+	Error string       `json:"error"`
+}
+
+// RuntimeSpec is the version of a runtime. Apis maps the hex encoded runtime API IDs to their versions.
+type RuntimeSpec struct {
+	SpecName           string            `json:"specName"`
+	ImplName           string            `json:"implName"`
+	SpecVersion        uint32            `json:"specVersion"`
+	ImplVersion        uint32            `json:"implVersion"`
+	TransactionVersion uint32            `json:"transactionVersion"`
+	Apis               map[string]uint32 `json:"apis"`
+}
+
+// Operation identifies an operation started by Body, Call or Storage
+type Operation struct {
+	OperationID string `json:"operationId"`
+}
+
+// OperationBodyDone contains the SCALE encoded extrinsics of the block requested by Body
+type OperationBodyDone struct {
+	Operation
+	Value []HexBytes `json:"value"`
+}
+
+// OperationCallDone contains the SCALE encoded output of the runtime call requested by Call
+type OperationCallDone struct {
+	Operation
+	Output HexBytes `json:"output"`
+}
+
+// OperationStorageItems contains some of the storage items requested by Storage, more items may follow until an
+// OperationStorageDone event is sent
+type OperationStorageItems struct {
+	Operation
+	Items []StorageResultItem `json:"items"`
+}
+
+// StorageResultItem is a storage item of a Storage operation, which of the fields are set depends on the query type
+type StorageResultItem struct {
+	Key                          HexBytes `json:"key"`
+	Value                        HexBytes `json:"value,omitempty"`
+	Hash                         HexBytes `json:"hash,omitempty"`
+	ClosestDescendantMerkleValue HexBytes `json:"closestDescendantMerkleValue,omitempty"`
+}
+
+// OperationError reports that an operation failed
+type OperationError struct {
+	Operation
+	Error string `json:"error"`
+}
+
+// OperationResult tells whether an operation has been started
+type OperationResult string
+
+const (
+	OperationResultStarted      OperationResult = "started"
+	OperationResultLimitReached OperationResult = "limitReached"
+)
+
+// OperationStarted is the response of Body, Call and Storage. The outcome of a started operation is reported by
+// events of the follow subscription with the same operation ID.
+type OperationStarted struct {
+	Result      OperationResult `json:"result"`
+	OperationID string          `json:"operationId"`
+	// DiscardedItems is the number of storage items at the end of the query that the node did not accept
+	DiscardedItems uint32 `json:"discardedItems"`
+}
+
+// LimitReached reports whether the node refused to start the operation because too many are in progress
+func (o OperationStarted) LimitReached() bool {
+	return o.Result == OperationResultLimitReached
+}
+
+// StorageQueryType is the kind of data a storage query item asks for
+type StorageQueryType string
+
+const (
+	StorageQueryValue                        StorageQueryType = "value"
+	StorageQueryHash                         StorageQueryType = "hash"
+	StorageQueryClosestDescendantMerkleValue StorageQueryType = "closestDescendantMerkleValue"
+	StorageQueryDescendantsValues            StorageQueryType = "descendantsValues"
+	StorageQueryDescendantsHashes            StorageQueryType = "descendantsHashes"
+)
+
+// StorageQueryItem is an item of a Storage query
+type StorageQueryItem struct {
+	Key  HexBytes         `json:"key"`
+	Type StorageQueryType `json:"type"`
+}
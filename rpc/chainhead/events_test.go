@@ -0,0 +1,99 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chainhead
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFollowEvent_UnmarshalJSON(t *testing.T) {
+	for _, test := range []struct {
+		json string
+		exp  FollowEvent
+	}{
+		{
+			`{"event":"operationBodyDone","operationId":"1","value":["0x0102","0x03"]}`,
+			FollowEvent{Type: EventOperationBodyDone, OperationBodyDone: &OperationBodyDone{
+				Operation: Operation{"1"}, Value: []HexBytes{{0x01, 0x02}, {0x03}}}},
+		},
+		{
+			`{"event":"operationCallDone","operationId":"2","output":"0xff"}`,
+			FollowEvent{Type: EventOperationCallDone, OperationCallDone: &OperationCallDone{
+				Operation: Operation{"2"}, Output: HexBytes{0xff}}},
+		},
+		{
+			`{"event":"operationStorageItems","operationId":"3","items":[{"key":"0x01","value":"0x02"},` +
+				`{"key":"0x03","hash":"0x04"}]}`,
+			FollowEvent{Type: EventOperationStorageItems, OperationStorageItems: &OperationStorageItems{
+				Operation: Operation{"3"}, Items: []StorageResultItem{
+					{Key: HexBytes{0x01}, Value: HexBytes{0x02}},
+					{Key: HexBytes{0x03}, Hash: HexBytes{0x04}},
+				}}},
+		},
+		{
+			`{"event":"operationStorageDone","operationId":"3"}`,
+			FollowEvent{Type: EventOperationStorageDone, OperationStorageDone: &Operation{"3"}},
+		},
+		{
+			`{"event":"operationWaitingForContinue","operationId":"4"}`,
+			FollowEvent{Type: EventOperationWaitingForContinue, OperationWaitingForContinue: &Operation{"4"}},
+		},
+		{
+			`{"event":"operationInaccessible","operationId":"5"}`,
+			FollowEvent{Type: EventOperationInaccessible, OperationInaccessible: &Operation{"5"}},
+		},
+		{
+			`{"event":"operationError","operationId":"6","error":"boom"}`,
+			FollowEvent{Type: EventOperationError, OperationError: &OperationError{
+				Operation: Operation{"6"}, Error: "boom"}},
+		},
+		{
+			`{"event":"newBlock","blockHash":"0x0000000000000000000000000000000000000000000000000000000000000001",` +
+				`"parentBlockHash":"0x0000000000000000000000000000000000000000000000000000000000000000",` +
+				`"newRuntime":{"type":"invalid","error":"bad runtime"}}`,
+			FollowEvent{Type: EventNewBlock, NewBlock: &NewBlock{BlockHash: [32]byte{31: 1},
+				NewRuntime: &RuntimeEvent{Type: RuntimeInvalid, Error: "bad runtime"}}},
+		},
+		{
+			`{"event":"stop"}`,
+			FollowEvent{Type: EventStop},
+		},
+	} {
+		var event FollowEvent
+		assert.NoError(t, json.Unmarshal([]byte(test.json), &event), test.json)
+		assert.Equal(t, test.exp, event, test.json)
+	}
+}
+
+func TestFollowEvent_UnmarshalJSONUnknown(t *testing.T) {
+	var event FollowEvent
+	err := json.Unmarshal([]byte(`{"event":"somethingNew"}`), &event)
+	assert.ErrorIs(t, err, ErrUnknownFollowEvent)
+}
+
+func TestHexBytes_JSON(t *testing.T) {
+	b, err := json.Marshal(HexBytes{0xde, 0xad})
+	assert.NoError(t, err)
+	assert.Equal(t, `"0xdead"`, string(b))
+
+	var h HexBytes
+	assert.NoError(t, json.Unmarshal(b, &h))
+	assert.Equal(t, HexBytes{0xde, 0xad}, h)
+}
@@ -0,0 +1,93 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chainhead
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
+)
+
+// FollowSubscription is a subscription established through Follow, its ID is passed to the other methods of ChainHead.
+type FollowSubscription struct {
+	sub *client.BufferedSubscription[FollowEvent]
+}
+
+// ID returns the ID of the follow subscription, which the other methods of ChainHead take
+func (s *FollowSubscription) ID() string {
+	return s.sub.ID()
+}
+
+// Chan returns the subscription channel.
+//
+// The channel is closed when Unsubscribe is called on the subscription.
+func (s *FollowSubscription) Chan() <-chan FollowEvent {
+	return s.sub.Chan()
+}
+
+// Err returns the subscription error channel. The intended use of Err is to schedule
+// resubscription when the client connection is closed unexpectedly.
+//
+// The error channel receives a value when the subscription has ended due
+// to an error. The received error is nil if Close has been called
+// on the underlying client and no other error has occurred.
+//
+// The error channel is closed when Unsubscribe is called on the subscription.
+func (s *FollowSubscription) Err() <-chan error {
+	return s.sub.Err()
+}
+
+// Reconnected returns a channel that receives a value whenever the subscription has been re-established after the
+// connection to the node was lost. The new subscription has a new ID and starts with an initialized event, blocks
+// pinned by the old subscription are gone.
+func (s *FollowSubscription) Reconnected() <-chan struct{} {
+	return s.sub.Reconnected()
+}
+
+// Dropped returns the number of notifications that have been dropped because the channel was full, see
+// client.SubscriptionOptions
+func (s *FollowSubscription) Dropped() uint64 {
+	return s.sub.Dropped()
+}
+
+// Unsubscribe unsubscribes the notification and closes the error and notification channels.
+// It can safely be called more than once.
+func (s *FollowSubscription) Unsubscribe() {
+	s.sub.Unsubscribe()
+}
+
+// Follow subscribes to the blocks of the chain, starting with an initialized event that reports the finalized block.
+// All reported blocks are pinned until they are unpinned, see Unpin. With withRuntime set, the events report the
+// runtime of the blocks.
+func (c *chainHead) Follow(withRuntime bool) (*FollowSubscription, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), client.ConfigOf(c.client).SubscribeTimeout)
+	defer cancel()
+
+	return c.FollowContext(ctx, withRuntime)
+}
+
+// FollowContext subscribes to the blocks of the chain, see Follow. The context bounds the subscription request only,
+// the subscription itself lives until it is unsubscribed.
+func (c *chainHead) FollowContext(ctx context.Context, withRuntime bool) (*FollowSubscription, error) {
+	sub, err := client.SubscribeBuffered[FollowEvent](ctx, c.client, "chainHead", "v1_follow", "v1_unfollow",
+		"v1_followEvent", withRuntime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FollowSubscription{sub: sub}, nil
+}
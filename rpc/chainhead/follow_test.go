@@ -0,0 +1,77 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chainhead
+
+import (
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func receiveEvents(t *testing.T, sub *FollowSubscription, n int) []FollowEvent {
+	var events []FollowEvent
+	for i := 0; i < n; i++ {
+		select {
+		case event := <-sub.Chan():
+			events = append(events, event)
+		case err := <-sub.Err():
+			t.Fatalf("subscription ended: %v", err)
+		case <-time.After(time.Second):
+			t.Fatalf("received %d of %d events", len(events), n)
+		}
+	}
+	return events
+}
+
+func TestChainHead_Follow(t *testing.T) {
+	sub, err := testChainHead.Follow(true)
+	assert.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	assert.NotEmpty(t, sub.ID())
+
+	events := receiveEvents(t, sub, 4)
+
+	assert.Equal(t, EventInitialized, events[0].Type)
+	assert.Equal(t, []types.Hash{mockSrv.finalizedHash}, events[0].Initialized.FinalizedBlockHashes)
+	assert.Equal(t, RuntimeValid, events[0].Initialized.FinalizedBlockRuntime.Type)
+	assert.Equal(t, mockSrv.runtime, *events[0].Initialized.FinalizedBlockRuntime.Spec)
+
+	assert.Equal(t, EventNewBlock, events[1].Type)
+	assert.Equal(t, mockSrv.bestHash, events[1].NewBlock.BlockHash)
+	assert.Equal(t, mockSrv.finalizedHash, events[1].NewBlock.ParentBlockHash)
+	assert.Nil(t, events[1].NewBlock.NewRuntime)
+
+	assert.Equal(t, EventBestBlockChanged, events[2].Type)
+	assert.Equal(t, mockSrv.bestHash, events[2].BestBlockChanged.BestBlockHash)
+
+	assert.Equal(t, EventFinalized, events[3].Type)
+	assert.Equal(t, []types.Hash{mockSrv.bestHash}, events[3].Finalized.FinalizedBlockHashes)
+	assert.Empty(t, events[3].Finalized.PrunedBlockHashes)
+}
+
+func TestChainHead_FollowWithoutRuntime(t *testing.T) {
+	sub, err := testChainHead.Follow(false)
+	assert.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	events := receiveEvents(t, sub, 1)
+	assert.Equal(t, EventInitialized, events[0].Type)
+	assert.Nil(t, events[0].Initialized.FinalizedBlockRuntime)
+}
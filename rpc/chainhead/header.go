@@ -0,0 +1,47 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chainhead
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+// Header retrieves the header of a pinned block. It returns nil if the block is not pinned by the follow
+// subscription.
+func (c *chainHead) Header(followSubscription string, blockHash types.Hash) (*types.Header, error) {
+	return c.HeaderContext(context.Background(), followSubscription, blockHash)
+}
+
+// HeaderContext retrieves the header of a pinned block, see Header. The call is aborted when ctx is done.
+func (c *chainHead) HeaderContext(ctx context.Context, followSubscription string, blockHash types.Hash) (
+	*types.Header, error) {
+	var res *string
+	err := c.client.CallContext(ctx, &res, "chainHead_v1_header", followSubscription, blockHash.Hex())
+	if err != nil || res == nil {
+		return nil, err
+	}
+
+	var header types.Header
+	if err := codec.DecodeFromHex(*res, &header); err != nil {
+		return nil, err
+	}
+
+	return &header, nil
+}
@@ -0,0 +1,31 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chainhead
+
+import (
+	"context"
+)
+
+// StopOperation stops an operation, no further events are sent for it
+func (c *chainHead) StopOperation(followSubscription string, operationID string) error {
+	return c.StopOperationContext(context.Background(), followSubscription, operationID)
+}
+
+// StopOperationContext stops an operation, see StopOperation. The call is aborted when ctx is done.
+func (c *chainHead) StopOperationContext(ctx context.Context, followSubscription string, operationID string) error {
+	return c.client.CallContext(ctx, nil, "chainHead_v1_stopOperation", followSubscription, operationID)
+}
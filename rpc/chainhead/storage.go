@@ -0,0 +1,46 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chainhead
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+// Storage starts querying the storage of a pinned block, or of the child trie with the given key if childTrie is
+// not nil. The items are reported by operationStorageItems events, followed by an operationStorageDone event. The node
+// may pause with an operationWaitingForContinue event, see Continue.
+func (c *chainHead) Storage(followSubscription string, blockHash types.Hash, items []StorageQueryItem,
+	childTrie []byte) (OperationStarted, error) {
+	return c.StorageContext(context.Background(), followSubscription, blockHash, items, childTrie)
+}
+
+// StorageContext starts querying the storage of a pinned block, see Storage. The call is aborted when ctx is done.
+func (c *chainHead) StorageContext(ctx context.Context, followSubscription string, blockHash types.Hash,
+	items []StorageQueryItem, childTrie []byte) (OperationStarted, error) {
+	var child *string
+	if childTrie != nil {
+		hex := codec.HexEncodeToString(childTrie)
+		child = &hex
+	}
+
+	var res OperationStarted
+	err := c.client.CallContext(ctx, &res, "chainHead_v1_storage", followSubscription, blockHash.Hex(), items, child)
+	return res, err
+}
@@ -0,0 +1,39 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chainhead
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChainHead_Storage(t *testing.T) {
+	items := []StorageQueryItem{
+		{Key: HexBytes{0x01}, Type: StorageQueryValue},
+		{Key: HexBytes{0x02}, Type: StorageQueryDescendantsHashes},
+	}
+
+	res, err := testChainHead.Storage("sub", mockSrv.bestHash, items, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, OperationStarted{Result: OperationResultStarted, OperationID: "storage", DiscardedItems: 1}, res)
+
+	res, err = testChainHead.Storage("sub", mockSrv.bestHash, items[:1], []byte{0xab})
+	assert.NoError(t, err)
+	assert.Equal(t, "storage-0xab", res.OperationID)
+	assert.Equal(t, uint32(0), res.DiscardedItems)
+}
@@ -0,0 +1,39 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chainhead
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+// Unpin unpins blocks reported by the follow subscription, after which the node may discard them. Every reported
+// block must be unpinned eventually, the node stops the subscription if too many blocks are pinned.
+func (c *chainHead) Unpin(followSubscription string, blockHashes ...types.Hash) error {
+	return c.UnpinContext(context.Background(), followSubscription, blockHashes...)
+}
+
+// UnpinContext unpins blocks, see Unpin. The call is aborted when ctx is done.
+func (c *chainHead) UnpinContext(ctx context.Context, followSubscription string, blockHashes ...types.Hash) error {
+	hashes := make([]string, len(blockHashes))
+	for i, hash := range blockHashes {
+		hashes[i] = hash.Hex()
+	}
+
+	return c.client.CallContext(ctx, nil, "chainHead_v1_unpin", followSubscription, hashes)
+}
@@ -0,0 +1,42 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chainhead
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChainHead_Unpin(t *testing.T) {
+	err := testChainHead.Unpin("sub", mockSrv.finalizedHash, mockSrv.bestHash)
+	assert.NoError(t, err)
+
+	mockSrv.mu.Lock()
+	defer mockSrv.mu.Unlock()
+	assert.Equal(t, []string{mockSrv.finalizedHash.Hex(), mockSrv.bestHash.Hex()}, mockSrv.unpinned)
+}
+
+func TestChainHead_ContinueAndStopOperation(t *testing.T) {
+	assert.NoError(t, testChainHead.Continue("sub", "op-1"))
+	assert.NoError(t, testChainHead.StopOperation("sub", "op-2"))
+
+	mockSrv.mu.Lock()
+	defer mockSrv.mu.Unlock()
+	assert.Equal(t, []string{"op-1"}, mockSrv.continued)
+	assert.Equal(t, []string{"op-2"}, mockSrv.stopped)
+}
@@ -0,0 +1,46 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate mockery --name ChainSpec --filename chain_spec.go
+
+package chainspec
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+// ChainSpec exposes the chainSpec_v1 methods of the new JSON-RPC interface, which return constant chain information
+type ChainSpec interface {
+	ChainName() (string, error)
+	ChainNameContext(ctx context.Context) (string, error)
+	GenesisHash() (types.Hash, error)
+	GenesisHashContext(ctx context.Context) (types.Hash, error)
+	Properties() (types.ChainProperties, error)
+	PropertiesContext(ctx context.Context) (types.ChainProperties, error)
+}
+
+// chainSpec exposes methods for retrieval of chain specification data
+type chainSpec struct {
+	client client.Client
+}
+
+// NewChainSpec creates a new chainSpec struct
+func NewChainSpec(cl client.Client) ChainSpec {
+	return &chainSpec{cl}
+}
@@ -0,0 +1,93 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chainspec
+
+import (
+	"os"
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/rpcmocksrv"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var testChainSpec ChainSpec
+
+func TestMain(m *testing.M) {
+	s := rpcmocksrv.New()
+	err := s.RegisterName("chainSpec", &mockSrv)
+	if err != nil {
+		panic(err)
+	}
+
+	cl, err := client.Connect(s.URL)
+	if err != nil {
+		panic(err)
+	}
+	testChainSpec = NewChainSpec(cl)
+
+	os.Exit(m.Run())
+}
+
+// MockSrv holds data and methods exposed by the RPC Mock Server used in integration tests
+type MockSrv struct {
+	chainName   string
+	genesisHash types.Hash
+}
+
+func (s *MockSrv) V1_chainName() string {
+	return s.chainName
+}
+
+func (s *MockSrv) V1_genesisHash() string {
+	return s.genesisHash.Hex()
+}
+
+func (s *MockSrv) V1_properties() map[string]interface{} {
+	return map[string]interface{}{
+		"ss58Format":    42,
+		"tokenDecimals": 12,
+		"tokenSymbol":   "UNIT",
+	}
+}
+
+// mockSrv sets default data used in tests. This data might become stale when substrate is updated – just run the tests
+// against real servers and update the values stored here. To do that, replace s.URL with
+// config.Default().RPCURL
+var mockSrv = MockSrv{
+	chainName:   "Development",
+	genesisHash: types.NewHash([]byte{0xa1, 0xb2, 0xc3, 31: 0xff}),
+}
+
+func TestChainSpec_ChainName(t *testing.T) {
+	name, err := testChainSpec.ChainName()
+	assert.NoError(t, err)
+	assert.Equal(t, mockSrv.chainName, name)
+}
+
+func TestChainSpec_GenesisHash(t *testing.T) {
+	hash, err := testChainSpec.GenesisHash()
+	assert.NoError(t, err)
+	assert.Equal(t, mockSrv.genesisHash, hash)
+}
+
+func TestChainSpec_Properties(t *testing.T) {
+	p, err := testChainSpec.Properties()
+	assert.NoError(t, err)
+	assert.Equal(t, types.ChainProperties{SS58Format: 42, TokenDecimals: 12, TokenSymbol: "UNIT"}, p)
+}
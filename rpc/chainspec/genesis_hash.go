@@ -0,0 +1,38 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chainspec
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+// GenesisHash retrieves the hash of the genesis block
+func (c *chainSpec) GenesisHash() (types.Hash, error) {
+	return c.GenesisHashContext(context.Background())
+}
+
+// GenesisHashContext retrieves the hash of the genesis block, the call is aborted when ctx is done
+func (c *chainSpec) GenesisHashContext(ctx context.Context) (types.Hash, error) {
+	var res string
+	if err := c.client.CallContext(ctx, &res, "chainSpec_v1_genesisHash"); err != nil {
+		return types.Hash{}, err
+	}
+
+	return types.NewHashFromHexString(res)
+}
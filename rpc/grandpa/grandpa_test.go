@@ -0,0 +1,132 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grandpa
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
+	gethrpc "github.com/centrifuge/go-substrate-rpc-client/v4/gethrpc"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/rpcmocksrv"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+var testGrandpa Grandpa
+
+func TestMain(m *testing.M) {
+	s := rpcmocksrv.New()
+	err := s.RegisterName("grandpa", &mockSrv)
+	if err != nil {
+		panic(err)
+	}
+
+	cl, err := client.Connect(s.URL)
+	if err != nil {
+		panic(err)
+	}
+	testGrandpa = NewGrandpa(cl)
+
+	os.Exit(m.Run())
+}
+
+// MockSrv holds data and methods exposed by the RPC Mock Server used in integration tests
+type MockSrv struct {
+	finalizedNumber uint32
+	justification   types.GrandpaJustification
+	header          types.Header
+}
+
+func (s *MockSrv) RoundState() map[string]interface{} {
+	round := func(n int) map[string]interface{} {
+		return map[string]interface{}{
+			"round":           n,
+			"totalWeight":     3,
+			"thresholdWeight": 2,
+			"prevotes":        map[string]interface{}{"currentWeight": 2, "missing": []string{}},
+			"precommits": map[string]interface{}{"currentWeight": 1,
+				"missing": []string{"5FA9nQDVg267DEd8m1ZypXLBnvN7SFxYwV7ndqSYGiN9TTpu"}},
+		}
+	}
+
+	return map[string]interface{}{
+		"setId":      7,
+		"best":       round(42),
+		"background": []interface{}{round(41)},
+	}
+}
+
+func (s *MockSrv) ProveFinality(blockNumber uint32) (*string, error) {
+	if blockNumber > s.finalizedNumber {
+		return nil, nil
+	}
+
+	enc, err := codec.EncodeToHex(types.GrandpaFinalityProof{
+		Block:          s.justification.Commit.TargetHash,
+		Justification:  s.justification,
+		UnknownHeaders: []types.Header{s.header},
+	})
+	return &enc, err
+}
+
+func (s *MockSrv) SubscribeJustifications(ctx context.Context) (*gethrpc.Subscription, error) {
+	n, ok := gethrpc.NotifierFromContext(ctx)
+	if !ok {
+		return nil, gethrpc.ErrNotificationsUnsupported
+	}
+	sub := n.CreateSubscription()
+
+	enc, err := codec.EncodeToHex(s.justification)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		_ = n.Notify(sub.ID, enc)
+	}()
+
+	return sub, nil
+}
+
+func (s *MockSrv) UnsubscribeJustifications(id string) bool {
+	return true
+}
+
+// mockSrv sets default data used in tests. This data might become stale when substrate is updated – just run the tests
+// against real servers and update the values stored here. To do that, replace s.URL with
+// config.Default().RPCURL
+var mockSrv = MockSrv{
+	finalizedNumber: 100,
+	justification: types.GrandpaJustification{
+		Round: 42,
+		Commit: types.GrandpaCommit{
+			TargetHash:   types.NewHash([]byte{0xaa, 31: 0x01}),
+			TargetNumber: 100,
+			Precommits: []types.GrandpaSignedPrecommit{{
+				Precommit: types.GrandpaPrecommit{TargetHash: types.NewHash([]byte{0xbb, 31: 0x02}), TargetNumber: 101},
+				Signature: types.Signature{0x01, 63: 0x02},
+				ID:        types.AuthorityID{0x03, 31: 0x04},
+			}},
+		},
+	},
+	header: types.Header{
+		ParentHash: types.NewHash([]byte{0xcc}),
+		Number:     99,
+	},
+}
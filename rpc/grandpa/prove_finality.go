@@ -0,0 +1,50 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grandpa
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+// ProveFinality returns a proof of finality for the given block, which holds the justification of the first block of
+// the authority set change that finalized it, or of the latest finalized block. It returns nil if the block is not
+// finalized yet.
+func (g *grandpa) ProveFinality(blockNumber types.BlockNumber) (*types.GrandpaFinalityProof, error) {
+	return g.ProveFinalityContext(context.Background(), blockNumber)
+}
+
+// ProveFinalityContext returns a proof of finality for the given block, or nil if the block is not finalized yet. The
+// call is aborted when ctx is done.
+func (g *grandpa) ProveFinalityContext(ctx context.Context, blockNumber types.BlockNumber) (
+	*types.GrandpaFinalityProof, error) {
+	var res *string
+
+	err := g.client.CallContext(ctx, &res, "grandpa_proveFinality", uint32(blockNumber))
+	if err != nil || res == nil {
+		return nil, err
+	}
+
+	var proof types.GrandpaFinalityProof
+	if err := codec.DecodeFromHex(*res, &proof); err != nil {
+		return nil, err
+	}
+
+	return &proof, nil
+}
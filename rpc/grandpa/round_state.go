@@ -0,0 +1,41 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grandpa
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+// RoundState returns the state of the current GRANDPA round and of the background rounds
+func (g *grandpa) RoundState() (types.GrandpaReportedRoundStates, error) {
+	return g.RoundStateContext(context.Background())
+}
+
+// RoundStateContext returns the state of the current GRANDPA round and of the background rounds, the call is aborted
+// when ctx is done
+func (g *grandpa) RoundStateContext(ctx context.Context) (types.GrandpaReportedRoundStates, error) {
+	var res types.GrandpaReportedRoundStates
+
+	err := g.client.CallContext(ctx, &res, "grandpa_roundState")
+	if err != nil {
+		return types.GrandpaReportedRoundStates{}, err
+	}
+
+	return res, nil
+}
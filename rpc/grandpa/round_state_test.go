@@ -0,0 +1,38 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grandpa
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGrandpa_RoundState(t *testing.T) {
+	res, err := testGrandpa.RoundState()
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, 7, res.SetID)
+	assert.EqualValues(t, 42, res.Best.Round)
+	assert.EqualValues(t, 3, res.Best.TotalWeight)
+	assert.EqualValues(t, 2, res.Best.ThresholdWeight)
+	assert.EqualValues(t, 2, res.Best.Prevotes.CurrentWeight)
+	assert.Empty(t, res.Best.Prevotes.Missing)
+	assert.Equal(t, []string{"5FA9nQDVg267DEd8m1ZypXLBnvN7SFxYwV7ndqSYGiN9TTpu"}, res.Best.Precommits.Missing)
+	assert.Len(t, res.Background, 1)
+	assert.EqualValues(t, 41, res.Background[0].Round)
+}
@@ -0,0 +1,89 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2021 Snowfork
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grandpa
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+// JustificationsSubscription is a subscription established through one of the Client's subscribe methods.
+type JustificationsSubscription struct {
+	sub *client.BufferedSubscription[types.GrandpaJustification]
+}
+
+// Chan returns the subscription channel.
+//
+// The channel is closed when Unsubscribe is called on the subscription.
+func (s *JustificationsSubscription) Chan() <-chan types.GrandpaJustification {
+	return s.sub.Chan()
+}
+
+// Err returns the subscription error channel. The intended use of Err is to schedule
+// resubscription when the client connection is closed unexpectedly.
+//
+// The error channel receives a value when the subscription has ended due
+// to an error. The received error is nil if Close has been called
+// on the underlying client and no other error has occurred.
+//
+// The error channel is closed when Unsubscribe is called on the subscription.
+func (s *JustificationsSubscription) Err() <-chan error {
+	return s.sub.Err()
+}
+
+// Reconnected returns a channel that receives a value whenever the subscription has been re-established after the
+// connection to the node was lost. Notifications may have been missed in between. It only ever receives values if
+// the subscription was created through a reconnecting client, see client.ConnectWithReconnect.
+func (s *JustificationsSubscription) Reconnected() <-chan struct{} {
+	return s.sub.Reconnected()
+}
+
+// Dropped returns the number of notifications that have been dropped because the channel was full, see
+// client.SubscriptionOptions
+func (s *JustificationsSubscription) Dropped() uint64 {
+	return s.sub.Dropped()
+}
+
+// Unsubscribe unsubscribes the notification and closes the error and notification channels.
+// It can safely be called more than once.
+func (s *JustificationsSubscription) Unsubscribe() {
+	s.sub.Unsubscribe()
+}
+
+// SubscribeJustifications subscribes GRANDPA justifications, returning a subscription that will receive server
+// notifications containing the justification of every block that is finalized with one.
+func (g *grandpa) SubscribeJustifications() (*JustificationsSubscription, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), client.ConfigOf(g.client).SubscribeTimeout)
+	defer cancel()
+
+	return g.SubscribeJustificationsContext(ctx)
+}
+
+// SubscribeJustificationsContext subscribes GRANDPA justifications, returning a subscription that will receive
+// server notifications containing the GrandpaJustification. The context bounds the subscription request only, the
+// subscription itself lives until it is unsubscribed.
+func (g *grandpa) SubscribeJustificationsContext(ctx context.Context) (*JustificationsSubscription, error) {
+	sub, err := client.SubscribeBuffered[types.GrandpaJustification](ctx, g.client, "grandpa", "subscribeJustifications",
+		"unsubscribeJustifications", "justifications")
+	if err != nil {
+		return nil, err
+	}
+
+	return &JustificationsSubscription{sub: sub}, nil
+}
@@ -0,0 +1,56 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kate
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+// BlockLength retreives the length limits and the data matrix dimensions of the block with the given blockHash
+func (k *kate) BlockLength(blockHash types.Hash) (types.BlockLength, error) {
+	return k.blockLength(context.Background(), &blockHash)
+}
+
+// BlockLengthContext retreives the length limits and the data matrix dimensions of the block with the given
+// blockHash, the call is aborted when ctx is done
+func (k *kate) BlockLengthContext(ctx context.Context, blockHash types.Hash) (types.BlockLength, error) {
+	return k.blockLength(ctx, &blockHash)
+}
+
+// BlockLengthLatest retreives the length limits and the data matrix dimensions of the latest block
+func (k *kate) BlockLengthLatest() (types.BlockLength, error) {
+	return k.blockLength(context.Background(), nil)
+}
+
+// BlockLengthLatestContext retreives the length limits and the data matrix dimensions of the latest block, the call
+// is aborted when ctx is done
+func (k *kate) BlockLengthLatestContext(ctx context.Context) (types.BlockLength, error) {
+	return k.blockLength(ctx, nil)
+}
+
+func (k *kate) blockLength(ctx context.Context, blockHash *types.Hash) (types.BlockLength, error) {
+	var res types.BlockLength
+	err := client.CallWithBlockHashContext(ctx, k.client, &res, "kate_blockLength", blockHash)
+	if err != nil {
+		return types.BlockLength{}, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,43 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kate
+
+import (
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKate_BlockLength(t *testing.T) {
+	expected := types.BlockLength{
+		Max:       types.PerDispatchClassU32{Normal: 2097152, Operational: 2097152, Mandatory: 2097152},
+		Cols:      256,
+		Rows:      256,
+		ChunkSize: 32,
+	}
+
+	l, err := testKate.BlockLengthLatest()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, l)
+
+	blockHash := types.NewHash([]byte{0x01, 0x02})
+	l, err = testKate.BlockLength(blockHash)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, l)
+	assert.Equal(t, blockHash.Hex(), *mockSrv.lastAt)
+}
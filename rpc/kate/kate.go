@@ -0,0 +1,60 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate mockery --name Kate --filename kate.go
+
+package kate
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+// Kate exposes methods to query the data matrix of Avail blocks and its proofs
+type Kate interface {
+	QueryRows(rows []uint32, blockHash types.Hash) ([]types.KateRow, error)
+	QueryRowsContext(ctx context.Context, rows []uint32, blockHash types.Hash) ([]types.KateRow, error)
+	QueryRowsLatest(rows []uint32) ([]types.KateRow, error)
+	QueryRowsLatestContext(ctx context.Context, rows []uint32) ([]types.KateRow, error)
+
+	QueryProof(cells []types.Cell, blockHash types.Hash) ([]types.CellProof, error)
+	QueryProofContext(ctx context.Context, cells []types.Cell, blockHash types.Hash) ([]types.CellProof, error)
+	QueryProofLatest(cells []types.Cell) ([]types.CellProof, error)
+	QueryProofLatestContext(ctx context.Context, cells []types.Cell) ([]types.CellProof, error)
+
+	BlockLength(blockHash types.Hash) (types.BlockLength, error)
+	BlockLengthContext(ctx context.Context, blockHash types.Hash) (types.BlockLength, error)
+	BlockLengthLatest() (types.BlockLength, error)
+	BlockLengthLatestContext(ctx context.Context) (types.BlockLength, error)
+
+	QueryDataProof(transactionIndex uint32, blockHash types.Hash) (types.DataProofResponse, error)
+	QueryDataProofContext(ctx context.Context, transactionIndex uint32, blockHash types.Hash) (
+		types.DataProofResponse, error)
+	QueryDataProofLatest(transactionIndex uint32) (types.DataProofResponse, error)
+	QueryDataProofLatestContext(ctx context.Context, transactionIndex uint32) (types.DataProofResponse, error)
+}
+
+// kate exposes methods to query the data matrix of Avail blocks
+type kate struct {
+	client client.Client
+}
+
+// NewKate creates a new kate struct
+func NewKate(cl client.Client) Kate {
+	return &kate{cl}
+}
@@ -0,0 +1,121 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kate
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/rpcmocksrv"
+)
+
+var testKate Kate
+
+func TestMain(m *testing.M) {
+	s := rpcmocksrv.New()
+	err := s.RegisterName("kate", &mockSrv)
+	if err != nil {
+		panic(err)
+	}
+
+	cl, err := client.Connect(s.URL)
+	if err != nil {
+		panic(err)
+	}
+	testKate = NewKate(cl)
+
+	os.Exit(m.Run())
+}
+
+// MockSrv holds data and methods exposed by the RPC Mock Server used in integration tests
+type MockSrv struct {
+	cols        int
+	blockLength map[string]interface{}
+	dataProof   map[string]interface{}
+	lastAt      *string
+}
+
+// scalar returns the U256 encoding of the cell at the given position, which holds its row and column
+func (s *MockSrv) scalar(row, col uint32) string {
+	return fmt.Sprintf("0x%x%08x", row+1, col)
+}
+
+func (s *MockSrv) QueryRows(rows []uint32, at *string) [][]string {
+	s.lastAt = at
+	res := make([][]string, len(rows))
+	for i, r := range rows {
+		for c := 0; c < s.cols; c++ {
+			res[i] = append(res[i], s.scalar(r, uint32(c)))
+		}
+	}
+	return res
+}
+
+func (s *MockSrv) QueryProof(cells []map[string]uint32, at *string) [][]interface{} {
+	s.lastAt = at
+	res := make([][]interface{}, len(cells))
+	for i, c := range cells {
+		var proof [48]byte
+		proof[0] = byte(c["row"])
+		proof[47] = byte(c["col"])
+		res[i] = []interface{}{s.scalar(c["row"], c["col"]), proof}
+	}
+	return res
+}
+
+func (s *MockSrv) BlockLength(at *string) map[string]interface{} {
+	s.lastAt = at
+	return s.blockLength
+}
+
+func (s *MockSrv) QueryDataProof(transactionIndex uint32, at *string) (map[string]interface{}, error) {
+	s.lastAt = at
+	if transactionIndex > 0 {
+		return nil, fmt.Errorf("transaction %d not found", transactionIndex)
+	}
+	return s.dataProof, nil
+}
+
+// mockSrv sets default data used in tests. This data might become stale when substrate is updated – just run the tests
+// against real servers and update the values stored here. To do that, replace s.URL with
+// config.Default().RPCURL
+var mockSrv = MockSrv{
+	cols: 4,
+	blockLength: map[string]interface{}{
+		"max":       map[string]interface{}{"normal": 2097152, "operational": 2097152, "mandatory": 2097152},
+		"cols":      256,
+		"rows":      256,
+		"chunkSize": 32,
+	},
+	dataProof: map[string]interface{}{
+		"dataProof": map[string]interface{}{
+			"roots": map[string]interface{}{
+				"dataRoot":   "0x" + strings.Repeat("11", 32),
+				"blobRoot":   "0x" + strings.Repeat("22", 32),
+				"bridgeRoot": "0x" + strings.Repeat("33", 32),
+			},
+			"proof":          []string{"0x" + strings.Repeat("44", 32)},
+			"numberOfLeaves": 2,
+			"leafIndex":      0,
+			"leaf":           "0x" + strings.Repeat("55", 32),
+		},
+		"message": nil,
+	},
+}
@@ -0,0 +1,61 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kate
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+// QueryDataProof retreives the merkle proof of the data of the transaction with the given index against the data root
+// of the block with the given blockHash
+func (k *kate) QueryDataProof(transactionIndex uint32, blockHash types.Hash) (types.DataProofResponse, error) {
+	return k.queryDataProof(context.Background(), transactionIndex, &blockHash)
+}
+
+// QueryDataProofContext retreives the merkle proof of the data of the transaction with the given index against the
+// data root of the block with the given blockHash, the call is aborted when ctx is done
+func (k *kate) QueryDataProofContext(ctx context.Context, transactionIndex uint32, blockHash types.Hash) (
+	types.DataProofResponse, error) {
+	return k.queryDataProof(ctx, transactionIndex, &blockHash)
+}
+
+// QueryDataProofLatest retreives the merkle proof of the data of the transaction with the given index against the
+// data root of the latest block
+func (k *kate) QueryDataProofLatest(transactionIndex uint32) (types.DataProofResponse, error) {
+	return k.queryDataProof(context.Background(), transactionIndex, nil)
+}
+
+// QueryDataProofLatestContext retreives the merkle proof of the data of the transaction with the given index against
+// the data root of the latest block, the call is aborted when ctx is done
+func (k *kate) QueryDataProofLatestContext(ctx context.Context, transactionIndex uint32) (types.DataProofResponse,
+	error) {
+	return k.queryDataProof(ctx, transactionIndex, nil)
+}
+
+func (k *kate) queryDataProof(ctx context.Context, transactionIndex uint32, blockHash *types.Hash) (
+	types.DataProofResponse, error) {
+	var res types.DataProofResponse
+	err := client.CallWithBlockHashContext(ctx, k.client, &res, "kate_queryDataProof", blockHash, transactionIndex)
+	if err != nil {
+		return types.DataProofResponse{}, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,49 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kate
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKate_QueryDataProof(t *testing.T) {
+	hash := func(b byte) types.Hash {
+		return types.NewHash(bytes.Repeat([]byte{b}, 32))
+	}
+
+	res, err := testKate.QueryDataProofLatest(0)
+	assert.NoError(t, err)
+	assert.Equal(t, types.DataProof{
+		Roots:          types.TxDataRoots{DataRoot: hash(0x11), BlobRoot: hash(0x22), BridgeRoot: hash(0x33)},
+		Proof:          []types.Hash{hash(0x44)},
+		NumberOfLeaves: 2,
+		LeafIndex:      0,
+		Leaf:           hash(0x55),
+	}, res.DataProof)
+
+	blockHash := types.NewHash([]byte{0x01, 0x02})
+	_, err = testKate.QueryDataProof(0, blockHash)
+	assert.NoError(t, err)
+	assert.Equal(t, blockHash.Hex(), *mockSrv.lastAt)
+
+	_, err = testKate.QueryDataProofLatest(1)
+	assert.Error(t, err)
+}
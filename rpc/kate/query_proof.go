@@ -0,0 +1,62 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kate
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+// QueryProof retreives the content and the KZG proof of the given cells of the data matrix of the block with the
+// given blockHash. The proofs are returned in the order of the cells.
+func (k *kate) QueryProof(cells []types.Cell, blockHash types.Hash) ([]types.CellProof, error) {
+	return k.queryProof(context.Background(), cells, &blockHash)
+}
+
+// QueryProofContext retreives the content and the KZG proof of the given cells of the data matrix of the block with
+// the given blockHash, the call is aborted when ctx is done
+func (k *kate) QueryProofContext(ctx context.Context, cells []types.Cell, blockHash types.Hash) ([]types.CellProof,
+	error) {
+	return k.queryProof(ctx, cells, &blockHash)
+}
+
+// QueryProofLatest retreives the content and the KZG proof of the given cells of the data matrix of the latest block
+func (k *kate) QueryProofLatest(cells []types.Cell) ([]types.CellProof, error) {
+	return k.queryProof(context.Background(), cells, nil)
+}
+
+// QueryProofLatestContext retreives the content and the KZG proof of the given cells of the data matrix of the latest
+// block, the call is aborted when ctx is done
+func (k *kate) QueryProofLatestContext(ctx context.Context, cells []types.Cell) ([]types.CellProof, error) {
+	return k.queryProof(ctx, cells, nil)
+}
+
+func (k *kate) queryProof(ctx context.Context, cells []types.Cell, blockHash *types.Hash) ([]types.CellProof, error) {
+	if cells == nil {
+		cells = []types.Cell{}
+	}
+
+	var res []types.CellProof
+	err := client.CallWithBlockHashContext(ctx, k.client, &res, "kate_queryProof", blockHash, cells)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,38 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kate
+
+import (
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKate_QueryProof(t *testing.T) {
+	proofs, err := testKate.QueryProofLatest([]types.Cell{types.NewCell(1, 2), types.NewCell(3, 0)})
+	assert.NoError(t, err)
+	assert.Equal(t, []types.CellProof{
+		{Scalar: types.KateScalar{27: 0x02, 31: 0x02}, Proof: types.KateProof{0: 1, 47: 2}},
+		{Scalar: types.KateScalar{27: 0x04}, Proof: types.KateProof{0: 3}},
+	}, proofs)
+
+	blockHash := types.NewHash([]byte{0x01, 0x02})
+	_, err = testKate.QueryProof([]types.Cell{types.NewCell(0, 0)}, blockHash)
+	assert.NoError(t, err)
+	assert.Equal(t, blockHash.Hex(), *mockSrv.lastAt)
+}
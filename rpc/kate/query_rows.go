@@ -0,0 +1,60 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kate
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+// QueryRows retreives the given rows of the data matrix of the block with the given blockHash
+func (k *kate) QueryRows(rows []uint32, blockHash types.Hash) ([]types.KateRow, error) {
+	return k.queryRows(context.Background(), rows, &blockHash)
+}
+
+// QueryRowsContext retreives the given rows of the data matrix of the block with the given blockHash, the call is
+// aborted when ctx is done
+func (k *kate) QueryRowsContext(ctx context.Context, rows []uint32, blockHash types.Hash) ([]types.KateRow, error) {
+	return k.queryRows(ctx, rows, &blockHash)
+}
+
+// QueryRowsLatest retreives the given rows of the data matrix of the latest block
+func (k *kate) QueryRowsLatest(rows []uint32) ([]types.KateRow, error) {
+	return k.queryRows(context.Background(), rows, nil)
+}
+
+// QueryRowsLatestContext retreives the given rows of the data matrix of the latest block, the call is aborted when
+// ctx is done
+func (k *kate) QueryRowsLatestContext(ctx context.Context, rows []uint32) ([]types.KateRow, error) {
+	return k.queryRows(ctx, rows, nil)
+}
+
+func (k *kate) queryRows(ctx context.Context, rows []uint32, blockHash *types.Hash) ([]types.KateRow, error) {
+	if rows == nil {
+		rows = []uint32{}
+	}
+
+	var res []types.KateRow
+	err := client.CallWithBlockHashContext(ctx, k.client, &res, "kate_queryRows", blockHash, rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
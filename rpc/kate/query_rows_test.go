@@ -0,0 +1,39 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kate
+
+import (
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKate_QueryRows(t *testing.T) {
+	rows, err := testKate.QueryRowsLatest([]uint32{0, 2})
+	assert.NoError(t, err)
+	assert.Len(t, rows, 2)
+	assert.Len(t, rows[1], mockSrv.cols)
+	assert.Equal(t, types.KateScalar{27: 0x03, 31: 0x01}, rows[1][1])
+	assert.Nil(t, mockSrv.lastAt)
+
+	blockHash := types.NewHash([]byte{0x01, 0x02})
+	rows, err = testKate.QueryRows(nil, blockHash)
+	assert.NoError(t, err)
+	assert.Empty(t, rows)
+	assert.Equal(t, blockHash.Hex(), *mockSrv.lastAt)
+}
@@ -21,22 +21,34 @@ import (
 	"github.com/centrifuge/go-substrate-rpc-client/v4/rpc/author"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/rpc/beefy"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/rpc/chain"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/rpc/chainhead"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/rpc/chainspec"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/rpc/grandpa"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/rpc/kate"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/rpc/mmr"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/rpc/offchain"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/rpc/payment"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/rpc/state"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/rpc/system"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/rpc/transaction"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
 )
 
 type RPC struct {
-	Author   author.Author
-	Beefy    beefy.Beefy
-	Chain    chain.Chain
-	MMR      mmr.MMR
-	Offchain offchain.Offchain
-	State    state.State
-	System   system.System
-	client   client.Client
+	Author      author.Author
+	Beefy       beefy.Beefy
+	Chain       chain.Chain
+	ChainHead   chainhead.ChainHead
+	ChainSpec   chainspec.ChainSpec
+	Grandpa     grandpa.Grandpa
+	Kate        kate.Kate
+	MMR         mmr.MMR
+	Offchain    offchain.Offchain
+	Payment     payment.Payment
+	State       state.State
+	System      system.System
+	Transaction transaction.Transaction
+	client      client.Client
 }
 
 func NewRPC(cl client.Client) (*RPC, error) {
@@ -50,13 +62,19 @@ func NewRPC(cl client.Client) (*RPC, error) {
 	types.SetSerDeOptions(opts)
 
 	return &RPC{
-		Author:   author.NewAuthor(cl),
-		Beefy:    beefy.NewBeefy(cl),
-		Chain:    chain.NewChain(cl),
-		MMR:      mmr.NewMMR(cl),
-		Offchain: offchain.NewOffchain(cl),
-		State:    st,
-		System:   system.NewSystem(cl),
-		client:   cl,
+		Author:      author.NewAuthor(cl),
+		Beefy:       beefy.NewBeefy(cl),
+		Chain:       chain.NewChain(cl),
+		ChainHead:   chainhead.NewChainHead(cl),
+		ChainSpec:   chainspec.NewChainSpec(cl),
+		Grandpa:     grandpa.NewGrandpa(cl),
+		Kate:        kate.NewKate(cl),
+		MMR:         mmr.NewMMR(cl),
+		Offchain:    offchain.NewOffchain(cl),
+		Payment:     payment.NewPayment(cl),
+		State:       st,
+		System:      system.NewSystem(cl),
+		Transaction: transaction.NewTransaction(cl),
+		client:      cl,
 	}, nil
 }
@@ -0,0 +1,71 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2022 Snowfork
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mmr
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+// GenerateBatchProof retrieves a MMR proof and the leaves for the given block numbers, at the given blockHash. If
+// bestKnownBlockNumber is not nil, the proof is generated against the MMR as it was at that block, which allows to
+// verify it against an older MMR root.
+func (c *mmr) GenerateBatchProof(blockNumbers []uint32, bestKnownBlockNumber *uint32, blockHash types.Hash) (
+	types.MMRLeavesProof, error) {
+	return c.generateBatchProof(context.Background(), blockNumbers, bestKnownBlockNumber, &blockHash)
+}
+
+// GenerateBatchProofContext retrieves a MMR proof and the leaves for the given block numbers, at the given blockHash.
+// If bestKnownBlockNumber is not nil, the proof is generated against the MMR as it was at that block. The call is
+// aborted when ctx is done
+func (c *mmr) GenerateBatchProofContext(ctx context.Context, blockNumbers []uint32, bestKnownBlockNumber *uint32,
+	blockHash types.Hash) (types.MMRLeavesProof, error) {
+	return c.generateBatchProof(ctx, blockNumbers, bestKnownBlockNumber, &blockHash)
+}
+
+// GenerateBatchProofLatest retrieves the latest MMR proof and the leaves for the given block numbers. If
+// bestKnownBlockNumber is not nil, the proof is generated against the MMR as it was at that block.
+func (c *mmr) GenerateBatchProofLatest(blockNumbers []uint32, bestKnownBlockNumber *uint32) (types.MMRLeavesProof,
+	error) {
+	return c.generateBatchProof(context.Background(), blockNumbers, bestKnownBlockNumber, nil)
+}
+
+// GenerateBatchProofLatestContext retrieves the latest MMR proof and the leaves for the given block numbers. If
+// bestKnownBlockNumber is not nil, the proof is generated against the MMR as it was at that block. The call is
+// aborted when ctx is done
+func (c *mmr) GenerateBatchProofLatestContext(ctx context.Context, blockNumbers []uint32,
+	bestKnownBlockNumber *uint32) (types.MMRLeavesProof, error) {
+	return c.generateBatchProof(ctx, blockNumbers, bestKnownBlockNumber, nil)
+}
+
+func (c *mmr) generateBatchProof(ctx context.Context, blockNumbers []uint32, bestKnownBlockNumber *uint32,
+	blockHash *types.Hash) (types.MMRLeavesProof, error) {
+	if blockNumbers == nil {
+		blockNumbers = []uint32{}
+	}
+
+	var res types.MMRLeavesProof
+	err := client.CallWithBlockHashContext(ctx, c.client, &res, "mmr_generateProof", blockHash, blockNumbers,
+		bestKnownBlockNumber)
+	if err != nil {
+		return types.MMRLeavesProof{}, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,121 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2022 Snowfork
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mmr
+
+import (
+	"os"
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
+	gsrpcmmr "github.com/centrifuge/go-substrate-rpc-client/v4/mmr"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/rpcmocksrv"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+	"golang.org/x/crypto/sha3"
+)
+
+var testMMR MMR
+
+func TestMain(m *testing.M) {
+	s := rpcmocksrv.New()
+	err := s.RegisterName("mmr", &mockSrv)
+	if err != nil {
+		panic(err)
+	}
+
+	cl, err := client.Connect(s.URL)
+	if err != nil {
+		panic(err)
+	}
+	testMMR = NewMMR(cl)
+
+	os.Exit(m.Run())
+}
+
+// MockSrv holds data and methods exposed by the RPC Mock Server used in integration tests
+type MockSrv struct {
+	root        types.H256
+	leavesProof types.MMRLeavesProof
+
+	lastBlockNumbers         []uint32
+	lastBestKnownBlockNumber *uint32
+	lastAt                   *string
+}
+
+func (s *MockSrv) Root(at *string) string {
+	s.lastAt = at
+	return s.root.Hex()
+}
+
+func (s *MockSrv) GenerateProof(blockNumbers []uint32, bestKnownBlockNumber *uint32, at *string) types.MMRLeavesProof {
+	s.lastBlockNumbers = blockNumbers
+	s.lastBestKnownBlockNumber = bestKnownBlockNumber
+	s.lastAt = at
+	return s.leavesProof
+}
+
+func (s *MockSrv) VerifyProof(proof types.MMRLeavesProof) bool {
+	return gsrpcmmr.VerifyLeavesProof(s.root, proof) == nil
+}
+
+func (s *MockSrv) VerifyProofStateless(root string, proof types.MMRLeavesProof) (bool, error) {
+	var r types.H256
+	err := codec.DecodeFromHex(root, &r)
+	if err != nil {
+		return false, err
+	}
+	return gsrpcmmr.VerifyLeavesProof(r, proof) == nil, nil
+}
+
+// mockSrv sets default data used in tests. This data might become stale when substrate is updated – just run the tests
+// against real servers and update the values stored here. To do that, replace s.URL with
+// config.Default().RPCURL
+var mockSrv = MockSrv{
+	root:        testRoot,
+	leavesProof: testLeavesProof,
+}
+
+// testLeaves are the leaves of a MMR with three leaves
+var testLeaves = []types.MMREncodableOpaqueLeaf{{0x00}, {0x01}, {0x02}}
+
+// testLeavesProof proves the first and the last leaf of testLeaves. The first peak is the parent of the first two
+// leaves, the second peak is the last leaf, so only the hash of the second leaf is needed.
+var testLeavesProof = types.MMRLeavesProof{
+	BlockHash: types.NewH256(codec.MustHexDecodeString(
+		"0x52d917b53796b671eb6f9f5497878cd7bacd1e8bafd41004687f67d2938b7b13")),
+	Leaves: []types.MMREncodableOpaqueLeaf{testLeaves[0], testLeaves[2]},
+	Proof: types.MMRBatchProof{
+		LeafIndices: []types.U64{0, 2},
+		LeafCount:   3,
+		Items:       []types.H256{keccak(testLeaves[1])},
+	},
+}
+
+// testRoot is the root of testLeaves, with the peaks bagged from right to left
+var testRoot = merge(keccak(testLeaves[2]), merge(keccak(testLeaves[0]), keccak(testLeaves[1])))
+
+func merge(left, right types.H256) types.H256 {
+	return keccak(left[:], right[:])
+}
+
+func keccak(data ...[]byte) types.H256 {
+	h := sha3.NewLegacyKeccak256()
+	for _, d := range data {
+		h.Write(d) //nolint:errcheck
+	}
+	return types.NewH256(h.Sum(nil))
+}
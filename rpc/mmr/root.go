@@ -0,0 +1,61 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2022 Snowfork
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mmr
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+// Root retrieves the MMR root hash at the given blockHash
+func (c *mmr) Root(blockHash types.Hash) (types.H256, error) {
+	return c.root(context.Background(), &blockHash)
+}
+
+// RootContext retrieves the MMR root hash at the given blockHash, the call is aborted when ctx is done
+func (c *mmr) RootContext(ctx context.Context, blockHash types.Hash) (types.H256, error) {
+	return c.root(ctx, &blockHash)
+}
+
+// RootLatest retrieves the latest MMR root hash
+func (c *mmr) RootLatest() (types.H256, error) {
+	return c.root(context.Background(), nil)
+}
+
+// RootLatestContext retrieves the latest MMR root hash, the call is aborted when ctx is done
+func (c *mmr) RootLatestContext(ctx context.Context) (types.H256, error) {
+	return c.root(ctx, nil)
+}
+
+func (c *mmr) root(ctx context.Context, blockHash *types.Hash) (types.H256, error) {
+	var res string
+	err := client.CallWithBlockHashContext(ctx, c.client, &res, "mmr_root", blockHash)
+	if err != nil {
+		return types.H256{}, err
+	}
+
+	var root types.H256
+	err = codec.DecodeFromHex(res, &root)
+	if err != nil {
+		return types.H256{}, err
+	}
+
+	return root, nil
+}
@@ -0,0 +1,60 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2022 Snowfork
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mmr
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+// VerifyProof verifies a MMR proof against the MMR root of the node at the block of the proof. The node must have
+// the MMR of that block, proofs generated at a block that is not canonical or pruned can't be verified.
+func (c *mmr) VerifyProof(proof types.MMRLeavesProof) (bool, error) {
+	return c.VerifyProofContext(context.Background(), proof)
+}
+
+// VerifyProofContext verifies a MMR proof against the MMR root of the node at the block of the proof, the call is
+// aborted when ctx is done
+func (c *mmr) VerifyProofContext(ctx context.Context, proof types.MMRLeavesProof) (bool, error) {
+	var res bool
+	err := c.client.CallContext(ctx, &res, "mmr_verifyProof", proof)
+	if err != nil {
+		return false, err
+	}
+
+	return res, nil
+}
+
+// VerifyProofStateless verifies a MMR proof against the given MMR root without using the state of the node. See
+// package github.com/centrifuge/go-substrate-rpc-client/v4/mmr to verify a proof without a node.
+func (c *mmr) VerifyProofStateless(root types.H256, proof types.MMRLeavesProof) (bool, error) {
+	return c.VerifyProofStatelessContext(context.Background(), root, proof)
+}
+
+// VerifyProofStatelessContext verifies a MMR proof against the given MMR root without using the state of the node,
+// the call is aborted when ctx is done
+func (c *mmr) VerifyProofStatelessContext(ctx context.Context, root types.H256, proof types.MMRLeavesProof) (bool,
+	error) {
+	var res bool
+	err := c.client.CallContext(ctx, &res, "mmr_verifyProofStateless", root.Hex(), proof)
+	if err != nil {
+		return false, err
+	}
+
+	return res, nil
+}
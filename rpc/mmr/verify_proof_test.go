@@ -0,0 +1,46 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2022 Snowfork
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mmr
+
+import (
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMMR_VerifyProof(t *testing.T) {
+	ok, err := testMMR.VerifyProof(mockSrv.leavesProof)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	tampered := mockSrv.leavesProof
+	tampered.Leaves = []types.MMREncodableOpaqueLeaf{testLeaves[1], testLeaves[2]}
+	ok, err = testMMR.VerifyProof(tampered)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestMMR_VerifyProofStateless(t *testing.T) {
+	ok, err := testMMR.VerifyProofStateless(mockSrv.root, mockSrv.leavesProof)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = testMMR.VerifyProofStateless(types.H256{1}, mockSrv.leavesProof)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
@@ -3,9 +3,12 @@
 package mocks
 
 import (
+	context "context"
+
 	offchain "github.com/centrifuge/go-substrate-rpc-client/v4/rpc/offchain"
-	types "github.com/centrifuge/go-substrate-rpc-client/v4/types"
 	mock "github.com/stretchr/testify/mock"
+
+	types "github.com/centrifuge/go-substrate-rpc-client/v4/types"
 )
 
 // Offchain is an autogenerated mock type for the Offchain type
@@ -36,6 +39,29 @@ func (_m *Offchain) LocalStorageGet(kind offchain.StorageKind, key []byte) (*typ
 	return r0, r1
 }
 
+// LocalStorageGetContext provides a mock function with given fields: ctx, kind, key
+func (_m *Offchain) LocalStorageGetContext(ctx context.Context, kind offchain.StorageKind, key []byte) (*types.StorageDataRaw, error) {
+	ret := _m.Called(ctx, kind, key)
+
+	var r0 *types.StorageDataRaw
+	if rf, ok := ret.Get(0).(func(context.Context, offchain.StorageKind, []byte) *types.StorageDataRaw); ok {
+		r0 = rf(ctx, kind, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.StorageDataRaw)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, offchain.StorageKind, []byte) error); ok {
+		r1 = rf(ctx, kind, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LocalStorageSet provides a mock function with given fields: kind, key, value
 func (_m *Offchain) LocalStorageSet(kind offchain.StorageKind, key []byte, value []byte) error {
 	ret := _m.Called(kind, key, value)
@@ -50,6 +76,20 @@ func (_m *Offchain) LocalStorageSet(kind offchain.StorageKind, key []byte, value
 	return r0
 }
 
+// LocalStorageSetContext provides a mock function with given fields: ctx, kind, key, value
+func (_m *Offchain) LocalStorageSetContext(ctx context.Context, kind offchain.StorageKind, key []byte, value []byte) error {
+	ret := _m.Called(ctx, kind, key, value)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, offchain.StorageKind, []byte, []byte) error); ok {
+		r0 = rf(ctx, kind, key, value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type NewOffchainT interface {
 	mock.TestingT
 	Cleanup(func())
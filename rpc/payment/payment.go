@@ -0,0 +1,60 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate mockery --name Payment --filename payment.go
+
+package payment
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+// Payment exposes methods for estimating the fees of extrinsics. The extrinsics must be signed, a fake signature is
+// sufficient since it is not verified. The Runtime methods query the TransactionPaymentApi of the runtime through
+// state_call, which is also available on nodes that no longer serve the payment RPCs.
+type Payment interface {
+	QueryInfo(xt types.Extrinsic, blockHash types.Hash) (types.RuntimeDispatchInfo, error)
+	QueryInfoContext(ctx context.Context, xt types.Extrinsic, blockHash types.Hash) (types.RuntimeDispatchInfo, error)
+	QueryInfoLatest(xt types.Extrinsic) (types.RuntimeDispatchInfo, error)
+	QueryInfoLatestContext(ctx context.Context, xt types.Extrinsic) (types.RuntimeDispatchInfo, error)
+	QueryFeeDetails(xt types.Extrinsic, blockHash types.Hash) (types.FeeDetails, error)
+	QueryFeeDetailsContext(ctx context.Context, xt types.Extrinsic, blockHash types.Hash) (types.FeeDetails, error)
+	QueryFeeDetailsLatest(xt types.Extrinsic) (types.FeeDetails, error)
+	QueryFeeDetailsLatestContext(ctx context.Context, xt types.Extrinsic) (types.FeeDetails, error)
+	RuntimeQueryInfo(xt types.Extrinsic, blockHash types.Hash) (types.RuntimeDispatchInfo, error)
+	RuntimeQueryInfoContext(ctx context.Context, xt types.Extrinsic, blockHash types.Hash) (
+		types.RuntimeDispatchInfo, error)
+	RuntimeQueryInfoLatest(xt types.Extrinsic) (types.RuntimeDispatchInfo, error)
+	RuntimeQueryInfoLatestContext(ctx context.Context, xt types.Extrinsic) (types.RuntimeDispatchInfo, error)
+	RuntimeQueryFeeDetails(xt types.Extrinsic, blockHash types.Hash) (types.FeeDetails, error)
+	RuntimeQueryFeeDetailsContext(ctx context.Context, xt types.Extrinsic, blockHash types.Hash) (
+		types.FeeDetails, error)
+	RuntimeQueryFeeDetailsLatest(xt types.Extrinsic) (types.FeeDetails, error)
+	RuntimeQueryFeeDetailsLatestContext(ctx context.Context, xt types.Extrinsic) (types.FeeDetails, error)
+}
+
+// payment exposes methods for querying transaction fees
+type payment struct {
+	client client.Client
+}
+
+// NewPayment creates a new payment struct
+func NewPayment(cl client.Client) Payment {
+	return &payment{cl}
+}
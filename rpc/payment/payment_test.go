@@ -0,0 +1,122 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package payment
+
+import (
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/rpcmocksrv"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+var testPayment Payment
+
+func TestMain(m *testing.M) {
+	s := rpcmocksrv.New()
+	err := s.RegisterName("payment", &mockSrv)
+	if err != nil {
+		panic(err)
+	}
+
+	err = s.RegisterName("state", &mockSrv)
+	if err != nil {
+		panic(err)
+	}
+
+	cl, err := client.Connect(s.URL)
+	if err != nil {
+		panic(err)
+	}
+	testPayment = NewPayment(cl)
+
+	os.Exit(m.Run())
+}
+
+// MockSrv holds data and methods exposed by the RPC Mock Server used in integration tests
+type MockSrv struct {
+	blockHash  types.Hash
+	xt         types.Extrinsic
+	info       types.RuntimeDispatchInfo
+	feeDetails types.FeeDetails
+
+	lastAt   *string
+	lastCall []byte
+}
+
+func (s *MockSrv) QueryInfo(xt string, at *string) map[string]interface{} {
+	s.lastAt = at
+	return map[string]interface{}{
+		"weight":     map[string]uint64{"ref_time": 161383000, "proof_size": 3593},
+		"class":      "normal",
+		"partialFee": "149000011",
+	}
+}
+
+func (s *MockSrv) QueryFeeDetails(xt string, at *string) map[string]interface{} {
+	s.lastAt = at
+	return map[string]interface{}{
+		"inclusionFee": map[string]string{
+			"baseFee":           "0x5f5e100",
+			"lenFee":            "0x1a",
+			"adjustedWeightFee": "0x3b9aca00",
+		},
+	}
+}
+
+func (s *MockSrv) Call(method, data string, at *string) (string, error) {
+	s.lastAt = at
+
+	var err error
+	if s.lastCall, err = codec.HexDecodeString(data); err != nil {
+		return "", err
+	}
+
+	switch method {
+	case "TransactionPaymentApi_query_info":
+		return codec.EncodeToHex(s.info)
+	default:
+		return codec.EncodeToHex(s.feeDetails)
+	}
+}
+
+// mockSrv sets default data used in tests. This data might become stale when substrate is updated – just run the tests
+// against real servers and update the values stored here. To do that, replace s.URL with
+// config.Default().RPCURL
+var mockSrv = MockSrv{
+	blockHash: types.NewHash([]byte{0x01, 0x02, 31: 0xff}),
+	xt: types.Extrinsic{
+		Version: types.ExtrinsicVersion4,
+		Method:  types.Call{CallIndex: types.CallIndex{SectionIndex: 5, MethodIndex: 3}, Args: types.Args{0x01, 0x02}},
+	},
+	info: types.RuntimeDispatchInfo{
+		Weight:     types.NewWeight(types.NewUCompactFromUInt(161383000), types.NewUCompactFromUInt(3593)),
+		Class:      types.DispatchClass{IsNormal: true},
+		PartialFee: types.NewU128(*big.NewInt(149000011)),
+	},
+	feeDetails: types.FeeDetails{
+		InclusionFee: types.NewOptionInclusionFee(types.InclusionFee{
+			BaseFee:           types.NewU128(*big.NewInt(100000000)),
+			LenFee:            types.NewU128(*big.NewInt(26)),
+			AdjustedWeightFee: types.NewU128(*big.NewInt(1000000000)),
+		}),
+		Tip: types.NewU128(*big.NewInt(5)),
+	},
+}
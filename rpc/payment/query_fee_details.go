@@ -0,0 +1,66 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package payment
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+// QueryFeeDetails retrieves the breakdown of the inclusion fee of the extrinsic into base fee, length fee and adjusted
+// weight fee at the given block
+func (p *payment) QueryFeeDetails(xt types.Extrinsic, blockHash types.Hash) (types.FeeDetails, error) {
+	return p.queryFeeDetails(context.Background(), xt, &blockHash)
+}
+
+// QueryFeeDetailsContext retrieves the breakdown of the inclusion fee of the extrinsic at the given block, the call is
+// aborted when ctx is done
+func (p *payment) QueryFeeDetailsContext(ctx context.Context, xt types.Extrinsic, blockHash types.Hash) (
+	types.FeeDetails, error) {
+	return p.queryFeeDetails(ctx, xt, &blockHash)
+}
+
+// QueryFeeDetailsLatest retrieves the breakdown of the inclusion fee of the extrinsic into base fee, length fee and
+// adjusted weight fee at the latest block
+func (p *payment) QueryFeeDetailsLatest(xt types.Extrinsic) (types.FeeDetails, error) {
+	return p.queryFeeDetails(context.Background(), xt, nil)
+}
+
+// QueryFeeDetailsLatestContext retrieves the breakdown of the inclusion fee of the extrinsic at the latest block, the
+// call is aborted when ctx is done
+func (p *payment) QueryFeeDetailsLatestContext(ctx context.Context, xt types.Extrinsic) (types.FeeDetails, error) {
+	return p.queryFeeDetails(ctx, xt, nil)
+}
+
+func (p *payment) queryFeeDetails(ctx context.Context, xt types.Extrinsic, blockHash *types.Hash) (
+	types.FeeDetails, error) {
+	enc, err := codec.EncodeToHex(xt)
+	if err != nil {
+		return types.FeeDetails{}, err
+	}
+
+	var res types.FeeDetails
+	err = client.CallWithBlockHashContext(ctx, p.client, &res, "payment_queryFeeDetails", blockHash, enc)
+	if err != nil {
+		return types.FeeDetails{}, err
+	}
+
+	return res, nil
+}
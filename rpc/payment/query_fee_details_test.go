@@ -0,0 +1,46 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package payment
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPayment_QueryFeeDetails(t *testing.T) {
+	details, err := testPayment.QueryFeeDetails(mockSrv.xt, mockSrv.blockHash)
+	assert.NoError(t, err)
+	assert.Equal(t, mockSrv.blockHash.Hex(), *mockSrv.lastAt)
+
+	ok, fee := details.InclusionFee.Unwrap()
+	assert.True(t, ok)
+	_, exp := mockSrv.feeDetails.InclusionFee.Unwrap()
+	assert.Equal(t, exp, fee)
+	assert.Equal(t, types.NewU128(*big.NewInt(1100000026)), fee.Total())
+
+	// The tip is not returned by the RPC
+	assert.Equal(t, types.NewU128(*big.NewInt(0)), details.Tip)
+}
+
+func TestFeeDetails_UnmarshalJSONUnsigned(t *testing.T) {
+	var details types.FeeDetails
+	assert.NoError(t, details.UnmarshalJSON([]byte(`{"inclusionFee":null}`)))
+	assert.True(t, details.InclusionFee.IsNone())
+}
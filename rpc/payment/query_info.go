@@ -0,0 +1,64 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package payment
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+// QueryInfo retrieves the dispatch info of the extrinsic, including its partial fee, at the given block
+func (p *payment) QueryInfo(xt types.Extrinsic, blockHash types.Hash) (types.RuntimeDispatchInfo, error) {
+	return p.queryInfo(context.Background(), xt, &blockHash)
+}
+
+// QueryInfoContext retrieves the dispatch info of the extrinsic at the given block, the call is aborted when ctx is
+// done
+func (p *payment) QueryInfoContext(ctx context.Context, xt types.Extrinsic, blockHash types.Hash) (
+	types.RuntimeDispatchInfo, error) {
+	return p.queryInfo(ctx, xt, &blockHash)
+}
+
+// QueryInfoLatest retrieves the dispatch info of the extrinsic, including its partial fee, at the latest block
+func (p *payment) QueryInfoLatest(xt types.Extrinsic) (types.RuntimeDispatchInfo, error) {
+	return p.queryInfo(context.Background(), xt, nil)
+}
+
+// QueryInfoLatestContext retrieves the dispatch info of the extrinsic at the latest block, the call is aborted when
+// ctx is done
+func (p *payment) QueryInfoLatestContext(ctx context.Context, xt types.Extrinsic) (types.RuntimeDispatchInfo, error) {
+	return p.queryInfo(ctx, xt, nil)
+}
+
+func (p *payment) queryInfo(ctx context.Context, xt types.Extrinsic, blockHash *types.Hash) (
+	types.RuntimeDispatchInfo, error) {
+	enc, err := codec.EncodeToHex(xt)
+	if err != nil {
+		return types.RuntimeDispatchInfo{}, err
+	}
+
+	var res types.RuntimeDispatchInfo
+	err = client.CallWithBlockHashContext(ctx, p.client, &res, "payment_queryInfo", blockHash, enc)
+	if err != nil {
+		return types.RuntimeDispatchInfo{}, err
+	}
+
+	return res, nil
+}
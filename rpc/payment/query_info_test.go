@@ -0,0 +1,53 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package payment
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPayment_QueryInfo(t *testing.T) {
+	info, err := testPayment.QueryInfo(mockSrv.xt, mockSrv.blockHash)
+	assert.NoError(t, err)
+	assert.Equal(t, mockSrv.info, info)
+	assert.Equal(t, mockSrv.blockHash.Hex(), *mockSrv.lastAt)
+}
+
+func TestPayment_QueryInfoLatest(t *testing.T) {
+	info, err := testPayment.QueryInfoLatest(mockSrv.xt)
+	assert.NoError(t, err)
+	assert.Equal(t, mockSrv.info, info)
+	assert.Nil(t, mockSrv.lastAt)
+}
+
+func TestRuntimeDispatchInfo_UnmarshalJSONWeightV1(t *testing.T) {
+	var info types.RuntimeDispatchInfo
+	err := info.UnmarshalJSON([]byte(`{"weight":195000000,"class":"operational","partialFee":149000011}`))
+	assert.NoError(t, err)
+	assert.Equal(t, types.RuntimeDispatchInfo{
+		Weight:     types.NewWeight(types.NewUCompactFromUInt(195000000), types.NewUCompactFromUInt(0)),
+		Class:      types.DispatchClass{IsOperational: true},
+		PartialFee: types.NewU128(*big.NewInt(149000011)),
+	}, info)
+
+	assert.Error(t, info.UnmarshalJSON([]byte(`{"weight":1,"class":"unknown","partialFee":"1"}`)))
+	assert.Error(t, info.UnmarshalJSON([]byte(`{"weight":1,"class":"normal","partialFee":"abc"}`)))
+}
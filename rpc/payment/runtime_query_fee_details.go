@@ -0,0 +1,55 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package payment
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/rpc/state"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+// RuntimeQueryFeeDetails retrieves the fee breakdown of the extrinsic, including its tip, at the given block by
+// calling TransactionPaymentApi_query_fee_details
+func (p *payment) RuntimeQueryFeeDetails(xt types.Extrinsic, blockHash types.Hash) (types.FeeDetails, error) {
+	return p.runtimeQueryFeeDetails(context.Background(), xt, &blockHash)
+}
+
+// RuntimeQueryFeeDetailsContext retrieves the fee breakdown of the extrinsic at the given block by calling
+// TransactionPaymentApi_query_fee_details, the call is aborted when ctx is done
+func (p *payment) RuntimeQueryFeeDetailsContext(ctx context.Context, xt types.Extrinsic, blockHash types.Hash) (
+	types.FeeDetails, error) {
+	return p.runtimeQueryFeeDetails(ctx, xt, &blockHash)
+}
+
+// RuntimeQueryFeeDetailsLatest retrieves the fee breakdown of the extrinsic, including its tip, at the latest block
+// by calling TransactionPaymentApi_query_fee_details
+func (p *payment) RuntimeQueryFeeDetailsLatest(xt types.Extrinsic) (types.FeeDetails, error) {
+	return p.runtimeQueryFeeDetails(context.Background(), xt, nil)
+}
+
+// RuntimeQueryFeeDetailsLatestContext retrieves the fee breakdown of the extrinsic at the latest block by calling
+// TransactionPaymentApi_query_fee_details, the call is aborted when ctx is done
+func (p *payment) RuntimeQueryFeeDetailsLatestContext(ctx context.Context, xt types.Extrinsic) (
+	types.FeeDetails, error) {
+	return p.runtimeQueryFeeDetails(ctx, xt, nil)
+}
+
+func (p *payment) runtimeQueryFeeDetails(ctx context.Context, xt types.Extrinsic, blockHash *types.Hash) (
+	types.FeeDetails, error) {
+	return state.TransactionPaymentQueryFeeDetails(ctx, state.NewState(p.client), xt, blockHash)
+}
@@ -0,0 +1,55 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package payment
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/rpc/state"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+// RuntimeQueryInfo retrieves the dispatch info of the extrinsic at the given block by calling
+// TransactionPaymentApi_query_info. The weight is decoded as Weight V2.
+func (p *payment) RuntimeQueryInfo(xt types.Extrinsic, blockHash types.Hash) (types.RuntimeDispatchInfo, error) {
+	return p.runtimeQueryInfo(context.Background(), xt, &blockHash)
+}
+
+// RuntimeQueryInfoContext retrieves the dispatch info of the extrinsic at the given block by calling
+// TransactionPaymentApi_query_info, the call is aborted when ctx is done
+func (p *payment) RuntimeQueryInfoContext(ctx context.Context, xt types.Extrinsic, blockHash types.Hash) (
+	types.RuntimeDispatchInfo, error) {
+	return p.runtimeQueryInfo(ctx, xt, &blockHash)
+}
+
+// RuntimeQueryInfoLatest retrieves the dispatch info of the extrinsic at the latest block by calling
+// TransactionPaymentApi_query_info
+func (p *payment) RuntimeQueryInfoLatest(xt types.Extrinsic) (types.RuntimeDispatchInfo, error) {
+	return p.runtimeQueryInfo(context.Background(), xt, nil)
+}
+
+// RuntimeQueryInfoLatestContext retrieves the dispatch info of the extrinsic at the latest block by calling
+// TransactionPaymentApi_query_info, the call is aborted when ctx is done
+func (p *payment) RuntimeQueryInfoLatestContext(ctx context.Context, xt types.Extrinsic) (
+	types.RuntimeDispatchInfo, error) {
+	return p.runtimeQueryInfo(ctx, xt, nil)
+}
+
+func (p *payment) runtimeQueryInfo(ctx context.Context, xt types.Extrinsic, blockHash *types.Hash) (
+	types.RuntimeDispatchInfo, error) {
+	return state.TransactionPaymentQueryInfo(ctx, state.NewState(p.client), xt, blockHash)
+}
@@ -0,0 +1,67 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package payment
+
+import (
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPayment_RuntimeQueryInfo(t *testing.T) {
+	info, err := testPayment.RuntimeQueryInfo(mockSrv.xt, mockSrv.blockHash)
+	assert.NoError(t, err)
+	assert.Equal(t, mockSrv.info, info)
+	assert.Equal(t, mockSrv.blockHash.Hex(), *mockSrv.lastAt)
+
+	// The runtime API is called with the extrinsic followed by its encoded length
+	enc, err := codec.Encode(mockSrv.xt)
+	assert.NoError(t, err)
+	assert.Equal(t, append(enc, byte(len(enc)), 0, 0, 0), mockSrv.lastCall)
+}
+
+func TestPayment_RuntimeQueryInfoLatest(t *testing.T) {
+	info, err := testPayment.RuntimeQueryInfoLatest(mockSrv.xt)
+	assert.NoError(t, err)
+	assert.Equal(t, mockSrv.info, info)
+	assert.Nil(t, mockSrv.lastAt)
+}
+
+func TestPayment_RuntimeQueryFeeDetails(t *testing.T) {
+	details, err := testPayment.RuntimeQueryFeeDetails(mockSrv.xt, mockSrv.blockHash)
+	assert.NoError(t, err)
+	assert.Equal(t, mockSrv.feeDetails, details)
+
+	details, err = testPayment.RuntimeQueryFeeDetailsLatest(mockSrv.xt)
+	assert.NoError(t, err)
+	assert.Equal(t, mockSrv.feeDetails, details)
+}
+
+func TestFeeDetails_EncodeDecode(t *testing.T) {
+	unsigned := types.FeeDetails{InclusionFee: types.NewOptionInclusionFeeEmpty(), Tip: mockSrv.feeDetails.Tip}
+
+	for _, details := range []types.FeeDetails{mockSrv.feeDetails, unsigned} {
+		enc, err := codec.Encode(details)
+		assert.NoError(t, err)
+
+		var dec types.FeeDetails
+		assert.NoError(t, codec.Decode(enc, &dec))
+		assert.Equal(t, details, dec)
+	}
+}
@@ -0,0 +1,60 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+// Call calls the runtime API function, e.g. Core_version, with the SCALE encoded data at the given block and returns
+// the SCALE encoded result. See CallRuntimeAPI for a typed variant.
+func (s *state) Call(method string, data []byte, blockHash types.Hash) ([]byte, error) {
+	return s.call(context.Background(), method, data, &blockHash)
+}
+
+// CallContext calls the runtime API function with the SCALE encoded data at the given block, the call is aborted when
+// ctx is done
+func (s *state) CallContext(ctx context.Context, method string, data []byte, blockHash types.Hash) ([]byte, error) {
+	return s.call(ctx, method, data, &blockHash)
+}
+
+// CallLatest calls the runtime API function, e.g. Core_version, with the SCALE encoded data at the latest block and
+// returns the SCALE encoded result
+func (s *state) CallLatest(method string, data []byte) ([]byte, error) {
+	return s.call(context.Background(), method, data, nil)
+}
+
+// CallLatestContext calls the runtime API function with the SCALE encoded data at the latest block, the call is
+// aborted when ctx is done
+func (s *state) CallLatestContext(ctx context.Context, method string, data []byte) ([]byte, error) {
+	return s.call(ctx, method, data, nil)
+}
+
+func (s *state) call(ctx context.Context, method string, data []byte, blockHash *types.Hash) ([]byte, error) {
+	var res string
+	err := client.CallWithBlockHashContext(ctx, s.client, &res, "state_call", blockHash, method,
+		codec.HexEncodeToString(data))
+	if err != nil {
+		return nil, err
+	}
+
+	return codec.HexDecodeString(res)
+}
@@ -0,0 +1,63 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/scale"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+// CoreVersion returns the runtime version by calling Core_version
+func CoreVersion(ctx context.Context, s State, blockHash *types.Hash) (*types.RuntimeVersion, error) {
+	v, err := CallRuntimeAPI[coreVersion](ctx, s, "Core_version", blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v.RuntimeVersion, nil
+}
+
+// coreVersion decodes a runtime version in the order the runtime encodes it, which is not the order of the fields
+// of types.RuntimeVersion
+type coreVersion struct {
+	types.RuntimeVersion
+}
+
+func (v *coreVersion) Decode(decoder scale.Decoder) error {
+	var apis []struct {
+		ID      [8]byte
+		Version types.U32
+	}
+
+	for _, field := range []interface{}{
+		&v.SpecName, &v.ImplName, &v.AuthoringVersion, &v.SpecVersion, &v.ImplVersion, &apis, &v.TransactionVersion,
+	} {
+		if err := decoder.Decode(field); err != nil {
+			return err
+		}
+	}
+
+	v.APIs = make([]types.RuntimeVersionAPI, len(apis))
+	for i, api := range apis {
+		v.APIs[i] = types.RuntimeVersionAPI{APIID: codec.HexEncodeToString(api.ID[:]), Version: api.Version}
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+// GetChildKeysPaged retreives up to count keys with the given prefix of a specific child storage, starting after
+// startKey if it is not nil
+func (s *state) GetChildKeysPaged(childStorageKey, prefix types.StorageKey, count uint32, startKey *types.StorageKey,
+	blockHash types.Hash) ([]types.StorageKey, error) {
+	return s.GetChildKeysPagedContext(context.Background(), childStorageKey, prefix, count, startKey, blockHash)
+}
+
+// GetChildKeysPagedContext retreives up to count keys with the given prefix of a specific child storage, starting
+// after startKey if it is not nil. The call is aborted when ctx is done.
+func (s *state) GetChildKeysPagedContext(ctx context.Context, childStorageKey, prefix types.StorageKey, count uint32,
+	startKey *types.StorageKey, blockHash types.Hash) ([]types.StorageKey, error) {
+	return s.getChildKeysPaged(ctx, childStorageKey, prefix, count, startKey, &blockHash)
+}
+
+// GetChildKeysPagedLatest retreives up to count keys with the given prefix of a specific child storage for the latest
+// block height, starting after startKey if it is not nil
+func (s *state) GetChildKeysPagedLatest(childStorageKey, prefix types.StorageKey, count uint32,
+	startKey *types.StorageKey) ([]types.StorageKey, error) {
+	return s.GetChildKeysPagedLatestContext(context.Background(), childStorageKey, prefix, count, startKey)
+}
+
+// GetChildKeysPagedLatestContext retreives up to count keys with the given prefix of a specific child storage for the
+// latest block height, starting after startKey if it is not nil. The call is aborted when ctx is done.
+func (s *state) GetChildKeysPagedLatestContext(ctx context.Context, childStorageKey, prefix types.StorageKey,
+	count uint32, startKey *types.StorageKey) ([]types.StorageKey, error) {
+	return s.getChildKeysPaged(ctx, childStorageKey, prefix, count, startKey, nil)
+}
+
+func (s *state) getChildKeysPaged(ctx context.Context, childStorageKey, prefix types.StorageKey, count uint32,
+	startKey *types.StorageKey, blockHash *types.Hash) ([]types.StorageKey, error) {
+	var res []string
+	err := client.CallWithBlockHashContext(ctx, s.client, &res, "childstate_getKeysPaged", blockHash,
+		childStorageKey.Hex(), prefix.Hex(), count, startKeyArg(startKey))
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeKeys(res)
+}
@@ -0,0 +1,69 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+// GetChildReadProof retreives a proof of the entries for the given keys of a specific child storage. The proof can be
+// verified against the state root of the block with trie.VerifyChildProof.
+func (s *state) GetChildReadProof(childStorageKey types.StorageKey, keys []types.StorageKey, blockHash types.Hash) (
+	*types.ReadProof, error) {
+	return s.GetChildReadProofContext(context.Background(), childStorageKey, keys, blockHash)
+}
+
+// GetChildReadProofContext retreives a proof of the entries for the given keys of a specific child storage, the call
+// is aborted when ctx is done
+func (s *state) GetChildReadProofContext(ctx context.Context, childStorageKey types.StorageKey,
+	keys []types.StorageKey, blockHash types.Hash) (*types.ReadProof, error) {
+	return s.getChildReadProof(ctx, childStorageKey, keys, &blockHash)
+}
+
+// GetChildReadProofLatest retreives a proof of the entries for the given keys of a specific child storage for the
+// latest block height
+func (s *state) GetChildReadProofLatest(childStorageKey types.StorageKey, keys []types.StorageKey) (
+	*types.ReadProof, error) {
+	return s.GetChildReadProofLatestContext(context.Background(), childStorageKey, keys)
+}
+
+// GetChildReadProofLatestContext retreives a proof of the entries for the given keys of a specific child storage for
+// the latest block height, the call is aborted when ctx is done
+func (s *state) GetChildReadProofLatestContext(ctx context.Context, childStorageKey types.StorageKey,
+	keys []types.StorageKey) (*types.ReadProof, error) {
+	return s.getChildReadProof(ctx, childStorageKey, keys, nil)
+}
+
+func (s *state) getChildReadProof(ctx context.Context, childStorageKey types.StorageKey, keys []types.StorageKey,
+	blockHash *types.Hash) (*types.ReadProof, error) {
+	hexKeys := make([]string, len(keys))
+	for i, key := range keys {
+		hexKeys[i] = key.Hex()
+	}
+
+	var res types.ReadProof
+	err := client.CallWithBlockHashContext(ctx, s.client, &res, "state_getChildReadProof", blockHash,
+		childStorageKey.Hex(), hexKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
@@ -17,23 +17,40 @@
 package state
 
 import (
+	"context"
+
 	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
 )
 
 // GetChildStorageHash retreives the child storage hash for the given key
 func (s *state) GetChildStorageHash(childStorageKey, key types.StorageKey, blockHash types.Hash) (types.Hash, error) {
-	return s.getChildStorageHash(childStorageKey, key, &blockHash)
+	return s.GetChildStorageHashContext(context.Background(), childStorageKey, key, blockHash)
+}
+
+// GetChildStorageHashContext retreives the child storage hash for the given key, the call is aborted when ctx is done
+func (s *state) GetChildStorageHashContext(ctx context.Context, childStorageKey, key types.StorageKey,
+	blockHash types.Hash) (types.Hash, error) {
+	return s.getChildStorageHash(ctx, childStorageKey, key, &blockHash)
 }
 
 // GetChildStorageHashLatest retreives the child storage hash for the given key for the latest block height
 func (s *state) GetChildStorageHashLatest(childStorageKey, key types.StorageKey) (types.Hash, error) {
-	return s.getChildStorageHash(childStorageKey, key, nil)
+	return s.GetChildStorageHashLatestContext(context.Background(), childStorageKey, key)
+}
+
+// GetChildStorageHashLatestContext retreives the child storage hash for the given key for the latest block height, the
+// call is aborted when ctx is done
+func (s *state) GetChildStorageHashLatestContext(ctx context.Context, childStorageKey, key types.StorageKey) (
+	types.Hash, error) {
+	return s.getChildStorageHash(ctx, childStorageKey, key, nil)
 }
 
-func (s *state) getChildStorageHash(childStorageKey, key types.StorageKey, blockHash *types.Hash) (types.Hash, error) {
+func (s *state) getChildStorageHash(ctx context.Context, childStorageKey, key types.StorageKey, blockHash *types.Hash) (
+	types.Hash, error) {
 	var res string
-	err := client.CallWithBlockHash(s.client, &res, "state_getChildStorageHash", blockHash, childStorageKey.Hex(),
+	err := client.CallWithBlockHashContext(ctx, s.client, &res, "state_getChildStorageHash", blockHash,
+		childStorageKey.Hex(),
 		key.Hex())
 	if err != nil {
 		return types.Hash{}, err
@@ -0,0 +1,84 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+// GetKeysPaged retreives up to count keys with the given prefix, starting after startKey if it is not nil. Nodes
+// limit count to 1000.
+func (s *state) GetKeysPaged(prefix types.StorageKey, count uint32, startKey *types.StorageKey,
+	blockHash types.Hash) ([]types.StorageKey, error) {
+	return s.GetKeysPagedContext(context.Background(), prefix, count, startKey, blockHash)
+}
+
+// GetKeysPagedContext retreives up to count keys with the given prefix, starting after startKey if it is not nil. The
+// call is aborted when ctx is done.
+func (s *state) GetKeysPagedContext(ctx context.Context, prefix types.StorageKey, count uint32,
+	startKey *types.StorageKey, blockHash types.Hash) ([]types.StorageKey, error) {
+	return s.getKeysPaged(ctx, prefix, count, startKey, &blockHash)
+}
+
+// GetKeysPagedLatest retreives up to count keys with the given prefix for the latest block height, starting after
+// startKey if it is not nil
+func (s *state) GetKeysPagedLatest(prefix types.StorageKey, count uint32, startKey *types.StorageKey) (
+	[]types.StorageKey, error) {
+	return s.GetKeysPagedLatestContext(context.Background(), prefix, count, startKey)
+}
+
+// GetKeysPagedLatestContext retreives up to count keys with the given prefix for the latest block height, starting
+// after startKey if it is not nil. The call is aborted when ctx is done.
+func (s *state) GetKeysPagedLatestContext(ctx context.Context, prefix types.StorageKey, count uint32,
+	startKey *types.StorageKey) ([]types.StorageKey, error) {
+	return s.getKeysPaged(ctx, prefix, count, startKey, nil)
+}
+
+func (s *state) getKeysPaged(ctx context.Context, prefix types.StorageKey, count uint32, startKey *types.StorageKey,
+	blockHash *types.Hash) ([]types.StorageKey, error) {
+	var res []string
+	err := client.CallWithBlockHashContext(ctx, s.client, &res, "state_getKeysPaged", blockHash, prefix.Hex(), count,
+		startKeyArg(startKey))
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeKeys(res)
+}
+
+// startKeyArg returns the optional start key argument of the paged RPC methods
+func startKeyArg(startKey *types.StorageKey) interface{} {
+	if startKey == nil {
+		return nil
+	}
+	return startKey.Hex()
+}
+
+func decodeKeys(res []string) ([]types.StorageKey, error) {
+	keys := make([]types.StorageKey, len(res))
+	for i, r := range res {
+		err := codec.DecodeFromHex(r, &keys[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return keys, nil
+}
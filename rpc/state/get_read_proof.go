@@ -0,0 +1,64 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+// GetReadProof retreives a proof of the storage entries for the given keys. The proof can be verified against the
+// state root of the block with trie.VerifyProof.
+func (s *state) GetReadProof(keys []types.StorageKey, blockHash types.Hash) (*types.ReadProof, error) {
+	return s.GetReadProofContext(context.Background(), keys, blockHash)
+}
+
+// GetReadProofContext retreives a proof of the storage entries for the given keys, the call is aborted when ctx is
+// done
+func (s *state) GetReadProofContext(ctx context.Context, keys []types.StorageKey, blockHash types.Hash) (
+	*types.ReadProof, error) {
+	return s.getReadProof(ctx, keys, &blockHash)
+}
+
+// GetReadProofLatest retreives a proof of the storage entries for the given keys for the latest block height
+func (s *state) GetReadProofLatest(keys []types.StorageKey) (*types.ReadProof, error) {
+	return s.GetReadProofLatestContext(context.Background(), keys)
+}
+
+// GetReadProofLatestContext retreives a proof of the storage entries for the given keys for the latest block height,
+// the call is aborted when ctx is done
+func (s *state) GetReadProofLatestContext(ctx context.Context, keys []types.StorageKey) (*types.ReadProof, error) {
+	return s.getReadProof(ctx, keys, nil)
+}
+
+func (s *state) getReadProof(ctx context.Context, keys []types.StorageKey, blockHash *types.Hash) (
+	*types.ReadProof, error) {
+	hexKeys := make([]string, len(keys))
+	for i, key := range keys {
+		hexKeys[i] = key.Hex()
+	}
+
+	var res types.ReadProof
+	err := client.CallWithBlockHashContext(ctx, s.client, &res, "state_getReadProof", blockHash, hexKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
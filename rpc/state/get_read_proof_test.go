@@ -0,0 +1,46 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/trie"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var readProofKeys = []types.StorageKey{{0x01}}
+
+func TestState_GetReadProofLatest(t *testing.T) {
+	proof, err := testState.GetReadProofLatest(readProofKeys)
+	assert.NoError(t, err)
+	assert.Equal(t, &types.ReadProof{At: mockSrv.blockHashLatest, Proof: []types.Bytes{readProofNode}}, proof)
+}
+
+func TestState_GetReadProof(t *testing.T) {
+	proof, err := testState.GetReadProof(readProofKeys, mockSrv.blockHashLatest)
+	assert.NoError(t, err)
+
+	root, err := trie.Root([]trie.Entry{{Key: types.StorageKey{0x01}, Value: types.StorageDataRaw{0x02}}},
+		trie.StateVersionV1)
+	assert.NoError(t, err)
+
+	values, err := trie.VerifyProof(root, proof.Proof, readProofKeys)
+	assert.NoError(t, err)
+	assert.Equal(t, []*types.StorageDataRaw{{0x02}}, values)
+}
@@ -17,6 +17,8 @@
 package state
 
 import (
+	"context"
+
 	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
@@ -25,7 +27,14 @@ import (
 // GetStorage retreives the stored data and decodes them into the provided interface. Ok is true if the value is not
 // empty.
 func (s *state) GetStorage(key types.StorageKey, target interface{}, blockHash types.Hash) (ok bool, err error) {
-	raw, err := s.getStorageRaw(key, &blockHash)
+	return s.GetStorageContext(context.Background(), key, target, blockHash)
+}
+
+// GetStorageContext retreives the stored data and decodes them into the provided interface. Ok is true if the value is
+// not empty. The call is aborted when ctx is done.
+func (s *state) GetStorageContext(ctx context.Context, key types.StorageKey, target interface{}, blockHash types.Hash) (
+	ok bool, err error) {
+	raw, err := s.getStorageRaw(ctx, key, &blockHash)
 	if err != nil {
 		return false, err
 	}
@@ -38,7 +47,14 @@ func (s *state) GetStorage(key types.StorageKey, target interface{}, blockHash t
 // GetStorageLatest retreives the stored data for the latest block height and decodes them into the provided interface.
 // Ok is true if the value is not empty.
 func (s *state) GetStorageLatest(key types.StorageKey, target interface{}) (ok bool, err error) {
-	raw, err := s.getStorageRaw(key, nil)
+	return s.GetStorageLatestContext(context.Background(), key, target)
+}
+
+// GetStorageLatestContext retreives the stored data for the latest block height and decodes them into the provided
+// interface. Ok is true if the value is not empty. The call is aborted when ctx is done.
+func (s *state) GetStorageLatestContext(ctx context.Context, key types.StorageKey, target interface{}) (
+	ok bool, err error) {
+	raw, err := s.getStorageRaw(ctx, key, nil)
 	if err != nil {
 		return false, err
 	}
@@ -50,17 +66,31 @@ func (s *state) GetStorageLatest(key types.StorageKey, target interface{}) (ok b
 
 // GetStorageRaw retreives the stored data as raw bytes, without decoding them
 func (s *state) GetStorageRaw(key types.StorageKey, blockHash types.Hash) (*types.StorageDataRaw, error) {
-	return s.getStorageRaw(key, &blockHash)
+	return s.GetStorageRawContext(context.Background(), key, blockHash)
+}
+
+// GetStorageRawContext retreives the stored data as raw bytes, without decoding them, the call is aborted when ctx is
+// done
+func (s *state) GetStorageRawContext(ctx context.Context, key types.StorageKey, blockHash types.Hash) (
+	*types.StorageDataRaw, error) {
+	return s.getStorageRaw(ctx, key, &blockHash)
 }
 
 // GetStorageRawLatest retreives the stored data for the latest block height as raw bytes, without decoding them
 func (s *state) GetStorageRawLatest(key types.StorageKey) (*types.StorageDataRaw, error) {
-	return s.getStorageRaw(key, nil)
+	return s.GetStorageRawLatestContext(context.Background(), key)
+}
+
+// GetStorageRawLatestContext retreives the stored data for the latest block height as raw bytes, without decoding them,
+// the call is aborted when ctx is done
+func (s *state) GetStorageRawLatestContext(ctx context.Context, key types.StorageKey) (*types.StorageDataRaw, error) {
+	return s.getStorageRaw(ctx, key, nil)
 }
 
-func (s *state) getStorageRaw(key types.StorageKey, blockHash *types.Hash) (*types.StorageDataRaw, error) {
+func (s *state) getStorageRaw(ctx context.Context, key types.StorageKey, blockHash *types.Hash) (
+	*types.StorageDataRaw, error) {
 	var res string
-	err := client.CallWithBlockHash(s.client, &res, "state_getStorage", blockHash, key.Hex())
+	err := client.CallWithBlockHashContext(ctx, s.client, &res, "state_getStorage", blockHash, key.Hex())
 	if err != nil {
 		return nil, err
 	}
@@ -17,23 +17,37 @@
 package state
 
 import (
+	"context"
+
 	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
 )
 
 // GetStorageHash retreives the storage hash for the given key
 func (s *state) GetStorageHash(key types.StorageKey, blockHash types.Hash) (types.Hash, error) {
-	return s.getStorageHash(key, &blockHash)
+	return s.GetStorageHashContext(context.Background(), key, blockHash)
+}
+
+// GetStorageHashContext retreives the storage hash for the given key, the call is aborted when ctx is done
+func (s *state) GetStorageHashContext(ctx context.Context, key types.StorageKey, blockHash types.Hash) (
+	types.Hash, error) {
+	return s.getStorageHash(ctx, key, &blockHash)
 }
 
 // GetStorageHashLatest retreives the storage hash for the given key for the latest block height
 func (s *state) GetStorageHashLatest(key types.StorageKey) (types.Hash, error) {
-	return s.getStorageHash(key, nil)
+	return s.GetStorageHashLatestContext(context.Background(), key)
+}
+
+// GetStorageHashLatestContext retreives the storage hash for the given key for the latest block height, the call is
+// aborted when ctx is done
+func (s *state) GetStorageHashLatestContext(ctx context.Context, key types.StorageKey) (types.Hash, error) {
+	return s.getStorageHash(ctx, key, nil)
 }
 
-func (s *state) getStorageHash(key types.StorageKey, blockHash *types.Hash) (types.Hash, error) {
+func (s *state) getStorageHash(ctx context.Context, key types.StorageKey, blockHash *types.Hash) (types.Hash, error) {
 	var res string
-	err := client.CallWithBlockHash(s.client, &res, "state_getStorageHash", blockHash, key.Hex())
+	err := client.CallWithBlockHashContext(ctx, s.client, &res, "state_getStorageHash", blockHash, key.Hex())
 	if err != nil {
 		return types.Hash{}, err
 	}
@@ -0,0 +1,108 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
+	gethrpc "github.com/centrifuge/go-substrate-rpc-client/v4/gethrpc"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+// GetStorageRawMulti retreives the stored data of all keys as raw bytes, using batch requests instead of one round
+// trip per key. The data and errors are returned in the order of the keys, an error for a single key is reported in
+// errs only. The keys are sent in batches of client.DefaultBatchSize. The returned error is set if a batch failed as a
+// whole, the data of the keys fetched by earlier batches is still returned and the keys that were not fetched have the
+// error in errs.
+func (s *state) GetStorageRawMulti(keys []types.StorageKey, blockHash types.Hash) (
+	data []*types.StorageDataRaw, errs []error, err error) {
+	return s.GetStorageRawMultiContext(context.Background(), keys, blockHash)
+}
+
+// GetStorageRawMultiContext retreives the stored data of all keys as raw bytes, using batch requests instead of one
+// round trip per key, see GetStorageRawMulti. The call is aborted when ctx is done.
+func (s *state) GetStorageRawMultiContext(ctx context.Context, keys []types.StorageKey, blockHash types.Hash) (
+	data []*types.StorageDataRaw, errs []error, err error) {
+	return s.getStorageRawMulti(ctx, keys, &blockHash)
+}
+
+// GetStorageRawMultiLatest retreives the stored data of all keys for the latest block height as raw bytes, see
+// GetStorageRawMulti. The latest block hash is looked up once, so that all batches read the same block.
+func (s *state) GetStorageRawMultiLatest(keys []types.StorageKey) (
+	data []*types.StorageDataRaw, errs []error, err error) {
+	return s.GetStorageRawMultiLatestContext(context.Background(), keys)
+}
+
+// GetStorageRawMultiLatestContext retreives the stored data of all keys for the latest block height as raw bytes, see
+// GetStorageRawMultiLatest. The call is aborted when ctx is done.
+func (s *state) GetStorageRawMultiLatestContext(ctx context.Context, keys []types.StorageKey) (
+	data []*types.StorageDataRaw, errs []error, err error) {
+	return s.getStorageRawMulti(ctx, keys, nil)
+}
+
+func (s *state) getStorageRawMulti(ctx context.Context, keys []types.StorageKey, blockHash *types.Hash) (
+	[]*types.StorageDataRaw, []error, error) {
+	if len(keys) == 0 {
+		return []*types.StorageDataRaw{}, []error{}, nil
+	}
+
+	// The keys might be sent in several batches, which must all read the same block
+	if blockHash == nil {
+		var latest types.Hash
+		if err := s.client.CallContext(ctx, &latest, "chain_getBlockHash"); err != nil {
+			return nil, nil, err
+		}
+		blockHash = &latest
+	}
+
+	hexHash, err := codec.Hex(*blockHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res := make([]string, len(keys))
+	batch := make([]gethrpc.BatchElem, len(keys))
+
+	for i, key := range keys {
+		batch[i] = gethrpc.BatchElem{Method: "state_getStorage", Args: []interface{}{key.Hex(), hexHash}, Result: &res[i]}
+	}
+
+	batchErr := client.BatchCallInChunks(ctx, s.client, batch, client.DefaultBatchSize)
+
+	data := make([]*types.StorageDataRaw, len(keys))
+	errs := make([]error, len(keys))
+
+	for i, elem := range batch {
+		if elem.Error != nil {
+			errs[i] = elem.Error
+			continue
+		}
+
+		bz, err := codec.HexDecodeString(res[i])
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+
+		d := types.NewStorageDataRaw(bz)
+		data[i] = &d
+	}
+
+	return data, errs, batchErr
+}
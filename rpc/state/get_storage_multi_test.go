@@ -0,0 +1,114 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
+	gethrpc "github.com/centrifuge/go-substrate-rpc-client/v4/gethrpc"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestState_GetStorageRawMulti(t *testing.T) {
+	keys := []types.StorageKey{
+		codec.MustHexDecodeString(mockSrv.storageKeyHex),
+		{0xab},
+		codec.MustHexDecodeString(mockSrv.storageKeyHex),
+	}
+
+	data, errs, err := testState.GetStorageRawMulti(keys, mockSrv.blockHashLatest)
+	assert.NoError(t, err)
+	assert.Len(t, data, 3)
+	assert.Equal(t, []error{nil, nil, nil}, errs)
+	assert.Equal(t, mockSrv.storageDataHex, data[0].Hex())
+	assert.Empty(t, *data[1])
+	assert.Equal(t, mockSrv.storageDataHex, data[2].Hex())
+}
+
+func TestState_GetStorageRawMultiLatest(t *testing.T) {
+	data, errs, err := testState.GetStorageRawMultiLatest([]types.StorageKey{
+		codec.MustHexDecodeString(mockSrv.storageKeyHex),
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, errs[0])
+	assert.Equal(t, mockSrv.storageDataHex, data[0].Hex())
+}
+
+func TestState_GetStorageRawMultiEmpty(t *testing.T) {
+	data, errs, err := testState.GetStorageRawMultiLatest(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, data)
+	assert.Empty(t, errs)
+}
+
+// batchRecorder records the batches sent to the wrapped client and fails the batch with the index failAt
+type batchRecorder struct {
+	client.Client
+
+	batches [][]gethrpc.BatchElem
+	failAt  int
+}
+
+func (r *batchRecorder) BatchCallContext(ctx context.Context, b []gethrpc.BatchElem) error {
+	r.batches = append(r.batches, b)
+	if len(r.batches)-1 == r.failAt {
+		return errors.New("batch failed")
+	}
+	return r.Client.BatchCallContext(ctx, b)
+}
+
+func testKeys(n int) []types.StorageKey {
+	keys := make([]types.StorageKey, n)
+	for i := range keys {
+		keys[i] = codec.MustHexDecodeString(mockSrv.storageKeyHex)
+	}
+	return keys
+}
+
+func TestState_GetStorageRawMultiLatest_PinsAllBatches(t *testing.T) {
+	rec := &batchRecorder{Client: testClient, failAt: -1}
+
+	data, errs, err := NewState(rec).GetStorageRawMultiLatest(testKeys(client.DefaultBatchSize + 1))
+	assert.NoError(t, err)
+	assert.Len(t, data, client.DefaultBatchSize+1)
+	assert.NoError(t, errs[client.DefaultBatchSize])
+
+	assert.Len(t, rec.batches, 2)
+	for _, b := range rec.batches {
+		for _, elem := range b {
+			assert.Equal(t, mockSrv.blockHashLatest.Hex(), elem.Args[1])
+		}
+	}
+}
+
+func TestState_GetStorageRawMulti_PartialResults(t *testing.T) {
+	rec := &batchRecorder{Client: testClient, failAt: 1}
+
+	data, errs, err := NewState(rec).GetStorageRawMulti(testKeys(client.DefaultBatchSize+1), mockSrv.blockHashLatest)
+	assert.EqualError(t, err, "batch failed")
+	assert.Len(t, data, client.DefaultBatchSize+1)
+
+	assert.NoError(t, errs[0])
+	assert.Equal(t, mockSrv.storageDataHex, data[client.DefaultBatchSize-1].Hex())
+	assert.Nil(t, data[client.DefaultBatchSize])
+	assert.Equal(t, err, errs[client.DefaultBatchSize])
+}
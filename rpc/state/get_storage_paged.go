@@ -0,0 +1,64 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+// GetStoragePaged retreives up to count keys with the given prefix together with their values, starting after
+// startKey if it is not nil
+func (s *state) GetStoragePaged(prefix types.StorageKey, count uint32, startKey *types.StorageKey,
+	blockHash types.Hash) ([]types.KeyValueOption, error) {
+	return s.GetStoragePagedContext(context.Background(), prefix, count, startKey, blockHash)
+}
+
+// GetStoragePagedContext retreives up to count keys with the given prefix together with their values, starting after
+// startKey if it is not nil. The call is aborted when ctx is done.
+func (s *state) GetStoragePagedContext(ctx context.Context, prefix types.StorageKey, count uint32,
+	startKey *types.StorageKey, blockHash types.Hash) ([]types.KeyValueOption, error) {
+	return s.getStoragePaged(ctx, prefix, count, startKey, &blockHash)
+}
+
+// GetStoragePagedLatest retreives up to count keys with the given prefix together with their values for the latest
+// block height, starting after startKey if it is not nil
+func (s *state) GetStoragePagedLatest(prefix types.StorageKey, count uint32, startKey *types.StorageKey) (
+	[]types.KeyValueOption, error) {
+	return s.GetStoragePagedLatestContext(context.Background(), prefix, count, startKey)
+}
+
+// GetStoragePagedLatestContext retreives up to count keys with the given prefix together with their values for the
+// latest block height, starting after startKey if it is not nil. The call is aborted when ctx is done.
+func (s *state) GetStoragePagedLatestContext(ctx context.Context, prefix types.StorageKey, count uint32,
+	startKey *types.StorageKey) ([]types.KeyValueOption, error) {
+	return s.getStoragePaged(ctx, prefix, count, startKey, nil)
+}
+
+func (s *state) getStoragePaged(ctx context.Context, prefix types.StorageKey, count uint32,
+	startKey *types.StorageKey, blockHash *types.Hash) ([]types.KeyValueOption, error) {
+	var res []types.KeyValueOption
+	err := client.CallWithBlockHashContext(ctx, s.client, &res, "state_getStoragePaged", blockHash, prefix.Hex(),
+		count, startKeyArg(startKey))
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -17,23 +17,37 @@
 package state
 
 import (
+	"context"
+
 	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
 )
 
 // GetStorageSize retreives the storage size for the given key
 func (s *state) GetStorageSize(key types.StorageKey, blockHash types.Hash) (types.U64, error) {
-	return s.getStorageSize(key, &blockHash)
+	return s.GetStorageSizeContext(context.Background(), key, blockHash)
+}
+
+// GetStorageSizeContext retreives the storage size for the given key, the call is aborted when ctx is done
+func (s *state) GetStorageSizeContext(ctx context.Context, key types.StorageKey, blockHash types.Hash) (
+	types.U64, error) {
+	return s.getStorageSize(ctx, key, &blockHash)
 }
 
 // GetStorageSizeLatest retreives the storage size for the given key for the latest block height
 func (s *state) GetStorageSizeLatest(key types.StorageKey) (types.U64, error) {
-	return s.getStorageSize(key, nil)
+	return s.GetStorageSizeLatestContext(context.Background(), key)
+}
+
+// GetStorageSizeLatestContext retreives the storage size for the given key for the latest block height, the call is
+// aborted when ctx is done
+func (s *state) GetStorageSizeLatestContext(ctx context.Context, key types.StorageKey) (types.U64, error) {
+	return s.getStorageSize(ctx, key, nil)
 }
 
-func (s *state) getStorageSize(key types.StorageKey, blockHash *types.Hash) (types.U64, error) {
+func (s *state) getStorageSize(ctx context.Context, key types.StorageKey, blockHash *types.Hash) (types.U64, error) {
 	var res types.U64
-	err := client.CallWithBlockHash(s.client, &res, "state_getStorageSize", blockHash, key.Hex())
+	err := client.CallWithBlockHashContext(ctx, s.client, &res, "state_getStorageSize", blockHash, key.Hex())
 	if err != nil {
 		return 0, err
 	}
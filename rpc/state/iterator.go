@@ -0,0 +1,171 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+// DefaultPageSize is the number of keys that iterators request at once if IterateOptions.PageSize is not set. It is
+// the maximum page size that nodes accept.
+const DefaultPageSize = 1000
+
+// IterateOptions configures the iteration over storage keys
+type IterateOptions struct {
+	// PageSize is the number of keys that are requested at once, it defaults to DefaultPageSize
+	PageSize uint32
+	// StartKey is the key after which the iteration starts, the key itself is not returned
+	StartKey *types.StorageKey
+	// BlockHash is the block that all pages are read from. If it is nil, the iteration is pinned to the best block at
+	// its start.
+	BlockHash *types.Hash
+}
+
+// StorageIterator streams storage keys, or keys with their values, that are requested page by page from the node
+type StorageIterator[T any] struct {
+	ch        chan T
+	blockHash types.Hash
+	cancel    context.CancelFunc
+	done      chan struct{}
+	err       error
+}
+
+// Chan returns the channel that receives the items. It is closed when all items have been received, when an error
+// occurred, or when the iteration has been stopped.
+func (it *StorageIterator[T]) Chan() <-chan T {
+	return it.ch
+}
+
+// Err returns the error that ended the iteration, once the channel returned by Chan is closed. It is nil if all items
+// have been received or Close has been called, and the error of the context if it is done before.
+func (it *StorageIterator[T]) Err() error {
+	<-it.done
+	return it.err
+}
+
+// BlockHash returns the hash of the block the iteration is pinned to
+func (it *StorageIterator[T]) BlockHash() types.Hash {
+	return it.blockHash
+}
+
+// Close stops the iteration and closes the channel returned by Chan. It can safely be called more than once.
+func (it *StorageIterator[T]) Close() {
+	it.cancel()
+	<-it.done
+}
+
+// IterateKeys streams the keys with the given prefix, see IterateOptions. The context bounds the whole iteration.
+func (s *state) IterateKeys(ctx context.Context, prefix types.StorageKey, opts IterateOptions) (
+	*StorageIterator[types.StorageKey], error) {
+	return iterate(ctx, s, opts,
+		func(ctx context.Context, count uint32, startKey *types.StorageKey, blockHash types.Hash) (
+			[]types.StorageKey, error) {
+			return s.GetKeysPagedContext(ctx, prefix, count, startKey, blockHash)
+		},
+		func(key types.StorageKey) types.StorageKey { return key },
+	)
+}
+
+// IterateStorage streams the keys with the given prefix together with their values, see IterateOptions. The context
+// bounds the whole iteration.
+func (s *state) IterateStorage(ctx context.Context, prefix types.StorageKey, opts IterateOptions) (
+	*StorageIterator[types.KeyValueOption], error) {
+	return iterate(ctx, s, opts,
+		func(ctx context.Context, count uint32, startKey *types.StorageKey, blockHash types.Hash) (
+			[]types.KeyValueOption, error) {
+			return s.GetStoragePagedContext(ctx, prefix, count, startKey, blockHash)
+		},
+		func(kv types.KeyValueOption) types.StorageKey { return kv.StorageKey },
+	)
+}
+
+// IterateChildKeys streams the keys with the given prefix of a specific child storage, see IterateOptions. The
+// context bounds the whole iteration.
+func (s *state) IterateChildKeys(ctx context.Context, childStorageKey, prefix types.StorageKey, opts IterateOptions) (
+	*StorageIterator[types.StorageKey], error) {
+	return iterate(ctx, s, opts,
+		func(ctx context.Context, count uint32, startKey *types.StorageKey, blockHash types.Hash) (
+			[]types.StorageKey, error) {
+			return s.GetChildKeysPagedContext(ctx, childStorageKey, prefix, count, startKey, blockHash)
+		},
+		func(key types.StorageKey) types.StorageKey { return key },
+	)
+}
+
+// iterate starts the iteration over the pages returned by fetch, key returns the storage key of an item
+func iterate[T any](ctx context.Context, s *state, opts IterateOptions,
+	fetch func(ctx context.Context, count uint32, startKey *types.StorageKey, blockHash types.Hash) ([]T, error),
+	key func(T) types.StorageKey) (*StorageIterator[T], error) {
+	pageSize := opts.PageSize
+	if pageSize == 0 {
+		pageSize = DefaultPageSize
+	}
+
+	var blockHash types.Hash
+	if opts.BlockHash != nil {
+		blockHash = *opts.BlockHash
+	} else if err := s.client.CallContext(ctx, &blockHash, "chain_getBlockHash"); err != nil {
+		return nil, err
+	}
+
+	iterCtx, cancel := context.WithCancel(ctx)
+	it := &StorageIterator[T]{
+		ch:        make(chan T),
+		blockHash: blockHash,
+		cancel:    cancel,
+		done:      make(chan struct{}),
+	}
+
+	go func() {
+		defer close(it.done)
+		defer close(it.ch)
+		defer cancel()
+
+		startKey := opts.StartKey
+		for {
+			page, err := fetch(iterCtx, pageSize, startKey, blockHash)
+			if err != nil {
+				if iterCtx.Err() != nil {
+					// Closed or the context is done, the request error only reflects that
+					err = ctx.Err()
+				}
+				it.err = err
+				return
+			}
+
+			for _, item := range page {
+				select {
+				case it.ch <- item:
+				case <-iterCtx.Done():
+					it.err = ctx.Err()
+					return
+				}
+			}
+
+			if len(page) < int(pageSize) {
+				return
+			}
+
+			last := key(page[len(page)-1])
+			startKey = &last
+		}
+	}()
+
+	return it, nil
+}
@@ -0,0 +1,125 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	"context"
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestState_IterateKeys(t *testing.T) {
+	mockSrv.pagedHashes = nil
+
+	it, err := testState.IterateKeys(context.Background(), types.StorageKey{0xaa}, IterateOptions{PageSize: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, mockSrv.blockHashLatest, it.BlockHash())
+
+	var keys []types.StorageKey
+	for key := range it.Chan() {
+		keys = append(keys, key)
+	}
+	assert.NoError(t, it.Err())
+	assert.Len(t, keys, 25)
+	for i, key := range keys {
+		assert.Equal(t, types.StorageKey{0xaa, 0x00, byte(i)}, key)
+	}
+
+	// All pages are read from the block the iteration is pinned to
+	hash := mockSrv.blockHashLatest.Hex()
+	assert.Equal(t, []string{hash, hash, hash}, mockSrv.pagedHashes)
+}
+
+func TestState_IterateKeys_ExactPages(t *testing.T) {
+	startKey := types.StorageKey{0xaa, 0x00, 0x04}
+	blockHash := types.Hash{0x02}
+	mockSrv.pagedHashes = nil
+
+	it, err := testState.IterateKeys(context.Background(), types.StorageKey{0xaa}, IterateOptions{
+		PageSize:  5,
+		StartKey:  &startKey,
+		BlockHash: &blockHash,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, blockHash, it.BlockHash())
+
+	var n int
+	for range it.Chan() {
+		n++
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, 20, n)
+
+	// The last page is empty
+	assert.Len(t, mockSrv.pagedHashes, 5)
+}
+
+func TestState_IterateStorage(t *testing.T) {
+	it, err := testState.IterateStorage(context.Background(), types.StorageKey{}, IterateOptions{PageSize: 7})
+	assert.NoError(t, err)
+
+	var pairs []types.KeyValueOption
+	for pair := range it.Chan() {
+		pairs = append(pairs, pair)
+	}
+	assert.NoError(t, it.Err())
+	assert.Len(t, pairs, 26)
+	assert.Equal(t, types.KeyValueOption{
+		StorageKey:     types.StorageKey{0xbb, 0x00, 0x00},
+		HasStorageData: true,
+		StorageData:    types.StorageDataRaw{0x00},
+	}, pairs[25])
+}
+
+func TestState_IterateChildKeys(t *testing.T) {
+	it, err := testState.IterateChildKeys(context.Background(), childStorageKey, types.StorageKey{0xbb},
+		IterateOptions{})
+	assert.NoError(t, err)
+
+	var keys []types.StorageKey
+	for key := range it.Chan() {
+		keys = append(keys, key)
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []types.StorageKey{{0xbb, 0x00, 0x00}}, keys)
+}
+
+func TestState_Iterate_Close(t *testing.T) {
+	it, err := testState.IterateKeys(context.Background(), types.StorageKey{0xaa}, IterateOptions{PageSize: 2})
+	assert.NoError(t, err)
+
+	<-it.Chan()
+	it.Close()
+	it.Close()
+	assert.NoError(t, it.Err())
+
+	_, ok := <-it.Chan()
+	assert.False(t, ok)
+}
+
+func TestState_Iterate_ContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	it, err := testState.IterateKeys(ctx, types.StorageKey{0xaa}, IterateOptions{PageSize: 2})
+	assert.NoError(t, err)
+
+	<-it.Chan()
+	cancel()
+	assert.ErrorIs(t, it.Err(), context.Canceled)
+}
@@ -3,9 +3,12 @@
 package mocks
 
 import (
+	context "context"
+
 	state "github.com/centrifuge/go-substrate-rpc-client/v4/rpc/state"
-	types "github.com/centrifuge/go-substrate-rpc-client/v4/types"
 	mock "github.com/stretchr/testify/mock"
+
+	types "github.com/centrifuge/go-substrate-rpc-client/v4/types"
 )
 
 // State is an autogenerated mock type for the State type
@@ -13,22 +16,22 @@ type State struct {
 	mock.Mock
 }
 
-// GetChildKeys provides a mock function with given fields: childStorageKey, prefix, blockHash
-func (_m *State) GetChildKeys(childStorageKey types.StorageKey, prefix types.StorageKey, blockHash types.Hash) ([]types.StorageKey, error) {
-	ret := _m.Called(childStorageKey, prefix, blockHash)
+// Call provides a mock function with given fields: method, data, blockHash
+func (_m *State) Call(method string, data []byte, blockHash types.Hash) ([]byte, error) {
+	ret := _m.Called(method, data, blockHash)
 
-	var r0 []types.StorageKey
-	if rf, ok := ret.Get(0).(func(types.StorageKey, types.StorageKey, types.Hash) []types.StorageKey); ok {
-		r0 = rf(childStorageKey, prefix, blockHash)
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(string, []byte, types.Hash) []byte); ok {
+		r0 = rf(method, data, blockHash)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]types.StorageKey)
+			r0 = ret.Get(0).([]byte)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(types.StorageKey, types.StorageKey, types.Hash) error); ok {
-		r1 = rf(childStorageKey, prefix, blockHash)
+	if rf, ok := ret.Get(1).(func(string, []byte, types.Hash) error); ok {
+		r1 = rf(method, data, blockHash)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -36,22 +39,22 @@ func (_m *State) GetChildKeys(childStorageKey types.StorageKey, prefix types.Sto
 	return r0, r1
 }
 
-// GetChildKeysLatest provides a mock function with given fields: childStorageKey, prefix
-func (_m *State) GetChildKeysLatest(childStorageKey types.StorageKey, prefix types.StorageKey) ([]types.StorageKey, error) {
-	ret := _m.Called(childStorageKey, prefix)
+// CallContext provides a mock function with given fields: ctx, method, data, blockHash
+func (_m *State) CallContext(ctx context.Context, method string, data []byte, blockHash types.Hash) ([]byte, error) {
+	ret := _m.Called(ctx, method, data, blockHash)
 
-	var r0 []types.StorageKey
-	if rf, ok := ret.Get(0).(func(types.StorageKey, types.StorageKey) []types.StorageKey); ok {
-		r0 = rf(childStorageKey, prefix)
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte, types.Hash) []byte); ok {
+		r0 = rf(ctx, method, data, blockHash)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]types.StorageKey)
+			r0 = ret.Get(0).([]byte)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(types.StorageKey, types.StorageKey) error); ok {
-		r1 = rf(childStorageKey, prefix)
+	if rf, ok := ret.Get(1).(func(context.Context, string, []byte, types.Hash) error); ok {
+		r1 = rf(ctx, method, data, blockHash)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -59,20 +62,22 @@ func (_m *State) GetChildKeysLatest(childStorageKey types.StorageKey, prefix typ
 	return r0, r1
 }
 
-// GetChildStorage provides a mock function with given fields: childStorageKey, key, target, blockHash
-func (_m *State) GetChildStorage(childStorageKey types.StorageKey, key types.StorageKey, target interface{}, blockHash types.Hash) (bool, error) {
-	ret := _m.Called(childStorageKey, key, target, blockHash)
+// CallLatest provides a mock function with given fields: method, data
+func (_m *State) CallLatest(method string, data []byte) ([]byte, error) {
+	ret := _m.Called(method, data)
 
-	var r0 bool
-	if rf, ok := ret.Get(0).(func(types.StorageKey, types.StorageKey, interface{}, types.Hash) bool); ok {
-		r0 = rf(childStorageKey, key, target, blockHash)
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(string, []byte) []byte); ok {
+		r0 = rf(method, data)
 	} else {
-		r0 = ret.Get(0).(bool)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(types.StorageKey, types.StorageKey, interface{}, types.Hash) error); ok {
-		r1 = rf(childStorageKey, key, target, blockHash)
+	if rf, ok := ret.Get(1).(func(string, []byte) error); ok {
+		r1 = rf(method, data)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -80,22 +85,22 @@ func (_m *State) GetChildStorage(childStorageKey types.StorageKey, key types.Sto
 	return r0, r1
 }
 
-// GetChildStorageHash provides a mock function with given fields: childStorageKey, key, blockHash
-func (_m *State) GetChildStorageHash(childStorageKey types.StorageKey, key types.StorageKey, blockHash types.Hash) (types.Hash, error) {
-	ret := _m.Called(childStorageKey, key, blockHash)
+// CallLatestContext provides a mock function with given fields: ctx, method, data
+func (_m *State) CallLatestContext(ctx context.Context, method string, data []byte) ([]byte, error) {
+	ret := _m.Called(ctx, method, data)
 
-	var r0 types.Hash
-	if rf, ok := ret.Get(0).(func(types.StorageKey, types.StorageKey, types.Hash) types.Hash); ok {
-		r0 = rf(childStorageKey, key, blockHash)
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte) []byte); ok {
+		r0 = rf(ctx, method, data)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(types.Hash)
+			r0 = ret.Get(0).([]byte)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(types.StorageKey, types.StorageKey, types.Hash) error); ok {
-		r1 = rf(childStorageKey, key, blockHash)
+	if rf, ok := ret.Get(1).(func(context.Context, string, []byte) error); ok {
+		r1 = rf(ctx, method, data)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -103,22 +108,22 @@ func (_m *State) GetChildStorageHash(childStorageKey types.StorageKey, key types
 	return r0, r1
 }
 
-// GetChildStorageHashLatest provides a mock function with given fields: childStorageKey, key
-func (_m *State) GetChildStorageHashLatest(childStorageKey types.StorageKey, key types.StorageKey) (types.Hash, error) {
-	ret := _m.Called(childStorageKey, key)
+// GetChildKeys provides a mock function with given fields: childStorageKey, prefix, blockHash
+func (_m *State) GetChildKeys(childStorageKey types.StorageKey, prefix types.StorageKey, blockHash types.Hash) ([]types.StorageKey, error) {
+	ret := _m.Called(childStorageKey, prefix, blockHash)
 
-	var r0 types.Hash
-	if rf, ok := ret.Get(0).(func(types.StorageKey, types.StorageKey) types.Hash); ok {
-		r0 = rf(childStorageKey, key)
+	var r0 []types.StorageKey
+	if rf, ok := ret.Get(0).(func(types.StorageKey, types.StorageKey, types.Hash) []types.StorageKey); ok {
+		r0 = rf(childStorageKey, prefix, blockHash)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(types.Hash)
+			r0 = ret.Get(0).([]types.StorageKey)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(types.StorageKey, types.StorageKey) error); ok {
-		r1 = rf(childStorageKey, key)
+	if rf, ok := ret.Get(1).(func(types.StorageKey, types.StorageKey, types.Hash) error); ok {
+		r1 = rf(childStorageKey, prefix, blockHash)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -126,20 +131,22 @@ func (_m *State) GetChildStorageHashLatest(childStorageKey types.StorageKey, key
 	return r0, r1
 }
 
-// GetChildStorageLatest provides a mock function with given fields: childStorageKey, key, target
-func (_m *State) GetChildStorageLatest(childStorageKey types.StorageKey, key types.StorageKey, target interface{}) (bool, error) {
-	ret := _m.Called(childStorageKey, key, target)
+// GetChildKeysContext provides a mock function with given fields: ctx, childStorageKey, prefix, blockHash
+func (_m *State) GetChildKeysContext(ctx context.Context, childStorageKey types.StorageKey, prefix types.StorageKey, blockHash types.Hash) ([]types.StorageKey, error) {
+	ret := _m.Called(ctx, childStorageKey, prefix, blockHash)
 
-	var r0 bool
-	if rf, ok := ret.Get(0).(func(types.StorageKey, types.StorageKey, interface{}) bool); ok {
-		r0 = rf(childStorageKey, key, target)
+	var r0 []types.StorageKey
+	if rf, ok := ret.Get(0).(func(context.Context, types.StorageKey, types.StorageKey, types.Hash) []types.StorageKey); ok {
+		r0 = rf(ctx, childStorageKey, prefix, blockHash)
 	} else {
-		r0 = ret.Get(0).(bool)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.StorageKey)
+		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(types.StorageKey, types.StorageKey, interface{}) error); ok {
-		r1 = rf(childStorageKey, key, target)
+	if rf, ok := ret.Get(1).(func(context.Context, types.StorageKey, types.StorageKey, types.Hash) error); ok {
+		r1 = rf(ctx, childStorageKey, prefix, blockHash)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -147,22 +154,22 @@ func (_m *State) GetChildStorageLatest(childStorageKey types.StorageKey, key typ
 	return r0, r1
 }
 
-// GetChildStorageRaw provides a mock function with given fields: childStorageKey, key, blockHash
-func (_m *State) GetChildStorageRaw(childStorageKey types.StorageKey, key types.StorageKey, blockHash types.Hash) (*types.StorageDataRaw, error) {
-	ret := _m.Called(childStorageKey, key, blockHash)
+// GetChildKeysLatest provides a mock function with given fields: childStorageKey, prefix
+func (_m *State) GetChildKeysLatest(childStorageKey types.StorageKey, prefix types.StorageKey) ([]types.StorageKey, error) {
+	ret := _m.Called(childStorageKey, prefix)
 
-	var r0 *types.StorageDataRaw
-	if rf, ok := ret.Get(0).(func(types.StorageKey, types.StorageKey, types.Hash) *types.StorageDataRaw); ok {
-		r0 = rf(childStorageKey, key, blockHash)
+	var r0 []types.StorageKey
+	if rf, ok := ret.Get(0).(func(types.StorageKey, types.StorageKey) []types.StorageKey); ok {
+		r0 = rf(childStorageKey, prefix)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.StorageDataRaw)
+			r0 = ret.Get(0).([]types.StorageKey)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(types.StorageKey, types.StorageKey, types.Hash) error); ok {
-		r1 = rf(childStorageKey, key, blockHash)
+	if rf, ok := ret.Get(1).(func(types.StorageKey, types.StorageKey) error); ok {
+		r1 = rf(childStorageKey, prefix)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -170,22 +177,22 @@ func (_m *State) GetChildStorageRaw(childStorageKey types.StorageKey, key types.
 	return r0, r1
 }
 
-// GetChildStorageRawLatest provides a mock function with given fields: childStorageKey, key
-func (_m *State) GetChildStorageRawLatest(childStorageKey types.StorageKey, key types.StorageKey) (*types.StorageDataRaw, error) {
-	ret := _m.Called(childStorageKey, key)
+// GetChildKeysLatestContext provides a mock function with given fields: ctx, childStorageKey, prefix
+func (_m *State) GetChildKeysLatestContext(ctx context.Context, childStorageKey types.StorageKey, prefix types.StorageKey) ([]types.StorageKey, error) {
+	ret := _m.Called(ctx, childStorageKey, prefix)
 
-	var r0 *types.StorageDataRaw
-	if rf, ok := ret.Get(0).(func(types.StorageKey, types.StorageKey) *types.StorageDataRaw); ok {
-		r0 = rf(childStorageKey, key)
+	var r0 []types.StorageKey
+	if rf, ok := ret.Get(0).(func(context.Context, types.StorageKey, types.StorageKey) []types.StorageKey); ok {
+		r0 = rf(ctx, childStorageKey, prefix)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.StorageDataRaw)
+			r0 = ret.Get(0).([]types.StorageKey)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(types.StorageKey, types.StorageKey) error); ok {
-		r1 = rf(childStorageKey, key)
+	if rf, ok := ret.Get(1).(func(context.Context, types.StorageKey, types.StorageKey) error); ok {
+		r1 = rf(ctx, childStorageKey, prefix)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -193,20 +200,22 @@ func (_m *State) GetChildStorageRawLatest(childStorageKey types.StorageKey, key
 	return r0, r1
 }
 
-// GetChildStorageSize provides a mock function with given fields: childStorageKey, key, blockHash
-func (_m *State) GetChildStorageSize(childStorageKey types.StorageKey, key types.StorageKey, blockHash types.Hash) (types.U64, error) {
-	ret := _m.Called(childStorageKey, key, blockHash)
+// GetChildKeysPaged provides a mock function with given fields: childStorageKey, prefix, count, startKey, blockHash
+func (_m *State) GetChildKeysPaged(childStorageKey types.StorageKey, prefix types.StorageKey, count uint32, startKey *types.StorageKey, blockHash types.Hash) ([]types.StorageKey, error) {
+	ret := _m.Called(childStorageKey, prefix, count, startKey, blockHash)
 
-	var r0 types.U64
-	if rf, ok := ret.Get(0).(func(types.StorageKey, types.StorageKey, types.Hash) types.U64); ok {
-		r0 = rf(childStorageKey, key, blockHash)
+	var r0 []types.StorageKey
+	if rf, ok := ret.Get(0).(func(types.StorageKey, types.StorageKey, uint32, *types.StorageKey, types.Hash) []types.StorageKey); ok {
+		r0 = rf(childStorageKey, prefix, count, startKey, blockHash)
 	} else {
-		r0 = ret.Get(0).(types.U64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.StorageKey)
+		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(types.StorageKey, types.StorageKey, types.Hash) error); ok {
-		r1 = rf(childStorageKey, key, blockHash)
+	if rf, ok := ret.Get(1).(func(types.StorageKey, types.StorageKey, uint32, *types.StorageKey, types.Hash) error); ok {
+		r1 = rf(childStorageKey, prefix, count, startKey, blockHash)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -214,20 +223,22 @@ func (_m *State) GetChildStorageSize(childStorageKey types.StorageKey, key types
 	return r0, r1
 }
 
-// GetChildStorageSizeLatest provides a mock function with given fields: childStorageKey, key
-func (_m *State) GetChildStorageSizeLatest(childStorageKey types.StorageKey, key types.StorageKey) (types.U64, error) {
-	ret := _m.Called(childStorageKey, key)
+// GetChildKeysPagedContext provides a mock function with given fields: ctx, childStorageKey, prefix, count, startKey, blockHash
+func (_m *State) GetChildKeysPagedContext(ctx context.Context, childStorageKey types.StorageKey, prefix types.StorageKey, count uint32, startKey *types.StorageKey, blockHash types.Hash) ([]types.StorageKey, error) {
+	ret := _m.Called(ctx, childStorageKey, prefix, count, startKey, blockHash)
 
-	var r0 types.U64
-	if rf, ok := ret.Get(0).(func(types.StorageKey, types.StorageKey) types.U64); ok {
-		r0 = rf(childStorageKey, key)
+	var r0 []types.StorageKey
+	if rf, ok := ret.Get(0).(func(context.Context, types.StorageKey, types.StorageKey, uint32, *types.StorageKey, types.Hash) []types.StorageKey); ok {
+		r0 = rf(ctx, childStorageKey, prefix, count, startKey, blockHash)
 	} else {
-		r0 = ret.Get(0).(types.U64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.StorageKey)
+		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(types.StorageKey, types.StorageKey) error); ok {
-		r1 = rf(childStorageKey, key)
+	if rf, ok := ret.Get(1).(func(context.Context, types.StorageKey, types.StorageKey, uint32, *types.StorageKey, types.Hash) error); ok {
+		r1 = rf(ctx, childStorageKey, prefix, count, startKey, blockHash)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -235,13 +246,13 @@ func (_m *State) GetChildStorageSizeLatest(childStorageKey types.StorageKey, key
 	return r0, r1
 }
 
-// GetKeys provides a mock function with given fields: prefix, blockHash
-func (_m *State) GetKeys(prefix types.StorageKey, blockHash types.Hash) ([]types.StorageKey, error) {
-	ret := _m.Called(prefix, blockHash)
+// GetChildKeysPagedLatest provides a mock function with given fields: childStorageKey, prefix, count, startKey
+func (_m *State) GetChildKeysPagedLatest(childStorageKey types.StorageKey, prefix types.StorageKey, count uint32, startKey *types.StorageKey) ([]types.StorageKey, error) {
+	ret := _m.Called(childStorageKey, prefix, count, startKey)
 
 	var r0 []types.StorageKey
-	if rf, ok := ret.Get(0).(func(types.StorageKey, types.Hash) []types.StorageKey); ok {
-		r0 = rf(prefix, blockHash)
+	if rf, ok := ret.Get(0).(func(types.StorageKey, types.StorageKey, uint32, *types.StorageKey) []types.StorageKey); ok {
+		r0 = rf(childStorageKey, prefix, count, startKey)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]types.StorageKey)
@@ -249,8 +260,8 @@ func (_m *State) GetKeys(prefix types.StorageKey, blockHash types.Hash) ([]types
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(types.StorageKey, types.Hash) error); ok {
-		r1 = rf(prefix, blockHash)
+	if rf, ok := ret.Get(1).(func(types.StorageKey, types.StorageKey, uint32, *types.StorageKey) error); ok {
+		r1 = rf(childStorageKey, prefix, count, startKey)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -258,13 +269,13 @@ func (_m *State) GetKeys(prefix types.StorageKey, blockHash types.Hash) ([]types
 	return r0, r1
 }
 
-// GetKeysLatest provides a mock function with given fields: prefix
-func (_m *State) GetKeysLatest(prefix types.StorageKey) ([]types.StorageKey, error) {
-	ret := _m.Called(prefix)
+// GetChildKeysPagedLatestContext provides a mock function with given fields: ctx, childStorageKey, prefix, count, startKey
+func (_m *State) GetChildKeysPagedLatestContext(ctx context.Context, childStorageKey types.StorageKey, prefix types.StorageKey, count uint32, startKey *types.StorageKey) ([]types.StorageKey, error) {
+	ret := _m.Called(ctx, childStorageKey, prefix, count, startKey)
 
 	var r0 []types.StorageKey
-	if rf, ok := ret.Get(0).(func(types.StorageKey) []types.StorageKey); ok {
-		r0 = rf(prefix)
+	if rf, ok := ret.Get(0).(func(context.Context, types.StorageKey, types.StorageKey, uint32, *types.StorageKey) []types.StorageKey); ok {
+		r0 = rf(ctx, childStorageKey, prefix, count, startKey)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]types.StorageKey)
@@ -272,8 +283,8 @@ func (_m *State) GetKeysLatest(prefix types.StorageKey) ([]types.StorageKey, err
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(types.StorageKey) error); ok {
-		r1 = rf(prefix)
+	if rf, ok := ret.Get(1).(func(context.Context, types.StorageKey, types.StorageKey, uint32, *types.StorageKey) error); ok {
+		r1 = rf(ctx, childStorageKey, prefix, count, startKey)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -281,22 +292,22 @@ func (_m *State) GetKeysLatest(prefix types.StorageKey) ([]types.StorageKey, err
 	return r0, r1
 }
 
-// GetMetadata provides a mock function with given fields: blockHash
-func (_m *State) GetMetadata(blockHash types.Hash) (*types.Metadata, error) {
-	ret := _m.Called(blockHash)
+// GetChildReadProof provides a mock function with given fields: childStorageKey, keys, blockHash
+func (_m *State) GetChildReadProof(childStorageKey types.StorageKey, keys []types.StorageKey, blockHash types.Hash) (*types.ReadProof, error) {
+	ret := _m.Called(childStorageKey, keys, blockHash)
 
-	var r0 *types.Metadata
-	if rf, ok := ret.Get(0).(func(types.Hash) *types.Metadata); ok {
-		r0 = rf(blockHash)
+	var r0 *types.ReadProof
+	if rf, ok := ret.Get(0).(func(types.StorageKey, []types.StorageKey, types.Hash) *types.ReadProof); ok {
+		r0 = rf(childStorageKey, keys, blockHash)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.Metadata)
+			r0 = ret.Get(0).(*types.ReadProof)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(types.Hash) error); ok {
-		r1 = rf(blockHash)
+	if rf, ok := ret.Get(1).(func(types.StorageKey, []types.StorageKey, types.Hash) error); ok {
+		r1 = rf(childStorageKey, keys, blockHash)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -304,22 +315,22 @@ func (_m *State) GetMetadata(blockHash types.Hash) (*types.Metadata, error) {
 	return r0, r1
 }
 
-// GetMetadataLatest provides a mock function with given fields:
-func (_m *State) GetMetadataLatest() (*types.Metadata, error) {
-	ret := _m.Called()
+// GetChildReadProofContext provides a mock function with given fields: ctx, childStorageKey, keys, blockHash
+func (_m *State) GetChildReadProofContext(ctx context.Context, childStorageKey types.StorageKey, keys []types.StorageKey, blockHash types.Hash) (*types.ReadProof, error) {
+	ret := _m.Called(ctx, childStorageKey, keys, blockHash)
 
-	var r0 *types.Metadata
-	if rf, ok := ret.Get(0).(func() *types.Metadata); ok {
-		r0 = rf()
+	var r0 *types.ReadProof
+	if rf, ok := ret.Get(0).(func(context.Context, types.StorageKey, []types.StorageKey, types.Hash) *types.ReadProof); ok {
+		r0 = rf(ctx, childStorageKey, keys, blockHash)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.Metadata)
+			r0 = ret.Get(0).(*types.ReadProof)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context, types.StorageKey, []types.StorageKey, types.Hash) error); ok {
+		r1 = rf(ctx, childStorageKey, keys, blockHash)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -327,22 +338,22 @@ func (_m *State) GetMetadataLatest() (*types.Metadata, error) {
 	return r0, r1
 }
 
-// GetRuntimeVersion provides a mock function with given fields: blockHash
-func (_m *State) GetRuntimeVersion(blockHash types.Hash) (*types.RuntimeVersion, error) {
-	ret := _m.Called(blockHash)
+// GetChildReadProofLatest provides a mock function with given fields: childStorageKey, keys
+func (_m *State) GetChildReadProofLatest(childStorageKey types.StorageKey, keys []types.StorageKey) (*types.ReadProof, error) {
+	ret := _m.Called(childStorageKey, keys)
 
-	var r0 *types.RuntimeVersion
-	if rf, ok := ret.Get(0).(func(types.Hash) *types.RuntimeVersion); ok {
-		r0 = rf(blockHash)
+	var r0 *types.ReadProof
+	if rf, ok := ret.Get(0).(func(types.StorageKey, []types.StorageKey) *types.ReadProof); ok {
+		r0 = rf(childStorageKey, keys)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.RuntimeVersion)
+			r0 = ret.Get(0).(*types.ReadProof)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(types.Hash) error); ok {
-		r1 = rf(blockHash)
+	if rf, ok := ret.Get(1).(func(types.StorageKey, []types.StorageKey) error); ok {
+		r1 = rf(childStorageKey, keys)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -350,22 +361,22 @@ func (_m *State) GetRuntimeVersion(blockHash types.Hash) (*types.RuntimeVersion,
 	return r0, r1
 }
 
-// GetRuntimeVersionLatest provides a mock function with given fields:
-func (_m *State) GetRuntimeVersionLatest() (*types.RuntimeVersion, error) {
-	ret := _m.Called()
+// GetChildReadProofLatestContext provides a mock function with given fields: ctx, childStorageKey, keys
+func (_m *State) GetChildReadProofLatestContext(ctx context.Context, childStorageKey types.StorageKey, keys []types.StorageKey) (*types.ReadProof, error) {
+	ret := _m.Called(ctx, childStorageKey, keys)
 
-	var r0 *types.RuntimeVersion
-	if rf, ok := ret.Get(0).(func() *types.RuntimeVersion); ok {
-		r0 = rf()
+	var r0 *types.ReadProof
+	if rf, ok := ret.Get(0).(func(context.Context, types.StorageKey, []types.StorageKey) *types.ReadProof); ok {
+		r0 = rf(ctx, childStorageKey, keys)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.RuntimeVersion)
+			r0 = ret.Get(0).(*types.ReadProof)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context, types.StorageKey, []types.StorageKey) error); ok {
+		r1 = rf(ctx, childStorageKey, keys)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -373,20 +384,20 @@ func (_m *State) GetRuntimeVersionLatest() (*types.RuntimeVersion, error) {
 	return r0, r1
 }
 
-// GetStorage provides a mock function with given fields: key, target, blockHash
-func (_m *State) GetStorage(key types.StorageKey, target interface{}, blockHash types.Hash) (bool, error) {
-	ret := _m.Called(key, target, blockHash)
+// GetChildStorage provides a mock function with given fields: childStorageKey, key, target, blockHash
+func (_m *State) GetChildStorage(childStorageKey types.StorageKey, key types.StorageKey, target interface{}, blockHash types.Hash) (bool, error) {
+	ret := _m.Called(childStorageKey, key, target, blockHash)
 
 	var r0 bool
-	if rf, ok := ret.Get(0).(func(types.StorageKey, interface{}, types.Hash) bool); ok {
-		r0 = rf(key, target, blockHash)
+	if rf, ok := ret.Get(0).(func(types.StorageKey, types.StorageKey, interface{}, types.Hash) bool); ok {
+		r0 = rf(childStorageKey, key, target, blockHash)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(types.StorageKey, interface{}, types.Hash) error); ok {
-		r1 = rf(key, target, blockHash)
+	if rf, ok := ret.Get(1).(func(types.StorageKey, types.StorageKey, interface{}, types.Hash) error); ok {
+		r1 = rf(childStorageKey, key, target, blockHash)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -394,22 +405,20 @@ func (_m *State) GetStorage(key types.StorageKey, target interface{}, blockHash
 	return r0, r1
 }
 
-// GetStorageHash provides a mock function with given fields: key, blockHash
-func (_m *State) GetStorageHash(key types.StorageKey, blockHash types.Hash) (types.Hash, error) {
-	ret := _m.Called(key, blockHash)
+// GetChildStorageContext provides a mock function with given fields: ctx, childStorageKey, key, target, blockHash
+func (_m *State) GetChildStorageContext(ctx context.Context, childStorageKey types.StorageKey, key types.StorageKey, target interface{}, blockHash types.Hash) (bool, error) {
+	ret := _m.Called(ctx, childStorageKey, key, target, blockHash)
 
-	var r0 types.Hash
-	if rf, ok := ret.Get(0).(func(types.StorageKey, types.Hash) types.Hash); ok {
-		r0 = rf(key, blockHash)
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, types.StorageKey, types.StorageKey, interface{}, types.Hash) bool); ok {
+		r0 = rf(ctx, childStorageKey, key, target, blockHash)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(types.Hash)
-		}
+		r0 = ret.Get(0).(bool)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(types.StorageKey, types.Hash) error); ok {
-		r1 = rf(key, blockHash)
+	if rf, ok := ret.Get(1).(func(context.Context, types.StorageKey, types.StorageKey, interface{}, types.Hash) error); ok {
+		r1 = rf(ctx, childStorageKey, key, target, blockHash)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -417,13 +426,13 @@ func (_m *State) GetStorageHash(key types.StorageKey, blockHash types.Hash) (typ
 	return r0, r1
 }
 
-// GetStorageHashLatest provides a mock function with given fields: key
-func (_m *State) GetStorageHashLatest(key types.StorageKey) (types.Hash, error) {
-	ret := _m.Called(key)
+// GetChildStorageHash provides a mock function with given fields: childStorageKey, key, blockHash
+func (_m *State) GetChildStorageHash(childStorageKey types.StorageKey, key types.StorageKey, blockHash types.Hash) (types.Hash, error) {
+	ret := _m.Called(childStorageKey, key, blockHash)
 
 	var r0 types.Hash
-	if rf, ok := ret.Get(0).(func(types.StorageKey) types.Hash); ok {
-		r0 = rf(key)
+	if rf, ok := ret.Get(0).(func(types.StorageKey, types.StorageKey, types.Hash) types.Hash); ok {
+		r0 = rf(childStorageKey, key, blockHash)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(types.Hash)
@@ -431,8 +440,8 @@ func (_m *State) GetStorageHashLatest(key types.StorageKey) (types.Hash, error)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(types.StorageKey) error); ok {
-		r1 = rf(key)
+	if rf, ok := ret.Get(1).(func(types.StorageKey, types.StorageKey, types.Hash) error); ok {
+		r1 = rf(childStorageKey, key, blockHash)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -440,20 +449,22 @@ func (_m *State) GetStorageHashLatest(key types.StorageKey) (types.Hash, error)
 	return r0, r1
 }
 
-// GetStorageLatest provides a mock function with given fields: key, target
-func (_m *State) GetStorageLatest(key types.StorageKey, target interface{}) (bool, error) {
-	ret := _m.Called(key, target)
+// GetChildStorageHashContext provides a mock function with given fields: ctx, childStorageKey, key, blockHash
+func (_m *State) GetChildStorageHashContext(ctx context.Context, childStorageKey types.StorageKey, key types.StorageKey, blockHash types.Hash) (types.Hash, error) {
+	ret := _m.Called(ctx, childStorageKey, key, blockHash)
 
-	var r0 bool
-	if rf, ok := ret.Get(0).(func(types.StorageKey, interface{}) bool); ok {
-		r0 = rf(key, target)
+	var r0 types.Hash
+	if rf, ok := ret.Get(0).(func(context.Context, types.StorageKey, types.StorageKey, types.Hash) types.Hash); ok {
+		r0 = rf(ctx, childStorageKey, key, blockHash)
 	} else {
-		r0 = ret.Get(0).(bool)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Hash)
+		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(types.StorageKey, interface{}) error); ok {
-		r1 = rf(key, target)
+	if rf, ok := ret.Get(1).(func(context.Context, types.StorageKey, types.StorageKey, types.Hash) error); ok {
+		r1 = rf(ctx, childStorageKey, key, blockHash)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -461,22 +472,22 @@ func (_m *State) GetStorageLatest(key types.StorageKey, target interface{}) (boo
 	return r0, r1
 }
 
-// GetStorageRaw provides a mock function with given fields: key, blockHash
-func (_m *State) GetStorageRaw(key types.StorageKey, blockHash types.Hash) (*types.StorageDataRaw, error) {
-	ret := _m.Called(key, blockHash)
+// GetChildStorageHashLatest provides a mock function with given fields: childStorageKey, key
+func (_m *State) GetChildStorageHashLatest(childStorageKey types.StorageKey, key types.StorageKey) (types.Hash, error) {
+	ret := _m.Called(childStorageKey, key)
 
-	var r0 *types.StorageDataRaw
-	if rf, ok := ret.Get(0).(func(types.StorageKey, types.Hash) *types.StorageDataRaw); ok {
-		r0 = rf(key, blockHash)
+	var r0 types.Hash
+	if rf, ok := ret.Get(0).(func(types.StorageKey, types.StorageKey) types.Hash); ok {
+		r0 = rf(childStorageKey, key)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.StorageDataRaw)
+			r0 = ret.Get(0).(types.Hash)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(types.StorageKey, types.Hash) error); ok {
-		r1 = rf(key, blockHash)
+	if rf, ok := ret.Get(1).(func(types.StorageKey, types.StorageKey) error); ok {
+		r1 = rf(childStorageKey, key)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -484,27 +495,1193 @@ func (_m *State) GetStorageRaw(key types.StorageKey, blockHash types.Hash) (*typ
 	return r0, r1
 }
 
-// GetStorageRawLatest provides a mock function with given fields: key
-func (_m *State) GetStorageRawLatest(key types.StorageKey) (*types.StorageDataRaw, error) {
-	ret := _m.Called(key)
+// GetChildStorageHashLatestContext provides a mock function with given fields: ctx, childStorageKey, key
+func (_m *State) GetChildStorageHashLatestContext(ctx context.Context, childStorageKey types.StorageKey, key types.StorageKey) (types.Hash, error) {
+	ret := _m.Called(ctx, childStorageKey, key)
 
-	var r0 *types.StorageDataRaw
-	if rf, ok := ret.Get(0).(func(types.StorageKey) *types.StorageDataRaw); ok {
-		r0 = rf(key)
+	var r0 types.Hash
+	if rf, ok := ret.Get(0).(func(context.Context, types.StorageKey, types.StorageKey) types.Hash); ok {
+		r0 = rf(ctx, childStorageKey, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Hash)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.StorageKey, types.StorageKey) error); ok {
+		r1 = rf(ctx, childStorageKey, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetChildStorageLatest provides a mock function with given fields: childStorageKey, key, target
+func (_m *State) GetChildStorageLatest(childStorageKey types.StorageKey, key types.StorageKey, target interface{}) (bool, error) {
+	ret := _m.Called(childStorageKey, key, target)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(types.StorageKey, types.StorageKey, interface{}) bool); ok {
+		r0 = rf(childStorageKey, key, target)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.StorageKey, types.StorageKey, interface{}) error); ok {
+		r1 = rf(childStorageKey, key, target)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetChildStorageLatestContext provides a mock function with given fields: ctx, childStorageKey, key, target
+func (_m *State) GetChildStorageLatestContext(ctx context.Context, childStorageKey types.StorageKey, key types.StorageKey, target interface{}) (bool, error) {
+	ret := _m.Called(ctx, childStorageKey, key, target)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, types.StorageKey, types.StorageKey, interface{}) bool); ok {
+		r0 = rf(ctx, childStorageKey, key, target)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.StorageKey, types.StorageKey, interface{}) error); ok {
+		r1 = rf(ctx, childStorageKey, key, target)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetChildStorageRaw provides a mock function with given fields: childStorageKey, key, blockHash
+func (_m *State) GetChildStorageRaw(childStorageKey types.StorageKey, key types.StorageKey, blockHash types.Hash) (*types.StorageDataRaw, error) {
+	ret := _m.Called(childStorageKey, key, blockHash)
+
+	var r0 *types.StorageDataRaw
+	if rf, ok := ret.Get(0).(func(types.StorageKey, types.StorageKey, types.Hash) *types.StorageDataRaw); ok {
+		r0 = rf(childStorageKey, key, blockHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.StorageDataRaw)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.StorageKey, types.StorageKey, types.Hash) error); ok {
+		r1 = rf(childStorageKey, key, blockHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetChildStorageRawContext provides a mock function with given fields: ctx, childStorageKey, key, blockHash
+func (_m *State) GetChildStorageRawContext(ctx context.Context, childStorageKey types.StorageKey, key types.StorageKey, blockHash types.Hash) (*types.StorageDataRaw, error) {
+	ret := _m.Called(ctx, childStorageKey, key, blockHash)
+
+	var r0 *types.StorageDataRaw
+	if rf, ok := ret.Get(0).(func(context.Context, types.StorageKey, types.StorageKey, types.Hash) *types.StorageDataRaw); ok {
+		r0 = rf(ctx, childStorageKey, key, blockHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.StorageDataRaw)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.StorageKey, types.StorageKey, types.Hash) error); ok {
+		r1 = rf(ctx, childStorageKey, key, blockHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetChildStorageRawLatest provides a mock function with given fields: childStorageKey, key
+func (_m *State) GetChildStorageRawLatest(childStorageKey types.StorageKey, key types.StorageKey) (*types.StorageDataRaw, error) {
+	ret := _m.Called(childStorageKey, key)
+
+	var r0 *types.StorageDataRaw
+	if rf, ok := ret.Get(0).(func(types.StorageKey, types.StorageKey) *types.StorageDataRaw); ok {
+		r0 = rf(childStorageKey, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.StorageDataRaw)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.StorageKey, types.StorageKey) error); ok {
+		r1 = rf(childStorageKey, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetChildStorageRawLatestContext provides a mock function with given fields: ctx, childStorageKey, key
+func (_m *State) GetChildStorageRawLatestContext(ctx context.Context, childStorageKey types.StorageKey, key types.StorageKey) (*types.StorageDataRaw, error) {
+	ret := _m.Called(ctx, childStorageKey, key)
+
+	var r0 *types.StorageDataRaw
+	if rf, ok := ret.Get(0).(func(context.Context, types.StorageKey, types.StorageKey) *types.StorageDataRaw); ok {
+		r0 = rf(ctx, childStorageKey, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.StorageDataRaw)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.StorageKey, types.StorageKey) error); ok {
+		r1 = rf(ctx, childStorageKey, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetChildStorageSize provides a mock function with given fields: childStorageKey, key, blockHash
+func (_m *State) GetChildStorageSize(childStorageKey types.StorageKey, key types.StorageKey, blockHash types.Hash) (types.U64, error) {
+	ret := _m.Called(childStorageKey, key, blockHash)
+
+	var r0 types.U64
+	if rf, ok := ret.Get(0).(func(types.StorageKey, types.StorageKey, types.Hash) types.U64); ok {
+		r0 = rf(childStorageKey, key, blockHash)
+	} else {
+		r0 = ret.Get(0).(types.U64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.StorageKey, types.StorageKey, types.Hash) error); ok {
+		r1 = rf(childStorageKey, key, blockHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetChildStorageSizeContext provides a mock function with given fields: ctx, childStorageKey, key, blockHash
+func (_m *State) GetChildStorageSizeContext(ctx context.Context, childStorageKey types.StorageKey, key types.StorageKey, blockHash types.Hash) (types.U64, error) {
+	ret := _m.Called(ctx, childStorageKey, key, blockHash)
+
+	var r0 types.U64
+	if rf, ok := ret.Get(0).(func(context.Context, types.StorageKey, types.StorageKey, types.Hash) types.U64); ok {
+		r0 = rf(ctx, childStorageKey, key, blockHash)
+	} else {
+		r0 = ret.Get(0).(types.U64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.StorageKey, types.StorageKey, types.Hash) error); ok {
+		r1 = rf(ctx, childStorageKey, key, blockHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetChildStorageSizeLatest provides a mock function with given fields: childStorageKey, key
+func (_m *State) GetChildStorageSizeLatest(childStorageKey types.StorageKey, key types.StorageKey) (types.U64, error) {
+	ret := _m.Called(childStorageKey, key)
+
+	var r0 types.U64
+	if rf, ok := ret.Get(0).(func(types.StorageKey, types.StorageKey) types.U64); ok {
+		r0 = rf(childStorageKey, key)
+	} else {
+		r0 = ret.Get(0).(types.U64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.StorageKey, types.StorageKey) error); ok {
+		r1 = rf(childStorageKey, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetChildStorageSizeLatestContext provides a mock function with given fields: ctx, childStorageKey, key
+func (_m *State) GetChildStorageSizeLatestContext(ctx context.Context, childStorageKey types.StorageKey, key types.StorageKey) (types.U64, error) {
+	ret := _m.Called(ctx, childStorageKey, key)
+
+	var r0 types.U64
+	if rf, ok := ret.Get(0).(func(context.Context, types.StorageKey, types.StorageKey) types.U64); ok {
+		r0 = rf(ctx, childStorageKey, key)
+	} else {
+		r0 = ret.Get(0).(types.U64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.StorageKey, types.StorageKey) error); ok {
+		r1 = rf(ctx, childStorageKey, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetKeys provides a mock function with given fields: prefix, blockHash
+func (_m *State) GetKeys(prefix types.StorageKey, blockHash types.Hash) ([]types.StorageKey, error) {
+	ret := _m.Called(prefix, blockHash)
+
+	var r0 []types.StorageKey
+	if rf, ok := ret.Get(0).(func(types.StorageKey, types.Hash) []types.StorageKey); ok {
+		r0 = rf(prefix, blockHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.StorageKey)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.StorageKey, types.Hash) error); ok {
+		r1 = rf(prefix, blockHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetKeysContext provides a mock function with given fields: ctx, prefix, blockHash
+func (_m *State) GetKeysContext(ctx context.Context, prefix types.StorageKey, blockHash types.Hash) ([]types.StorageKey, error) {
+	ret := _m.Called(ctx, prefix, blockHash)
+
+	var r0 []types.StorageKey
+	if rf, ok := ret.Get(0).(func(context.Context, types.StorageKey, types.Hash) []types.StorageKey); ok {
+		r0 = rf(ctx, prefix, blockHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.StorageKey)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.StorageKey, types.Hash) error); ok {
+		r1 = rf(ctx, prefix, blockHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetKeysLatest provides a mock function with given fields: prefix
+func (_m *State) GetKeysLatest(prefix types.StorageKey) ([]types.StorageKey, error) {
+	ret := _m.Called(prefix)
+
+	var r0 []types.StorageKey
+	if rf, ok := ret.Get(0).(func(types.StorageKey) []types.StorageKey); ok {
+		r0 = rf(prefix)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.StorageKey)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.StorageKey) error); ok {
+		r1 = rf(prefix)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetKeysLatestContext provides a mock function with given fields: ctx, prefix
+func (_m *State) GetKeysLatestContext(ctx context.Context, prefix types.StorageKey) ([]types.StorageKey, error) {
+	ret := _m.Called(ctx, prefix)
+
+	var r0 []types.StorageKey
+	if rf, ok := ret.Get(0).(func(context.Context, types.StorageKey) []types.StorageKey); ok {
+		r0 = rf(ctx, prefix)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.StorageKey)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.StorageKey) error); ok {
+		r1 = rf(ctx, prefix)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetKeysPaged provides a mock function with given fields: prefix, count, startKey, blockHash
+func (_m *State) GetKeysPaged(prefix types.StorageKey, count uint32, startKey *types.StorageKey, blockHash types.Hash) ([]types.StorageKey, error) {
+	ret := _m.Called(prefix, count, startKey, blockHash)
+
+	var r0 []types.StorageKey
+	if rf, ok := ret.Get(0).(func(types.StorageKey, uint32, *types.StorageKey, types.Hash) []types.StorageKey); ok {
+		r0 = rf(prefix, count, startKey, blockHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.StorageKey)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.StorageKey, uint32, *types.StorageKey, types.Hash) error); ok {
+		r1 = rf(prefix, count, startKey, blockHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetKeysPagedContext provides a mock function with given fields: ctx, prefix, count, startKey, blockHash
+func (_m *State) GetKeysPagedContext(ctx context.Context, prefix types.StorageKey, count uint32, startKey *types.StorageKey, blockHash types.Hash) ([]types.StorageKey, error) {
+	ret := _m.Called(ctx, prefix, count, startKey, blockHash)
+
+	var r0 []types.StorageKey
+	if rf, ok := ret.Get(0).(func(context.Context, types.StorageKey, uint32, *types.StorageKey, types.Hash) []types.StorageKey); ok {
+		r0 = rf(ctx, prefix, count, startKey, blockHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.StorageKey)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.StorageKey, uint32, *types.StorageKey, types.Hash) error); ok {
+		r1 = rf(ctx, prefix, count, startKey, blockHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetKeysPagedLatest provides a mock function with given fields: prefix, count, startKey
+func (_m *State) GetKeysPagedLatest(prefix types.StorageKey, count uint32, startKey *types.StorageKey) ([]types.StorageKey, error) {
+	ret := _m.Called(prefix, count, startKey)
+
+	var r0 []types.StorageKey
+	if rf, ok := ret.Get(0).(func(types.StorageKey, uint32, *types.StorageKey) []types.StorageKey); ok {
+		r0 = rf(prefix, count, startKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.StorageKey)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.StorageKey, uint32, *types.StorageKey) error); ok {
+		r1 = rf(prefix, count, startKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetKeysPagedLatestContext provides a mock function with given fields: ctx, prefix, count, startKey
+func (_m *State) GetKeysPagedLatestContext(ctx context.Context, prefix types.StorageKey, count uint32, startKey *types.StorageKey) ([]types.StorageKey, error) {
+	ret := _m.Called(ctx, prefix, count, startKey)
+
+	var r0 []types.StorageKey
+	if rf, ok := ret.Get(0).(func(context.Context, types.StorageKey, uint32, *types.StorageKey) []types.StorageKey); ok {
+		r0 = rf(ctx, prefix, count, startKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.StorageKey)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.StorageKey, uint32, *types.StorageKey) error); ok {
+		r1 = rf(ctx, prefix, count, startKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMetadata provides a mock function with given fields: blockHash
+func (_m *State) GetMetadata(blockHash types.Hash) (*types.Metadata, error) {
+	ret := _m.Called(blockHash)
+
+	var r0 *types.Metadata
+	if rf, ok := ret.Get(0).(func(types.Hash) *types.Metadata); ok {
+		r0 = rf(blockHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Metadata)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.Hash) error); ok {
+		r1 = rf(blockHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMetadataContext provides a mock function with given fields: ctx, blockHash
+func (_m *State) GetMetadataContext(ctx context.Context, blockHash types.Hash) (*types.Metadata, error) {
+	ret := _m.Called(ctx, blockHash)
+
+	var r0 *types.Metadata
+	if rf, ok := ret.Get(0).(func(context.Context, types.Hash) *types.Metadata); ok {
+		r0 = rf(ctx, blockHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Metadata)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.Hash) error); ok {
+		r1 = rf(ctx, blockHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMetadataLatest provides a mock function with given fields:
+func (_m *State) GetMetadataLatest() (*types.Metadata, error) {
+	ret := _m.Called()
+
+	var r0 *types.Metadata
+	if rf, ok := ret.Get(0).(func() *types.Metadata); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Metadata)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMetadataLatestContext provides a mock function with given fields: ctx
+func (_m *State) GetMetadataLatestContext(ctx context.Context) (*types.Metadata, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *types.Metadata
+	if rf, ok := ret.Get(0).(func(context.Context) *types.Metadata); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Metadata)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReadProof provides a mock function with given fields: keys, blockHash
+func (_m *State) GetReadProof(keys []types.StorageKey, blockHash types.Hash) (*types.ReadProof, error) {
+	ret := _m.Called(keys, blockHash)
+
+	var r0 *types.ReadProof
+	if rf, ok := ret.Get(0).(func([]types.StorageKey, types.Hash) *types.ReadProof); ok {
+		r0 = rf(keys, blockHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReadProof)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]types.StorageKey, types.Hash) error); ok {
+		r1 = rf(keys, blockHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReadProofContext provides a mock function with given fields: ctx, keys, blockHash
+func (_m *State) GetReadProofContext(ctx context.Context, keys []types.StorageKey, blockHash types.Hash) (*types.ReadProof, error) {
+	ret := _m.Called(ctx, keys, blockHash)
+
+	var r0 *types.ReadProof
+	if rf, ok := ret.Get(0).(func(context.Context, []types.StorageKey, types.Hash) *types.ReadProof); ok {
+		r0 = rf(ctx, keys, blockHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReadProof)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []types.StorageKey, types.Hash) error); ok {
+		r1 = rf(ctx, keys, blockHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReadProofLatest provides a mock function with given fields: keys
+func (_m *State) GetReadProofLatest(keys []types.StorageKey) (*types.ReadProof, error) {
+	ret := _m.Called(keys)
+
+	var r0 *types.ReadProof
+	if rf, ok := ret.Get(0).(func([]types.StorageKey) *types.ReadProof); ok {
+		r0 = rf(keys)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReadProof)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]types.StorageKey) error); ok {
+		r1 = rf(keys)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReadProofLatestContext provides a mock function with given fields: ctx, keys
+func (_m *State) GetReadProofLatestContext(ctx context.Context, keys []types.StorageKey) (*types.ReadProof, error) {
+	ret := _m.Called(ctx, keys)
+
+	var r0 *types.ReadProof
+	if rf, ok := ret.Get(0).(func(context.Context, []types.StorageKey) *types.ReadProof); ok {
+		r0 = rf(ctx, keys)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReadProof)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []types.StorageKey) error); ok {
+		r1 = rf(ctx, keys)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRuntimeVersion provides a mock function with given fields: blockHash
+func (_m *State) GetRuntimeVersion(blockHash types.Hash) (*types.RuntimeVersion, error) {
+	ret := _m.Called(blockHash)
+
+	var r0 *types.RuntimeVersion
+	if rf, ok := ret.Get(0).(func(types.Hash) *types.RuntimeVersion); ok {
+		r0 = rf(blockHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.RuntimeVersion)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.Hash) error); ok {
+		r1 = rf(blockHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRuntimeVersionContext provides a mock function with given fields: ctx, blockHash
+func (_m *State) GetRuntimeVersionContext(ctx context.Context, blockHash types.Hash) (*types.RuntimeVersion, error) {
+	ret := _m.Called(ctx, blockHash)
+
+	var r0 *types.RuntimeVersion
+	if rf, ok := ret.Get(0).(func(context.Context, types.Hash) *types.RuntimeVersion); ok {
+		r0 = rf(ctx, blockHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.RuntimeVersion)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.Hash) error); ok {
+		r1 = rf(ctx, blockHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRuntimeVersionLatest provides a mock function with given fields:
+func (_m *State) GetRuntimeVersionLatest() (*types.RuntimeVersion, error) {
+	ret := _m.Called()
+
+	var r0 *types.RuntimeVersion
+	if rf, ok := ret.Get(0).(func() *types.RuntimeVersion); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.RuntimeVersion)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRuntimeVersionLatestContext provides a mock function with given fields: ctx
+func (_m *State) GetRuntimeVersionLatestContext(ctx context.Context) (*types.RuntimeVersion, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *types.RuntimeVersion
+	if rf, ok := ret.Get(0).(func(context.Context) *types.RuntimeVersion); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.RuntimeVersion)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStorage provides a mock function with given fields: key, target, blockHash
+func (_m *State) GetStorage(key types.StorageKey, target interface{}, blockHash types.Hash) (bool, error) {
+	ret := _m.Called(key, target, blockHash)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(types.StorageKey, interface{}, types.Hash) bool); ok {
+		r0 = rf(key, target, blockHash)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.StorageKey, interface{}, types.Hash) error); ok {
+		r1 = rf(key, target, blockHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStorageContext provides a mock function with given fields: ctx, key, target, blockHash
+func (_m *State) GetStorageContext(ctx context.Context, key types.StorageKey, target interface{}, blockHash types.Hash) (bool, error) {
+	ret := _m.Called(ctx, key, target, blockHash)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, types.StorageKey, interface{}, types.Hash) bool); ok {
+		r0 = rf(ctx, key, target, blockHash)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.StorageKey, interface{}, types.Hash) error); ok {
+		r1 = rf(ctx, key, target, blockHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStorageHash provides a mock function with given fields: key, blockHash
+func (_m *State) GetStorageHash(key types.StorageKey, blockHash types.Hash) (types.Hash, error) {
+	ret := _m.Called(key, blockHash)
+
+	var r0 types.Hash
+	if rf, ok := ret.Get(0).(func(types.StorageKey, types.Hash) types.Hash); ok {
+		r0 = rf(key, blockHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Hash)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.StorageKey, types.Hash) error); ok {
+		r1 = rf(key, blockHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStorageHashContext provides a mock function with given fields: ctx, key, blockHash
+func (_m *State) GetStorageHashContext(ctx context.Context, key types.StorageKey, blockHash types.Hash) (types.Hash, error) {
+	ret := _m.Called(ctx, key, blockHash)
+
+	var r0 types.Hash
+	if rf, ok := ret.Get(0).(func(context.Context, types.StorageKey, types.Hash) types.Hash); ok {
+		r0 = rf(ctx, key, blockHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Hash)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.StorageKey, types.Hash) error); ok {
+		r1 = rf(ctx, key, blockHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStorageHashLatest provides a mock function with given fields: key
+func (_m *State) GetStorageHashLatest(key types.StorageKey) (types.Hash, error) {
+	ret := _m.Called(key)
+
+	var r0 types.Hash
+	if rf, ok := ret.Get(0).(func(types.StorageKey) types.Hash); ok {
+		r0 = rf(key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Hash)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.StorageKey) error); ok {
+		r1 = rf(key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStorageHashLatestContext provides a mock function with given fields: ctx, key
+func (_m *State) GetStorageHashLatestContext(ctx context.Context, key types.StorageKey) (types.Hash, error) {
+	ret := _m.Called(ctx, key)
+
+	var r0 types.Hash
+	if rf, ok := ret.Get(0).(func(context.Context, types.StorageKey) types.Hash); ok {
+		r0 = rf(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Hash)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.StorageKey) error); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStorageLatest provides a mock function with given fields: key, target
+func (_m *State) GetStorageLatest(key types.StorageKey, target interface{}) (bool, error) {
+	ret := _m.Called(key, target)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(types.StorageKey, interface{}) bool); ok {
+		r0 = rf(key, target)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.StorageKey, interface{}) error); ok {
+		r1 = rf(key, target)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStorageLatestContext provides a mock function with given fields: ctx, key, target
+func (_m *State) GetStorageLatestContext(ctx context.Context, key types.StorageKey, target interface{}) (bool, error) {
+	ret := _m.Called(ctx, key, target)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, types.StorageKey, interface{}) bool); ok {
+		r0 = rf(ctx, key, target)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.StorageKey, interface{}) error); ok {
+		r1 = rf(ctx, key, target)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStoragePaged provides a mock function with given fields: prefix, count, startKey, blockHash
+func (_m *State) GetStoragePaged(prefix types.StorageKey, count uint32, startKey *types.StorageKey, blockHash types.Hash) ([]types.KeyValueOption, error) {
+	ret := _m.Called(prefix, count, startKey, blockHash)
+
+	var r0 []types.KeyValueOption
+	if rf, ok := ret.Get(0).(func(types.StorageKey, uint32, *types.StorageKey, types.Hash) []types.KeyValueOption); ok {
+		r0 = rf(prefix, count, startKey, blockHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.KeyValueOption)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.StorageKey, uint32, *types.StorageKey, types.Hash) error); ok {
+		r1 = rf(prefix, count, startKey, blockHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStoragePagedContext provides a mock function with given fields: ctx, prefix, count, startKey, blockHash
+func (_m *State) GetStoragePagedContext(ctx context.Context, prefix types.StorageKey, count uint32, startKey *types.StorageKey, blockHash types.Hash) ([]types.KeyValueOption, error) {
+	ret := _m.Called(ctx, prefix, count, startKey, blockHash)
+
+	var r0 []types.KeyValueOption
+	if rf, ok := ret.Get(0).(func(context.Context, types.StorageKey, uint32, *types.StorageKey, types.Hash) []types.KeyValueOption); ok {
+		r0 = rf(ctx, prefix, count, startKey, blockHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.KeyValueOption)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.StorageKey, uint32, *types.StorageKey, types.Hash) error); ok {
+		r1 = rf(ctx, prefix, count, startKey, blockHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStoragePagedLatest provides a mock function with given fields: prefix, count, startKey
+func (_m *State) GetStoragePagedLatest(prefix types.StorageKey, count uint32, startKey *types.StorageKey) ([]types.KeyValueOption, error) {
+	ret := _m.Called(prefix, count, startKey)
+
+	var r0 []types.KeyValueOption
+	if rf, ok := ret.Get(0).(func(types.StorageKey, uint32, *types.StorageKey) []types.KeyValueOption); ok {
+		r0 = rf(prefix, count, startKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.KeyValueOption)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.StorageKey, uint32, *types.StorageKey) error); ok {
+		r1 = rf(prefix, count, startKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStoragePagedLatestContext provides a mock function with given fields: ctx, prefix, count, startKey
+func (_m *State) GetStoragePagedLatestContext(ctx context.Context, prefix types.StorageKey, count uint32, startKey *types.StorageKey) ([]types.KeyValueOption, error) {
+	ret := _m.Called(ctx, prefix, count, startKey)
+
+	var r0 []types.KeyValueOption
+	if rf, ok := ret.Get(0).(func(context.Context, types.StorageKey, uint32, *types.StorageKey) []types.KeyValueOption); ok {
+		r0 = rf(ctx, prefix, count, startKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.KeyValueOption)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.StorageKey, uint32, *types.StorageKey) error); ok {
+		r1 = rf(ctx, prefix, count, startKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStorageRaw provides a mock function with given fields: key, blockHash
+func (_m *State) GetStorageRaw(key types.StorageKey, blockHash types.Hash) (*types.StorageDataRaw, error) {
+	ret := _m.Called(key, blockHash)
+
+	var r0 *types.StorageDataRaw
+	if rf, ok := ret.Get(0).(func(types.StorageKey, types.Hash) *types.StorageDataRaw); ok {
+		r0 = rf(key, blockHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.StorageDataRaw)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.StorageKey, types.Hash) error); ok {
+		r1 = rf(key, blockHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStorageRawContext provides a mock function with given fields: ctx, key, blockHash
+func (_m *State) GetStorageRawContext(ctx context.Context, key types.StorageKey, blockHash types.Hash) (*types.StorageDataRaw, error) {
+	ret := _m.Called(ctx, key, blockHash)
+
+	var r0 *types.StorageDataRaw
+	if rf, ok := ret.Get(0).(func(context.Context, types.StorageKey, types.Hash) *types.StorageDataRaw); ok {
+		r0 = rf(ctx, key, blockHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.StorageDataRaw)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.StorageKey, types.Hash) error); ok {
+		r1 = rf(ctx, key, blockHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStorageRawLatest provides a mock function with given fields: key
+func (_m *State) GetStorageRawLatest(key types.StorageKey) (*types.StorageDataRaw, error) {
+	ret := _m.Called(key)
+
+	var r0 *types.StorageDataRaw
+	if rf, ok := ret.Get(0).(func(types.StorageKey) *types.StorageDataRaw); ok {
+		r0 = rf(key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.StorageDataRaw)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.StorageKey) error); ok {
+		r1 = rf(key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStorageRawLatestContext provides a mock function with given fields: ctx, key
+func (_m *State) GetStorageRawLatestContext(ctx context.Context, key types.StorageKey) (*types.StorageDataRaw, error) {
+	ret := _m.Called(ctx, key)
+
+	var r0 *types.StorageDataRaw
+	if rf, ok := ret.Get(0).(func(context.Context, types.StorageKey) *types.StorageDataRaw); ok {
+		r0 = rf(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.StorageDataRaw)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.StorageKey) error); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStorageRawMulti provides a mock function with given fields: keys, blockHash
+func (_m *State) GetStorageRawMulti(keys []types.StorageKey, blockHash types.Hash) ([]*types.StorageDataRaw, []error, error) {
+	ret := _m.Called(keys, blockHash)
+
+	var r0 []*types.StorageDataRaw
+	if rf, ok := ret.Get(0).(func([]types.StorageKey, types.Hash) []*types.StorageDataRaw); ok {
+		r0 = rf(keys, blockHash)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.StorageDataRaw)
+			r0 = ret.Get(0).([]*types.StorageDataRaw)
 		}
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(types.StorageKey) error); ok {
-		r1 = rf(key)
+	var r1 []error
+	if rf, ok := ret.Get(1).(func([]types.StorageKey, types.Hash) []error); ok {
+		r1 = rf(keys, blockHash)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]error)
+		}
 	}
 
-	return r0, r1
+	var r2 error
+	if rf, ok := ret.Get(2).(func([]types.StorageKey, types.Hash) error); ok {
+		r2 = rf(keys, blockHash)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetStorageRawMultiContext provides a mock function with given fields: ctx, keys, blockHash
+func (_m *State) GetStorageRawMultiContext(ctx context.Context, keys []types.StorageKey, blockHash types.Hash) ([]*types.StorageDataRaw, []error, error) {
+	ret := _m.Called(ctx, keys, blockHash)
+
+	var r0 []*types.StorageDataRaw
+	if rf, ok := ret.Get(0).(func(context.Context, []types.StorageKey, types.Hash) []*types.StorageDataRaw); ok {
+		r0 = rf(ctx, keys, blockHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.StorageDataRaw)
+		}
+	}
+
+	var r1 []error
+	if rf, ok := ret.Get(1).(func(context.Context, []types.StorageKey, types.Hash) []error); ok {
+		r1 = rf(ctx, keys, blockHash)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]error)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, []types.StorageKey, types.Hash) error); ok {
+		r2 = rf(ctx, keys, blockHash)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetStorageRawMultiLatest provides a mock function with given fields: keys
+func (_m *State) GetStorageRawMultiLatest(keys []types.StorageKey) ([]*types.StorageDataRaw, []error, error) {
+	ret := _m.Called(keys)
+
+	var r0 []*types.StorageDataRaw
+	if rf, ok := ret.Get(0).(func([]types.StorageKey) []*types.StorageDataRaw); ok {
+		r0 = rf(keys)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.StorageDataRaw)
+		}
+	}
+
+	var r1 []error
+	if rf, ok := ret.Get(1).(func([]types.StorageKey) []error); ok {
+		r1 = rf(keys)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]error)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func([]types.StorageKey) error); ok {
+		r2 = rf(keys)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetStorageRawMultiLatestContext provides a mock function with given fields: ctx, keys
+func (_m *State) GetStorageRawMultiLatestContext(ctx context.Context, keys []types.StorageKey) ([]*types.StorageDataRaw, []error, error) {
+	ret := _m.Called(ctx, keys)
+
+	var r0 []*types.StorageDataRaw
+	if rf, ok := ret.Get(0).(func(context.Context, []types.StorageKey) []*types.StorageDataRaw); ok {
+		r0 = rf(ctx, keys)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.StorageDataRaw)
+		}
+	}
+
+	var r1 []error
+	if rf, ok := ret.Get(1).(func(context.Context, []types.StorageKey) []error); ok {
+		r1 = rf(ctx, keys)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]error)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, []types.StorageKey) error); ok {
+		r2 = rf(ctx, keys)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // GetStorageSize provides a mock function with given fields: key, blockHash
@@ -528,6 +1705,27 @@ func (_m *State) GetStorageSize(key types.StorageKey, blockHash types.Hash) (typ
 	return r0, r1
 }
 
+// GetStorageSizeContext provides a mock function with given fields: ctx, key, blockHash
+func (_m *State) GetStorageSizeContext(ctx context.Context, key types.StorageKey, blockHash types.Hash) (types.U64, error) {
+	ret := _m.Called(ctx, key, blockHash)
+
+	var r0 types.U64
+	if rf, ok := ret.Get(0).(func(context.Context, types.StorageKey, types.Hash) types.U64); ok {
+		r0 = rf(ctx, key, blockHash)
+	} else {
+		r0 = ret.Get(0).(types.U64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.StorageKey, types.Hash) error); ok {
+		r1 = rf(ctx, key, blockHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetStorageSizeLatest provides a mock function with given fields: key
 func (_m *State) GetStorageSizeLatest(key types.StorageKey) (types.U64, error) {
 	ret := _m.Called(key)
@@ -549,6 +1747,96 @@ func (_m *State) GetStorageSizeLatest(key types.StorageKey) (types.U64, error) {
 	return r0, r1
 }
 
+// GetStorageSizeLatestContext provides a mock function with given fields: ctx, key
+func (_m *State) GetStorageSizeLatestContext(ctx context.Context, key types.StorageKey) (types.U64, error) {
+	ret := _m.Called(ctx, key)
+
+	var r0 types.U64
+	if rf, ok := ret.Get(0).(func(context.Context, types.StorageKey) types.U64); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Get(0).(types.U64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.StorageKey) error); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IterateChildKeys provides a mock function with given fields: ctx, childStorageKey, prefix, opts
+func (_m *State) IterateChildKeys(ctx context.Context, childStorageKey types.StorageKey, prefix types.StorageKey, opts state.IterateOptions) (*state.StorageIterator[types.StorageKey], error) {
+	ret := _m.Called(ctx, childStorageKey, prefix, opts)
+
+	var r0 *state.StorageIterator[types.StorageKey]
+	if rf, ok := ret.Get(0).(func(context.Context, types.StorageKey, types.StorageKey, state.IterateOptions) *state.StorageIterator[types.StorageKey]); ok {
+		r0 = rf(ctx, childStorageKey, prefix, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*state.StorageIterator[types.StorageKey])
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.StorageKey, types.StorageKey, state.IterateOptions) error); ok {
+		r1 = rf(ctx, childStorageKey, prefix, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IterateKeys provides a mock function with given fields: ctx, prefix, opts
+func (_m *State) IterateKeys(ctx context.Context, prefix types.StorageKey, opts state.IterateOptions) (*state.StorageIterator[types.StorageKey], error) {
+	ret := _m.Called(ctx, prefix, opts)
+
+	var r0 *state.StorageIterator[types.StorageKey]
+	if rf, ok := ret.Get(0).(func(context.Context, types.StorageKey, state.IterateOptions) *state.StorageIterator[types.StorageKey]); ok {
+		r0 = rf(ctx, prefix, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*state.StorageIterator[types.StorageKey])
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.StorageKey, state.IterateOptions) error); ok {
+		r1 = rf(ctx, prefix, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IterateStorage provides a mock function with given fields: ctx, prefix, opts
+func (_m *State) IterateStorage(ctx context.Context, prefix types.StorageKey, opts state.IterateOptions) (*state.StorageIterator[types.KeyValueOption], error) {
+	ret := _m.Called(ctx, prefix, opts)
+
+	var r0 *state.StorageIterator[types.KeyValueOption]
+	if rf, ok := ret.Get(0).(func(context.Context, types.StorageKey, state.IterateOptions) *state.StorageIterator[types.KeyValueOption]); ok {
+		r0 = rf(ctx, prefix, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*state.StorageIterator[types.KeyValueOption])
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.StorageKey, state.IterateOptions) error); ok {
+		r1 = rf(ctx, prefix, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // QueryStorage provides a mock function with given fields: keys, startBlock, block
 func (_m *State) QueryStorage(keys []types.StorageKey, startBlock types.Hash, block types.Hash) ([]types.StorageChangeSet, error) {
 	ret := _m.Called(keys, startBlock, block)
@@ -595,6 +1883,29 @@ func (_m *State) QueryStorageAt(keys []types.StorageKey, block types.Hash) ([]ty
 	return r0, r1
 }
 
+// QueryStorageAtContext provides a mock function with given fields: ctx, keys, block
+func (_m *State) QueryStorageAtContext(ctx context.Context, keys []types.StorageKey, block types.Hash) ([]types.StorageChangeSet, error) {
+	ret := _m.Called(ctx, keys, block)
+
+	var r0 []types.StorageChangeSet
+	if rf, ok := ret.Get(0).(func(context.Context, []types.StorageKey, types.Hash) []types.StorageChangeSet); ok {
+		r0 = rf(ctx, keys, block)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.StorageChangeSet)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []types.StorageKey, types.Hash) error); ok {
+		r1 = rf(ctx, keys, block)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // QueryStorageAtLatest provides a mock function with given fields: keys
 func (_m *State) QueryStorageAtLatest(keys []types.StorageKey) ([]types.StorageChangeSet, error) {
 	ret := _m.Called(keys)
@@ -618,6 +1929,52 @@ func (_m *State) QueryStorageAtLatest(keys []types.StorageKey) ([]types.StorageC
 	return r0, r1
 }
 
+// QueryStorageAtLatestContext provides a mock function with given fields: ctx, keys
+func (_m *State) QueryStorageAtLatestContext(ctx context.Context, keys []types.StorageKey) ([]types.StorageChangeSet, error) {
+	ret := _m.Called(ctx, keys)
+
+	var r0 []types.StorageChangeSet
+	if rf, ok := ret.Get(0).(func(context.Context, []types.StorageKey) []types.StorageChangeSet); ok {
+		r0 = rf(ctx, keys)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.StorageChangeSet)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []types.StorageKey) error); ok {
+		r1 = rf(ctx, keys)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueryStorageContext provides a mock function with given fields: ctx, keys, startBlock, block
+func (_m *State) QueryStorageContext(ctx context.Context, keys []types.StorageKey, startBlock types.Hash, block types.Hash) ([]types.StorageChangeSet, error) {
+	ret := _m.Called(ctx, keys, startBlock, block)
+
+	var r0 []types.StorageChangeSet
+	if rf, ok := ret.Get(0).(func(context.Context, []types.StorageKey, types.Hash, types.Hash) []types.StorageChangeSet); ok {
+		r0 = rf(ctx, keys, startBlock, block)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.StorageChangeSet)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []types.StorageKey, types.Hash, types.Hash) error); ok {
+		r1 = rf(ctx, keys, startBlock, block)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // QueryStorageLatest provides a mock function with given fields: keys, startBlock
 func (_m *State) QueryStorageLatest(keys []types.StorageKey, startBlock types.Hash) ([]types.StorageChangeSet, error) {
 	ret := _m.Called(keys, startBlock)
@@ -641,6 +1998,29 @@ func (_m *State) QueryStorageLatest(keys []types.StorageKey, startBlock types.Ha
 	return r0, r1
 }
 
+// QueryStorageLatestContext provides a mock function with given fields: ctx, keys, startBlock
+func (_m *State) QueryStorageLatestContext(ctx context.Context, keys []types.StorageKey, startBlock types.Hash) ([]types.StorageChangeSet, error) {
+	ret := _m.Called(ctx, keys, startBlock)
+
+	var r0 []types.StorageChangeSet
+	if rf, ok := ret.Get(0).(func(context.Context, []types.StorageKey, types.Hash) []types.StorageChangeSet); ok {
+		r0 = rf(ctx, keys, startBlock)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.StorageChangeSet)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []types.StorageKey, types.Hash) error); ok {
+		r1 = rf(ctx, keys, startBlock)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SubscribeRuntimeVersion provides a mock function with given fields:
 func (_m *State) SubscribeRuntimeVersion() (*state.RuntimeVersionSubscription, error) {
 	ret := _m.Called()
@@ -664,6 +2044,29 @@ func (_m *State) SubscribeRuntimeVersion() (*state.RuntimeVersionSubscription, e
 	return r0, r1
 }
 
+// SubscribeRuntimeVersionContext provides a mock function with given fields: ctx
+func (_m *State) SubscribeRuntimeVersionContext(ctx context.Context) (*state.RuntimeVersionSubscription, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *state.RuntimeVersionSubscription
+	if rf, ok := ret.Get(0).(func(context.Context) *state.RuntimeVersionSubscription); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*state.RuntimeVersionSubscription)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SubscribeStorageRaw provides a mock function with given fields: keys
 func (_m *State) SubscribeStorageRaw(keys []types.StorageKey) (*state.StorageSubscription, error) {
 	ret := _m.Called(keys)
@@ -687,6 +2090,75 @@ func (_m *State) SubscribeStorageRaw(keys []types.StorageKey) (*state.StorageSub
 	return r0, r1
 }
 
+// SubscribeStorageRawContext provides a mock function with given fields: ctx, keys
+func (_m *State) SubscribeStorageRawContext(ctx context.Context, keys []types.StorageKey) (*state.StorageSubscription, error) {
+	ret := _m.Called(ctx, keys)
+
+	var r0 *state.StorageSubscription
+	if rf, ok := ret.Get(0).(func(context.Context, []types.StorageKey) *state.StorageSubscription); ok {
+		r0 = rf(ctx, keys)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*state.StorageSubscription)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []types.StorageKey) error); ok {
+		r1 = rf(ctx, keys)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TraceBlock provides a mock function with given fields: blockHash, targets, storageKeys, methods
+func (_m *State) TraceBlock(blockHash types.Hash, targets string, storageKeys []types.StorageKey, methods string) (*types.TraceBlockResponse, error) {
+	ret := _m.Called(blockHash, targets, storageKeys, methods)
+
+	var r0 *types.TraceBlockResponse
+	if rf, ok := ret.Get(0).(func(types.Hash, string, []types.StorageKey, string) *types.TraceBlockResponse); ok {
+		r0 = rf(blockHash, targets, storageKeys, methods)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.TraceBlockResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.Hash, string, []types.StorageKey, string) error); ok {
+		r1 = rf(blockHash, targets, storageKeys, methods)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TraceBlockContext provides a mock function with given fields: ctx, blockHash, targets, storageKeys, methods
+func (_m *State) TraceBlockContext(ctx context.Context, blockHash types.Hash, targets string, storageKeys []types.StorageKey, methods string) (*types.TraceBlockResponse, error) {
+	ret := _m.Called(ctx, blockHash, targets, storageKeys, methods)
+
+	var r0 *types.TraceBlockResponse
+	if rf, ok := ret.Get(0).(func(context.Context, types.Hash, string, []types.StorageKey, string) *types.TraceBlockResponse); ok {
+		r0 = rf(ctx, blockHash, targets, storageKeys, methods)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.TraceBlockResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.Hash, string, []types.StorageKey, string) error); ok {
+		r1 = rf(ctx, blockHash, targets, storageKeys, methods)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type NewStateT interface {
 	mock.TestingT
 	Cleanup(func())
@@ -17,6 +17,8 @@
 package state
 
 import (
+	"context"
+
 	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
 )
@@ -24,15 +26,29 @@ import (
 // QueryStorage queries historical storage entries (by key) starting from a start block until an end block
 func (s *state) QueryStorage(keys []types.StorageKey, startBlock types.Hash, block types.Hash) (
 	[]types.StorageChangeSet, error) {
-	return s.queryStorage(keys, startBlock, &block)
+	return s.QueryStorageContext(context.Background(), keys, startBlock, block)
+}
+
+// QueryStorageContext queries historical storage entries (by key) starting from a start block until an end block, the
+// call is aborted when ctx is done
+func (s *state) QueryStorageContext(ctx context.Context, keys []types.StorageKey, startBlock types.Hash,
+	block types.Hash) ([]types.StorageChangeSet, error) {
+	return s.queryStorage(ctx, keys, startBlock, &block)
 }
 
 // QueryStorageLatest queries historical storage entries (by key) starting from a start block until the latest block
 func (s *state) QueryStorageLatest(keys []types.StorageKey, startBlock types.Hash) ([]types.StorageChangeSet, error) {
-	return s.queryStorage(keys, startBlock, nil)
+	return s.QueryStorageLatestContext(context.Background(), keys, startBlock)
+}
+
+// QueryStorageLatestContext queries historical storage entries (by key) starting from a start block until the latest
+// block, the call is aborted when ctx is done
+func (s *state) QueryStorageLatestContext(ctx context.Context, keys []types.StorageKey, startBlock types.Hash) (
+	[]types.StorageChangeSet, error) {
+	return s.queryStorage(ctx, keys, startBlock, nil)
 }
 
-func (s *state) queryStorage(keys []types.StorageKey, startBlock types.Hash, block *types.Hash) (
+func (s *state) queryStorage(ctx context.Context, keys []types.StorageKey, startBlock types.Hash, block *types.Hash) (
 	[]types.StorageChangeSet, error) {
 	hexKeys := make([]string, len(keys))
 	for i, key := range keys {
@@ -40,7 +56,7 @@ func (s *state) queryStorage(keys []types.StorageKey, startBlock types.Hash, blo
 	}
 
 	var res []types.StorageChangeSet
-	err := client.CallWithBlockHash(s.client, &res, "state_queryStorage", block, hexKeys, startBlock.Hex())
+	err := client.CallWithBlockHashContext(ctx, s.client, &res, "state_queryStorage", block, hexKeys, startBlock.Hex())
 	if err != nil {
 		return nil, err
 	}
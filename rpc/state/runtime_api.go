@@ -0,0 +1,156 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+// CallRuntimeAPI calls the runtime API function with the SCALE encoded args and decodes the result into a T. The args
+// are encoded one after another, in the order of the parameters of the function. The function is called at the given
+// block, or at the latest block if blockHash is nil.
+func CallRuntimeAPI[T any](ctx context.Context, s State, method string, blockHash *types.Hash, args ...interface{}) (
+	T, error) {
+	var res T
+
+	data, err := encodeArgs(args)
+	if err != nil {
+		return res, err
+	}
+
+	var b []byte
+	if blockHash == nil {
+		b, err = s.CallLatestContext(ctx, method, data)
+	} else {
+		b, err = s.CallContext(ctx, method, data, *blockHash)
+	}
+	if err != nil {
+		return res, err
+	}
+
+	if err := codec.Decode(b, &res); err != nil {
+		return res, err
+	}
+
+	return res, nil
+}
+
+func encodeArgs(args []interface{}) ([]byte, error) {
+	var data []byte
+	for _, arg := range args {
+		enc, err := codec.Encode(arg)
+		if err != nil {
+			return nil, err
+		}
+
+		data = append(data, enc...)
+	}
+
+	return data, nil
+}
+
+// AccountNonce returns the nonce of the account by calling AccountNonceApi_account_nonce
+func AccountNonce(ctx context.Context, s State, accountID types.AccountID, blockHash *types.Hash) (types.U32, error) {
+	return CallRuntimeAPI[types.U32](ctx, s, "AccountNonceApi_account_nonce", blockHash, accountID)
+}
+
+// MetadataVersions returns the metadata versions the runtime supports by calling Metadata_metadata_versions
+func MetadataVersions(ctx context.Context, s State, blockHash *types.Hash) ([]types.U32, error) {
+	return CallRuntimeAPI[[]types.U32](ctx, s, "Metadata_metadata_versions", blockHash)
+}
+
+// MetadataAtVersion returns the metadata of the given version by calling Metadata_metadata_at_version, or nil if the
+// runtime does not support that version
+func MetadataAtVersion(ctx context.Context, s State, version uint32, blockHash *types.Hash) (*types.Metadata, error) {
+	opaque, err := CallRuntimeAPI[types.OptionBytes](ctx, s, "Metadata_metadata_at_version", blockHash,
+		types.NewU32(version))
+	if err != nil {
+		return nil, err
+	}
+
+	ok, b := opaque.Unwrap()
+	if !ok {
+		return nil, nil
+	}
+
+	var meta types.Metadata
+	if err := codec.Decode(b, &meta); err != nil {
+		return nil, err
+	}
+
+	return &meta, nil
+}
+
+// InherentExtrinsics returns the inherent extrinsics the runtime creates from the inherent data by calling
+// BlockBuilder_inherent_extrinsics
+func InherentExtrinsics(ctx context.Context, s State, data types.InherentData, blockHash *types.Hash) (
+	[]types.Extrinsic, error) {
+	return CallRuntimeAPI[[]types.Extrinsic](ctx, s, "BlockBuilder_inherent_extrinsics", blockHash, data)
+}
+
+// TransactionPaymentQueryInfo returns the dispatch info of the extrinsic by calling TransactionPaymentApi_query_info
+func TransactionPaymentQueryInfo(ctx context.Context, s State, xt types.Extrinsic, blockHash *types.Hash) (
+	types.RuntimeDispatchInfo, error) {
+	length, err := encodedLength(xt)
+	if err != nil {
+		return types.RuntimeDispatchInfo{}, err
+	}
+
+	return CallRuntimeAPI[types.RuntimeDispatchInfo](ctx, s, "TransactionPaymentApi_query_info", blockHash, xt,
+		length)
+}
+
+// TransactionPaymentQueryFeeDetails returns the fee breakdown of the extrinsic by calling
+// TransactionPaymentApi_query_fee_details
+func TransactionPaymentQueryFeeDetails(ctx context.Context, s State, xt types.Extrinsic, blockHash *types.Hash) (
+	types.FeeDetails, error) {
+	length, err := encodedLength(xt)
+	if err != nil {
+		return types.FeeDetails{}, err
+	}
+
+	return CallRuntimeAPI[types.FeeDetails](ctx, s, "TransactionPaymentApi_query_fee_details", blockHash, xt,
+		length)
+}
+
+// TransactionPaymentQueryWeightToFee returns the fee for the weight by calling
+// TransactionPaymentApi_query_weight_to_fee, which is available from version 3 of the API
+func TransactionPaymentQueryWeightToFee(ctx context.Context, s State, weight types.Weight, blockHash *types.Hash) (
+	types.U128, error) {
+	return CallRuntimeAPI[types.U128](ctx, s, "TransactionPaymentApi_query_weight_to_fee", blockHash, weight)
+}
+
+// TransactionPaymentQueryLengthToFee returns the fee for the encoded length of an extrinsic by calling
+// TransactionPaymentApi_query_length_to_fee, which is available from version 3 of the API
+func TransactionPaymentQueryLengthToFee(ctx context.Context, s State, length uint32, blockHash *types.Hash) (
+	types.U128, error) {
+	return CallRuntimeAPI[types.U128](ctx, s, "TransactionPaymentApi_query_length_to_fee", blockHash,
+		types.NewU32(length))
+}
+
+// encodedLength returns the length of the encoded extrinsic, which the transaction payment API expects next to it
+func encodedLength(xt types.Extrinsic) (types.U32, error) {
+	enc, err := codec.Encode(xt)
+	if err != nil {
+		return 0, err
+	}
+
+	return types.NewU32(uint32(len(enc))), nil
+}
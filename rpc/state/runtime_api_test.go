@@ -0,0 +1,112 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCallRuntimeAPI(t *testing.T) {
+	res, err := CallRuntimeAPI[struct {
+		A types.U8
+		B types.U32
+	}](context.Background(), testState, "Test_echo", nil, types.U8(1), types.U32(2))
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, res.A)
+	assert.EqualValues(t, 2, res.B)
+	assert.Equal(t, []byte{0x01, 0x02, 0x00, 0x00, 0x00}, mockSrv.lastCallData)
+}
+
+func TestAccountNonce(t *testing.T) {
+	nonce, err := AccountNonce(context.Background(), testState, types.AccountID{0x07}, &mockSrv.blockHashLatest)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 7, nonce)
+}
+
+func TestCoreVersion(t *testing.T) {
+	v, err := CoreVersion(context.Background(), testState, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, &types.RuntimeVersion{
+		APIs:               []types.RuntimeVersionAPI{{APIID: "0xdf6acb689907609b", Version: 4}},
+		AuthoringVersion:   10,
+		ImplName:           "substrate-node",
+		ImplVersion:        62,
+		SpecName:           "node",
+		SpecVersion:        60,
+		TransactionVersion: 2,
+	}, v)
+}
+
+func TestMetadataVersions(t *testing.T) {
+	versions, err := MetadataVersions(context.Background(), testState, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []types.U32{14, 15}, versions)
+}
+
+func TestMetadataAtVersion(t *testing.T) {
+	meta, err := MetadataAtVersion(context.Background(), testState, 4, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, mockSrv.metadata, meta)
+
+	meta, err = MetadataAtVersion(context.Background(), testState, 16, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, meta)
+}
+
+func TestInherentExtrinsics(t *testing.T) {
+	data := types.InherentData{}
+	assert.NoError(t, data.Put(types.NewInherentIdentifier("timstap0"), types.NewU64(1700000000000)))
+	assert.NoError(t, data.Put(types.NewInherentIdentifier("babeslot"), types.NewU64(42)))
+
+	xts, err := InherentExtrinsics(context.Background(), testState, data, nil)
+	assert.NoError(t, err)
+	assert.Len(t, xts, 1)
+
+	// The identifiers are sorted like in the BTreeMap of the runtime
+	var dec types.InherentData
+	assert.NoError(t, codec.Decode(mockSrv.lastCallData, &dec))
+	assert.Equal(t, data, dec)
+	assert.Equal(t, []byte("babeslot"), mockSrv.lastCallData[1:9])
+
+	// The mock returns the encoded timestamp as the arguments of the extrinsic
+	ts, err := codec.Encode(data[types.NewInherentIdentifier("timstap0")])
+	assert.NoError(t, err)
+	assert.Equal(t, types.Args(ts), xts[0].Method.Args)
+}
+
+func TestInherentData_DecodeUntrustedLength(t *testing.T) {
+	// a compact length of 2^64-1 without any entries
+	var dec types.InherentData
+	assert.Error(t, codec.Decode([]byte{0x13, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, &dec))
+}
+
+func TestTransactionPaymentQueryLengthToFee(t *testing.T) {
+	fee, err := TransactionPaymentQueryLengthToFee(context.Background(), testState, 100, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, types.NewU128(*big.NewInt(4000)), fee)
+
+	fee, err = TransactionPaymentQueryWeightToFee(context.Background(), testState,
+		types.NewWeight(types.NewUCompactFromUInt(1000), types.NewUCompactFromUInt(10)), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, types.NewU128(*big.NewInt(3000)), fee)
+}
@@ -19,53 +19,159 @@
 package state
 
 import (
+	"context"
+
 	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
 )
 
 type State interface {
 	GetStorage(key types.StorageKey, target interface{}, blockHash types.Hash) (ok bool, err error)
+	GetStorageContext(ctx context.Context, key types.StorageKey, target interface{}, blockHash types.Hash) (
+		ok bool, err error)
 	GetStorageLatest(key types.StorageKey, target interface{}) (ok bool, err error)
+	GetStorageLatestContext(ctx context.Context, key types.StorageKey, target interface{}) (ok bool, err error)
 	GetStorageRaw(key types.StorageKey, blockHash types.Hash) (*types.StorageDataRaw, error)
+	GetStorageRawContext(ctx context.Context, key types.StorageKey, blockHash types.Hash) (*types.StorageDataRaw, error)
 	GetStorageRawLatest(key types.StorageKey) (*types.StorageDataRaw, error)
+	GetStorageRawLatestContext(ctx context.Context, key types.StorageKey) (*types.StorageDataRaw, error)
+	GetStorageRawMulti(keys []types.StorageKey, blockHash types.Hash) (
+		data []*types.StorageDataRaw, errs []error, err error)
+	GetStorageRawMultiContext(ctx context.Context, keys []types.StorageKey, blockHash types.Hash) (
+		data []*types.StorageDataRaw, errs []error, err error)
+	GetStorageRawMultiLatest(keys []types.StorageKey) (data []*types.StorageDataRaw, errs []error, err error)
+	GetStorageRawMultiLatestContext(ctx context.Context, keys []types.StorageKey) (
+		data []*types.StorageDataRaw, errs []error, err error)
 
 	GetChildStorageSize(childStorageKey, key types.StorageKey, blockHash types.Hash) (types.U64, error)
+	GetChildStorageSizeContext(ctx context.Context, childStorageKey, key types.StorageKey, blockHash types.Hash) (
+		types.U64, error)
 	GetChildStorageSizeLatest(childStorageKey, key types.StorageKey) (types.U64, error)
+	GetChildStorageSizeLatestContext(ctx context.Context, childStorageKey, key types.StorageKey) (types.U64, error)
 	GetChildStorage(childStorageKey, key types.StorageKey, target interface{}, blockHash types.Hash) (ok bool, err error)
+	GetChildStorageContext(ctx context.Context, childStorageKey, key types.StorageKey, target interface{},
+		blockHash types.Hash) (ok bool, err error)
 	GetChildStorageLatest(childStorageKey, key types.StorageKey, target interface{}) (ok bool, err error)
+	GetChildStorageLatestContext(ctx context.Context, childStorageKey, key types.StorageKey, target interface{}) (
+		ok bool, err error)
 	GetChildStorageRaw(childStorageKey, key types.StorageKey, blockHash types.Hash) (*types.StorageDataRaw, error)
+	GetChildStorageRawContext(ctx context.Context, childStorageKey, key types.StorageKey, blockHash types.Hash) (
+		*types.StorageDataRaw, error)
 	GetChildStorageRawLatest(childStorageKey, key types.StorageKey) (*types.StorageDataRaw, error)
+	GetChildStorageRawLatestContext(ctx context.Context, childStorageKey, key types.StorageKey) (
+		*types.StorageDataRaw, error)
 
 	GetMetadata(blockHash types.Hash) (*types.Metadata, error)
+	GetMetadataContext(ctx context.Context, blockHash types.Hash) (*types.Metadata, error)
 	GetMetadataLatest() (*types.Metadata, error)
+	GetMetadataLatestContext(ctx context.Context) (*types.Metadata, error)
 
 	GetStorageHash(key types.StorageKey, blockHash types.Hash) (types.Hash, error)
+	GetStorageHashContext(ctx context.Context, key types.StorageKey, blockHash types.Hash) (types.Hash, error)
 	GetStorageHashLatest(key types.StorageKey) (types.Hash, error)
+	GetStorageHashLatestContext(ctx context.Context, key types.StorageKey) (types.Hash, error)
 
 	SubscribeStorageRaw(keys []types.StorageKey) (*StorageSubscription, error)
+	SubscribeStorageRawContext(ctx context.Context, keys []types.StorageKey) (*StorageSubscription, error)
 
 	GetRuntimeVersion(blockHash types.Hash) (*types.RuntimeVersion, error)
+	GetRuntimeVersionContext(ctx context.Context, blockHash types.Hash) (*types.RuntimeVersion, error)
 	GetRuntimeVersionLatest() (*types.RuntimeVersion, error)
+	GetRuntimeVersionLatestContext(ctx context.Context) (*types.RuntimeVersion, error)
 
 	GetChildKeys(childStorageKey, prefix types.StorageKey, blockHash types.Hash) ([]types.StorageKey, error)
+	GetChildKeysContext(ctx context.Context, childStorageKey, prefix types.StorageKey, blockHash types.Hash) (
+		[]types.StorageKey, error)
 	GetChildKeysLatest(childStorageKey, prefix types.StorageKey) ([]types.StorageKey, error)
+	GetChildKeysLatestContext(ctx context.Context, childStorageKey, prefix types.StorageKey) ([]types.StorageKey, error)
 
 	SubscribeRuntimeVersion() (*RuntimeVersionSubscription, error)
+	SubscribeRuntimeVersionContext(ctx context.Context) (*RuntimeVersionSubscription, error)
 
 	QueryStorage(keys []types.StorageKey, startBlock types.Hash, block types.Hash) ([]types.StorageChangeSet, error)
+	QueryStorageContext(ctx context.Context, keys []types.StorageKey, startBlock types.Hash, block types.Hash) (
+		[]types.StorageChangeSet, error)
 	QueryStorageLatest(keys []types.StorageKey, startBlock types.Hash) ([]types.StorageChangeSet, error)
+	QueryStorageLatestContext(ctx context.Context, keys []types.StorageKey, startBlock types.Hash) (
+		[]types.StorageChangeSet, error)
 
 	QueryStorageAt(keys []types.StorageKey, block types.Hash) ([]types.StorageChangeSet, error)
+	QueryStorageAtContext(ctx context.Context, keys []types.StorageKey, block types.Hash) (
+		[]types.StorageChangeSet, error)
 	QueryStorageAtLatest(keys []types.StorageKey) ([]types.StorageChangeSet, error)
+	QueryStorageAtLatestContext(ctx context.Context, keys []types.StorageKey) ([]types.StorageChangeSet, error)
 
 	GetKeys(prefix types.StorageKey, blockHash types.Hash) ([]types.StorageKey, error)
+	GetKeysContext(ctx context.Context, prefix types.StorageKey, blockHash types.Hash) ([]types.StorageKey, error)
 	GetKeysLatest(prefix types.StorageKey) ([]types.StorageKey, error)
+	GetKeysLatestContext(ctx context.Context, prefix types.StorageKey) ([]types.StorageKey, error)
 
 	GetStorageSize(key types.StorageKey, blockHash types.Hash) (types.U64, error)
+	GetStorageSizeContext(ctx context.Context, key types.StorageKey, blockHash types.Hash) (types.U64, error)
 	GetStorageSizeLatest(key types.StorageKey) (types.U64, error)
+	GetStorageSizeLatestContext(ctx context.Context, key types.StorageKey) (types.U64, error)
 
 	GetChildStorageHash(childStorageKey, key types.StorageKey, blockHash types.Hash) (types.Hash, error)
+	GetChildStorageHashContext(ctx context.Context, childStorageKey, key types.StorageKey, blockHash types.Hash) (
+		types.Hash, error)
 	GetChildStorageHashLatest(childStorageKey, key types.StorageKey) (types.Hash, error)
+	GetChildStorageHashLatestContext(ctx context.Context, childStorageKey, key types.StorageKey) (types.Hash, error)
+
+	Call(method string, data []byte, blockHash types.Hash) ([]byte, error)
+	CallContext(ctx context.Context, method string, data []byte, blockHash types.Hash) ([]byte, error)
+	CallLatest(method string, data []byte) ([]byte, error)
+	CallLatestContext(ctx context.Context, method string, data []byte) ([]byte, error)
+
+	GetReadProof(keys []types.StorageKey, blockHash types.Hash) (*types.ReadProof, error)
+	GetReadProofContext(ctx context.Context, keys []types.StorageKey, blockHash types.Hash) (*types.ReadProof, error)
+	GetReadProofLatest(keys []types.StorageKey) (*types.ReadProof, error)
+	GetReadProofLatestContext(ctx context.Context, keys []types.StorageKey) (*types.ReadProof, error)
+
+	GetChildReadProof(childStorageKey types.StorageKey, keys []types.StorageKey, blockHash types.Hash) (
+		*types.ReadProof, error)
+	GetChildReadProofContext(ctx context.Context, childStorageKey types.StorageKey, keys []types.StorageKey,
+		blockHash types.Hash) (*types.ReadProof, error)
+	GetChildReadProofLatest(childStorageKey types.StorageKey, keys []types.StorageKey) (*types.ReadProof, error)
+	GetChildReadProofLatestContext(ctx context.Context, childStorageKey types.StorageKey, keys []types.StorageKey) (
+		*types.ReadProof, error)
+
+	GetKeysPaged(prefix types.StorageKey, count uint32, startKey *types.StorageKey, blockHash types.Hash) (
+		[]types.StorageKey, error)
+	GetKeysPagedContext(ctx context.Context, prefix types.StorageKey, count uint32, startKey *types.StorageKey,
+		blockHash types.Hash) ([]types.StorageKey, error)
+	GetKeysPagedLatest(prefix types.StorageKey, count uint32, startKey *types.StorageKey) ([]types.StorageKey, error)
+	GetKeysPagedLatestContext(ctx context.Context, prefix types.StorageKey, count uint32,
+		startKey *types.StorageKey) ([]types.StorageKey, error)
+
+	GetStoragePaged(prefix types.StorageKey, count uint32, startKey *types.StorageKey, blockHash types.Hash) (
+		[]types.KeyValueOption, error)
+	GetStoragePagedContext(ctx context.Context, prefix types.StorageKey, count uint32, startKey *types.StorageKey,
+		blockHash types.Hash) ([]types.KeyValueOption, error)
+	GetStoragePagedLatest(prefix types.StorageKey, count uint32, startKey *types.StorageKey) (
+		[]types.KeyValueOption, error)
+	GetStoragePagedLatestContext(ctx context.Context, prefix types.StorageKey, count uint32,
+		startKey *types.StorageKey) ([]types.KeyValueOption, error)
+
+	GetChildKeysPaged(childStorageKey, prefix types.StorageKey, count uint32, startKey *types.StorageKey,
+		blockHash types.Hash) ([]types.StorageKey, error)
+	GetChildKeysPagedContext(ctx context.Context, childStorageKey, prefix types.StorageKey, count uint32,
+		startKey *types.StorageKey, blockHash types.Hash) ([]types.StorageKey, error)
+	GetChildKeysPagedLatest(childStorageKey, prefix types.StorageKey, count uint32, startKey *types.StorageKey) (
+		[]types.StorageKey, error)
+	GetChildKeysPagedLatestContext(ctx context.Context, childStorageKey, prefix types.StorageKey, count uint32,
+		startKey *types.StorageKey) ([]types.StorageKey, error)
+
+	IterateKeys(ctx context.Context, prefix types.StorageKey, opts IterateOptions) (
+		*StorageIterator[types.StorageKey], error)
+	IterateStorage(ctx context.Context, prefix types.StorageKey, opts IterateOptions) (
+		*StorageIterator[types.KeyValueOption], error)
+	IterateChildKeys(ctx context.Context, childStorageKey, prefix types.StorageKey, opts IterateOptions) (
+		*StorageIterator[types.StorageKey], error)
+
+	TraceBlock(blockHash types.Hash, targets string, storageKeys []types.StorageKey, methods string) (
+		*types.TraceBlockResponse, error)
+	TraceBlockContext(ctx context.Context, blockHash types.Hash, targets string, storageKeys []types.StorageKey,
+		methods string) (*types.TraceBlockResponse, error)
 }
 
 // state exposes methods for querying state
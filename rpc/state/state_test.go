@@ -17,6 +17,7 @@
 package state
 
 import (
+	"math/big"
 	"os"
 	"strings"
 	"testing"
@@ -25,9 +26,13 @@ import (
 	"github.com/centrifuge/go-substrate-rpc-client/v4/rpcmocksrv"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+	"golang.org/x/crypto/blake2b"
 )
 
-var testState State
+var (
+	testClient client.Client
+	testState  State
+)
 
 func TestMain(m *testing.M) {
 	s := rpcmocksrv.New()
@@ -35,6 +40,14 @@ func TestMain(m *testing.M) {
 	if err != nil {
 		panic(err)
 	}
+	err = s.RegisterName("childstate", &ChildStateMockSrv{})
+	if err != nil {
+		panic(err)
+	}
+	err = s.RegisterName("chain", &ChainMockSrv{})
+	if err != nil {
+		panic(err)
+	}
 
 	cl, err := client.Connect(s.URL)
 	// cl, err := client.Connect(config.Default().RPCURL)
@@ -42,6 +55,7 @@ func TestMain(m *testing.M) {
 	if err != nil {
 		panic(err)
 	}
+	testClient = cl
 	testState = NewState(cl)
 
 	os.Exit(m.Run())
@@ -65,6 +79,10 @@ type MockSrv struct {
 	childStorageTrieValue    ChildStorageTrieTestVal
 	childStorageTrieSize     types.U64
 	childStorageTrieHashHex  string
+	lastCallData             []byte
+	pagedKeys                []string
+	pagedHashes              []string
+	traceBlockArgs           []*string
 }
 
 func (s *MockSrv) GetMetadata(hash *string) string {
@@ -172,6 +190,162 @@ type ChildStorageTrieTestVal struct {
 	Value   types.U32
 }
 
+// page returns up to count of the paged keys with the given prefix that follow startKey
+func (s *MockSrv) page(prefix string, count uint32, startKey *string, hash *string) []string {
+	if hash != nil {
+		mockSrv.pagedHashes = append(mockSrv.pagedHashes, *hash)
+	}
+
+	var keys []string
+	for _, key := range mockSrv.pagedKeys {
+		if uint32(len(keys)) == count {
+			break
+		}
+		if strings.HasPrefix(key, prefix) && (startKey == nil || key > *startKey) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+func (s *MockSrv) GetKeysPaged(prefix string, count uint32, startKey *string, hash *string) []string {
+	return s.page(prefix, count, startKey, hash)
+}
+
+func (s *MockSrv) GetStoragePaged(prefix string, count uint32, startKey *string, hash *string) [][2]string {
+	keys := s.page(prefix, count, startKey, hash)
+	pairs := make([][2]string, len(keys))
+	for i, key := range keys {
+		pairs[i] = [2]string{key, "0x" + key[len(key)-2:]}
+	}
+	return pairs
+}
+
+// TraceBlock returns a trace with a span and a read and write of System.Account, or an error for unknown blocks
+func (s *MockSrv) TraceBlock(block string, targets, storageKeys, methods *string) types.TraceBlockResponse {
+	if block != mockSrv.blockHashLatest.Hex() {
+		return types.TraceBlockResponse{IsTraceError: true, AsTraceError: types.TraceError{Error: "unknown block"}}
+	}
+	mockSrv.traceBlockArgs = []*string{targets, storageKeys, methods}
+
+	parentID := uint64(1)
+	return types.TraceBlockResponse{IsBlockTrace: true, AsBlockTrace: types.BlockTrace{
+		BlockHash:   block,
+		ParentHash:  "0x00",
+		StorageKeys: *storageKeys,
+		Spans:       []types.TraceSpan{{ID: 1, Name: "execute_block", Target: "frame_executive", Wasm: true}},
+		Events: []types.TraceEvent{
+			{Target: "state", ParentID: &parentID, Data: types.TraceEventData{StringValues: map[string]string{
+				"method": "Get", "ext_id": "8a3f", "key": traceKey, "result": "Some(0102)",
+			}}},
+			{Target: "state", ParentID: &parentID, Data: types.TraceEventData{StringValues: map[string]string{
+				"method": "Put", "ext_id": "8a3f", "key": traceKey, "value": "None",
+			}}},
+			{Target: "frame_support", ParentID: &parentID, Data: types.TraceEventData{StringValues: map[string]string{
+				"message": "hook",
+			}}},
+		},
+	}}
+}
+
+// traceKey is a key of System.Account, as formatted in storage trace events
+var traceKey = "26aa394eea5630e07c48ae0c9558cef7b99d880ec681799c0cf30e8886371da9" +
+	"de1e86a9a8c739864cf3cc5ec2bea59fd43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d"
+
+// ChildStateMockSrv exposes the childstate methods of the RPC Mock Server
+type ChildStateMockSrv struct{}
+
+func (s *ChildStateMockSrv) GetKeysPaged(childStorageKey, prefix string, count uint32, startKey *string,
+	hash *string) []string {
+	if childStorageKey != mockSrv.childStorageKeyHex {
+		panic("childStorageKey not found")
+	}
+	return mockSrv.page(prefix, count, startKey, hash)
+}
+
+// ChainMockSrv exposes the chain methods of the RPC Mock Server that are used by the state
+type ChainMockSrv struct{}
+
+func (s *ChainMockSrv) GetBlockHash() string {
+	return mockSrv.blockHashLatest.Hex()
+}
+
+// readProofNode is a trie leaf with the key 0x01 and the value 0x02, which is the proof for a trie with only this entry
+var readProofNode = types.Bytes{0x42, 0x01, 0x04, 0x02}
+
+func (s *MockSrv) GetReadProof(keys []string, hash *string) types.ReadProof {
+	if len(keys) != 1 || keys[0] != "0x01" {
+		panic("keys not found")
+	}
+	return types.ReadProof{At: mockSrv.blockHashLatest, Proof: []types.Bytes{readProofNode}}
+}
+
+// GetChildReadProof returns the proof of the key 0x01 of a child trie that contains the proven trie of GetReadProof,
+// the top trie contains only the root of the child trie
+func (s *MockSrv) GetChildReadProof(childStorageKey string, keys []string, hash *string) types.ReadProof {
+	if childStorageKey != mockSrv.childStorageKeyHex {
+		panic("childStorageKey not found")
+	}
+	if len(keys) != 1 || keys[0] != "0x01" {
+		panic("keys not found")
+	}
+
+	childRoot := blake2b.Sum256(readProofNode)
+	key := codec.MustHexDecodeString(childStorageKey)
+	top := append([]byte{0x40 | byte(2*len(key))}, key...)
+	top = append(append(top, 0x80), childRoot[:]...)
+
+	return types.ReadProof{At: mockSrv.blockHashLatest, Proof: []types.Bytes{top, readProofNode}}
+}
+
+// Call serves the runtime API functions used in tests from the mock data, any other function echoes its data
+func (s *MockSrv) Call(method, data string, hash *string) (string, error) {
+	b, err := codec.HexDecodeString(data)
+	if err != nil {
+		return "", err
+	}
+	mockSrv.lastCallData = b
+
+	switch method {
+	case "AccountNonceApi_account_nonce":
+		return codec.EncodeToHex(types.NewU32(uint32(b[0])))
+	case "Core_version":
+		return codec.EncodeToHex(struct {
+			SpecName, ImplName                         string
+			AuthoringVersion, SpecVersion, ImplVersion types.U32
+			APIs                                       []struct {
+				ID      [8]byte
+				Version types.U32
+			}
+			TransactionVersion types.U32
+			StateVersion       types.U8
+		}{"node", "substrate-node", 10, 60, 62, []struct {
+			ID      [8]byte
+			Version types.U32
+		}{{[8]byte{0xdf, 0x6a, 0xcb, 0x68, 0x99, 0x07, 0x60, 0x9b}, 4}}, 2, 1})
+	case "Metadata_metadata_versions":
+		return codec.EncodeToHex([]types.U32{14, 15})
+	case "Metadata_metadata_at_version":
+		if b[0] != 4 {
+			return codec.EncodeToHex(types.NewOptionBytesEmpty())
+		}
+		meta, err := codec.HexDecodeString(mockSrv.metadataString)
+		if err != nil {
+			return "", err
+		}
+		return codec.EncodeToHex(types.NewOptionBytes(meta))
+	case "BlockBuilder_inherent_extrinsics":
+		return codec.EncodeToHex([]types.Extrinsic{{
+			Version: types.ExtrinsicVersion4,
+			Method:  types.Call{CallIndex: types.CallIndex{SectionIndex: 3}, Args: types.Args(b[len(b)-9:])},
+		}})
+	case "TransactionPaymentApi_query_weight_to_fee", "TransactionPaymentApi_query_length_to_fee":
+		return codec.EncodeToHex(types.NewU128(*big.NewInt(int64(len(b)) * 1000)))
+	default:
+		return data, nil
+	}
+}
+
 // mockSrv sets default data used in tests. This data might become stale when substrate is updated – just run the tests
 // against real servers and update the values stored here. To do that, replace s.URL with
 // config.Default().RPCURL
@@ -196,4 +370,14 @@ var mockSrv = MockSrv{
 	},
 	childStorageTrieSize:    68,
 	childStorageTrieHashHex: "0x20e3fc48a91087d091c17de08a5c470de53ccdaebd361025b0e5b7c65b9a0d30", //nolint:lll
+	pagedKeys:               append(pagedKeys(0xaa, 25), pagedKeys(0xbb, 1)...),
+}
+
+// pagedKeys returns n sorted keys with the given first byte
+func pagedKeys(prefix byte, n int) []string {
+	keys := make([]string, n)
+	for i := range keys {
+		keys[i] = codec.HexEncodeToString([]byte{prefix, 0x00, byte(i)})
+	}
+	return keys
 }
@@ -18,25 +18,21 @@ package state //nolint:dupl
 
 import (
 	"context"
-	"sync"
 
-	"github.com/centrifuge/go-substrate-rpc-client/v4/config"
-	gethrpc "github.com/centrifuge/go-substrate-rpc-client/v4/gethrpc"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
 )
 
 // RuntimeVersionSubscription is a subscription established through one of the Client's subscribe methods.
 type RuntimeVersionSubscription struct {
-	sub      *gethrpc.ClientSubscription
-	channel  chan types.RuntimeVersion
-	quitOnce sync.Once // ensures quit is closed once
+	sub *client.BufferedSubscription[types.RuntimeVersion]
 }
 
 // Chan returns the subscription channel.
 //
 // The channel is closed when Unsubscribe is called on the subscription.
 func (s *RuntimeVersionSubscription) Chan() <-chan types.RuntimeVersion {
-	return s.channel
+	return s.sub.Chan()
 }
 
 // Err returns the subscription error channel. The intended use of Err is to schedule
@@ -51,29 +47,44 @@ func (s *RuntimeVersionSubscription) Err() <-chan error {
 	return s.sub.Err()
 }
 
-// Unsubscribe unsubscribes the notification and closes the error channel.
+// Reconnected returns a channel that receives a value whenever the subscription has been re-established after the
+// connection to the node was lost. Notifications may have been missed in between. It only ever receives values if
+// the subscription was created through a reconnecting client, see client.ConnectWithReconnect.
+func (s *RuntimeVersionSubscription) Reconnected() <-chan struct{} {
+	return s.sub.Reconnected()
+}
+
+// Dropped returns the number of notifications that have been dropped because the channel was full, see
+// client.SubscriptionOptions
+func (s *RuntimeVersionSubscription) Dropped() uint64 {
+	return s.sub.Dropped()
+}
+
+// Unsubscribe unsubscribes the notification and closes the error and notification channels.
 // It can safely be called more than once.
 func (s *RuntimeVersionSubscription) Unsubscribe() {
 	s.sub.Unsubscribe()
-	s.quitOnce.Do(func() {
-		close(s.channel)
-	})
 }
 
 // SubscribeRuntimeVersion subscribes the runtime version, returning a subscription that will
 // receive server notifications containing the RuntimeVersion.
 func (s *state) SubscribeRuntimeVersion() (
 	*RuntimeVersionSubscription, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), config.Default().SubscribeTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), client.ConfigOf(s.client).SubscribeTimeout)
 	defer cancel()
 
-	c := make(chan types.RuntimeVersion)
+	return s.SubscribeRuntimeVersionContext(ctx)
+}
 
-	sub, err := s.client.Subscribe(ctx, "state", "subscribeRuntimeVersion", "unsubscribeRuntimeVersion",
-		"runtimeVersion", c)
+// SubscribeRuntimeVersionContext subscribes the runtime version, returning a subscription that will
+// receive server notifications containing the RuntimeVersion. The context bounds the subscription request only,
+// the subscription itself lives until it is unsubscribed.
+func (s *state) SubscribeRuntimeVersionContext(ctx context.Context) (*RuntimeVersionSubscription, error) {
+	sub, err := client.SubscribeBuffered[types.RuntimeVersion](ctx, s.client, "state", "subscribeRuntimeVersion",
+		"unsubscribeRuntimeVersion", "runtimeVersion")
 	if err != nil {
 		return nil, err
 	}
 
-	return &RuntimeVersionSubscription{sub: sub, channel: c}, nil
+	return &RuntimeVersionSubscription{sub: sub}, nil
 }
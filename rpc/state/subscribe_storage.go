@@ -18,25 +18,21 @@ package state
 
 import (
 	"context"
-	"sync"
 
-	"github.com/centrifuge/go-substrate-rpc-client/v4/config"
-	gethrpc "github.com/centrifuge/go-substrate-rpc-client/v4/gethrpc"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
 )
 
 // StorageSubscription is a subscription established through one of the Client's subscribe methods.
 type StorageSubscription struct {
-	sub      *gethrpc.ClientSubscription
-	channel  chan types.StorageChangeSet
-	quitOnce sync.Once // ensures quit is closed once
+	sub *client.BufferedSubscription[types.StorageChangeSet]
 }
 
 // Chan returns the subscription channel.
 //
 // The channel is closed when Unsubscribe is called on the subscription.
 func (s *StorageSubscription) Chan() <-chan types.StorageChangeSet {
-	return s.channel
+	return s.sub.Chan()
 }
 
 // Err returns the subscription error channel. The intended use of Err is to schedule
@@ -51,37 +47,55 @@ func (s *StorageSubscription) Err() <-chan error {
 	return s.sub.Err()
 }
 
-// Unsubscribe unsubscribes the notification and closes the error channel.
+// Reconnected returns a channel that receives a value whenever the subscription has been re-established after the
+// connection to the node was lost. Notifications may have been missed in between. It only ever receives values if
+// the subscription was created through a reconnecting client, see client.ConnectWithReconnect.
+func (s *StorageSubscription) Reconnected() <-chan struct{} {
+	return s.sub.Reconnected()
+}
+
+// Dropped returns the number of notifications that have been dropped because the channel was full, see
+// client.SubscriptionOptions
+func (s *StorageSubscription) Dropped() uint64 {
+	return s.sub.Dropped()
+}
+
+// Unsubscribe unsubscribes the notification and closes the error and notification channels.
 // It can safely be called more than once.
 func (s *StorageSubscription) Unsubscribe() {
 	s.sub.Unsubscribe()
-	s.quitOnce.Do(func() {
-		close(s.channel)
-	})
 }
 
 // SubscribeStorageRaw subscribes the storage for the given keys, returning a subscription that will
 // receive server notifications containing the storage change sets.
 //
-// Slow subscribers will be dropped eventually. Client buffers up to 20000 notifications before considering the
-// subscriber dead. The subscription Err channel will receive ErrSubscriptionQueueOverflow. Use a sufficiently
-// large buffer on the channel or ensure that the channel usually has at least one reader to prevent this issue.
+// What happens to notifications a slow subscriber cannot keep up with is decided by the subscription buffer size and
+// overflow policy of the client config, see client.SubscriptionOptions. With the default blocking policy the client
+// buffers up to 20000 notifications before considering the subscriber dead, and the subscription Err channel will
+// receive ErrSubscriptionQueueOverflow.
 func (s *state) SubscribeStorageRaw(keys []types.StorageKey) (
 	*StorageSubscription, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), config.Default().SubscribeTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), client.ConfigOf(s.client).SubscribeTimeout)
 	defer cancel()
 
-	c := make(chan types.StorageChangeSet)
+	return s.SubscribeStorageRawContext(ctx, keys)
+}
 
+// SubscribeStorageRawContext subscribes the storage for the given keys, returning a subscription that will
+// receive server notifications containing the storage change sets. The context bounds the subscription request only,
+// the subscription itself lives until it is unsubscribed.
+func (s *state) SubscribeStorageRawContext(ctx context.Context, keys []types.StorageKey) (
+	*StorageSubscription, error) {
 	keyss := make([]string, len(keys))
 	for i := range keys {
 		keyss[i] = keys[i].Hex()
 	}
 
-	sub, err := s.client.Subscribe(ctx, "state", "subscribeStorage", "unsubscribeStorage", "storage", c, keyss)
+	sub, err := client.SubscribeBuffered[types.StorageChangeSet](ctx, s.client, "state", "subscribeStorage",
+		"unsubscribeStorage", "storage", keyss)
 	if err != nil {
 		return nil, err
 	}
 
-	return &StorageSubscription{sub: sub, channel: c}, nil
+	return &StorageSubscription{sub: sub}, nil
 }
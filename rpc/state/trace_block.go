@@ -0,0 +1,62 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+// TraceBlock re-executes the block with the given hash and returns the recorded trace. Targets is a comma separated
+// list of tracing targets, methods a comma separated list of runtime functions to trace, both use the defaults of
+// the node if they are empty. Only storage accesses to keys with one of the given prefixes are included. The node
+// must be started with the rpc-methods unsafe flag.
+func (s *state) TraceBlock(blockHash types.Hash, targets string, storageKeys []types.StorageKey, methods string) (
+	*types.TraceBlockResponse, error) {
+	return s.TraceBlockContext(context.Background(), blockHash, targets, storageKeys, methods)
+}
+
+// TraceBlockContext re-executes the block with the given hash and returns the recorded trace, see TraceBlock. The call
+// is aborted when ctx is done.
+func (s *state) TraceBlockContext(ctx context.Context, blockHash types.Hash, targets string,
+	storageKeys []types.StorageKey, methods string) (*types.TraceBlockResponse, error) {
+	// The node matches the keys as hex strings without prefix against the traced keys
+	keys := make([]string, len(storageKeys))
+	for i, key := range storageKeys {
+		keys[i] = hex.EncodeToString(key)
+	}
+
+	var res types.TraceBlockResponse
+	err := s.client.CallContext(ctx, &res, "state_traceBlock", blockHash.Hex(), optionalString(targets),
+		optionalString(strings.Join(keys, ",")), optionalString(methods))
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// optionalString returns the argument for an optional string, which is omitted if it is empty
+func optionalString(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
@@ -0,0 +1,73 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestState_TraceBlock(t *testing.T) {
+	prefix := types.StorageKey(codec.MustHexDecodeString("0x26aa394eea5630e07c48ae0c9558cef7"))
+	res, err := testState.TraceBlock(mockSrv.blockHashLatest, "", []types.StorageKey{prefix, {0x3a}}, "")
+	assert.NoError(t, err)
+	assert.True(t, res.IsBlockTrace)
+
+	// Empty filters are omitted, storage keys are passed without hex prefix
+	assert.Nil(t, mockSrv.traceBlockArgs[0])
+	assert.Equal(t, "26aa394eea5630e07c48ae0c9558cef7,3a", *mockSrv.traceBlockArgs[1])
+	assert.Nil(t, mockSrv.traceBlockArgs[2])
+
+	trace := res.AsBlockTrace
+	assert.Equal(t, mockSrv.blockHashLatest.Hex(), trace.BlockHash)
+	assert.Len(t, trace.Spans, 1)
+	assert.Nil(t, trace.Spans[0].ParentID)
+	assert.Len(t, trace.Events, 3)
+
+	accesses, err := trace.StorageAccesses()
+	assert.NoError(t, err)
+	assert.Len(t, accesses, 2)
+	assert.Equal(t, "Get", accesses[0].Method)
+	assert.Equal(t, types.StorageKey(codec.MustHexDecodeString(traceKey)), accesses[0].Key)
+	assert.True(t, accesses[0].HasValue)
+	assert.Equal(t, types.StorageDataRaw{0x01, 0x02}, accesses[0].Value)
+	assert.Equal(t, uint64(1), *accesses[0].ParentID)
+	assert.Equal(t, "Put", accesses[1].Method)
+	assert.False(t, accesses[1].HasValue)
+
+	var meta types.Metadata
+	err = codec.DecodeFromHex(types.MetadataV14Data, &meta)
+	assert.NoError(t, err)
+
+	pallet, item, err := accesses[0].StorageNames(&meta)
+	assert.NoError(t, err)
+	assert.Equal(t, types.Text("System"), pallet)
+	assert.Equal(t, types.Text("Account"), item)
+
+	_, _, err = meta.FindStorageNamesForKey(types.StorageKey{0x3a})
+	assert.Error(t, err)
+}
+
+func TestState_TraceBlock_TraceError(t *testing.T) {
+	res, err := testState.TraceBlock(types.Hash{0xff}, "pallet,frame", nil, "Put,Get")
+	assert.NoError(t, err)
+	assert.True(t, res.IsTraceError)
+	assert.Equal(t, "unknown block", res.AsTraceError.Error)
+}
@@ -0,0 +1,32 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package system
+
+import (
+	"context"
+)
+
+// AddLogFilter adds log filter directives to the node, using the syntax of the -l/--log flag of the node, e.g.
+// sync=debug,grandpa=trace. This is an unsafe RPC that the node only serves if unsafe RPCs are enabled.
+func (c *system) AddLogFilter(directives string) error {
+	return c.AddLogFilterContext(context.Background(), directives)
+}
+
+// AddLogFilterContext adds log filter directives to the node, the call is aborted when ctx is done
+func (c *system) AddLogFilterContext(ctx context.Context, directives string) error {
+	return c.client.CallContext(ctx, nil, "system_addLogFilter", directives)
+}
@@ -0,0 +1,38 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package system
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSystem_LogFilter(t *testing.T) {
+	assert.NoError(t, testSystem.AddLogFilter("sync=debug"))
+	assert.NoError(t, testSystem.AddLogFilter("grandpa=trace"))
+
+	mockSrv.mu.Lock()
+	assert.Equal(t, "sync=debug,grandpa=trace", mockSrv.logFilter)
+	mockSrv.mu.Unlock()
+
+	assert.NoError(t, testSystem.ResetLogFilter())
+
+	mockSrv.mu.Lock()
+	assert.Empty(t, mockSrv.logFilter)
+	mockSrv.mu.Unlock()
+}
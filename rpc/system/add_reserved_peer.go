@@ -0,0 +1,34 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package system
+
+import (
+	"context"
+)
+
+// AddReservedPeer adds a reserved peer to the node, given as a multiaddress that includes the peer ID, e.g.
+// /ip4/198.51.100.19/tcp/30333/p2p/12D3KooW... This is an unsafe RPC that the node only serves if unsafe RPCs are
+// enabled.
+func (c *system) AddReservedPeer(peer string) error {
+	return c.AddReservedPeerContext(context.Background(), peer)
+}
+
+// AddReservedPeerContext adds a reserved peer to the node, given as a multiaddress that includes the peer ID, the call
+// is aborted when ctx is done
+func (c *system) AddReservedPeerContext(ctx context.Context, peer string) error {
+	return c.client.CallContext(ctx, nil, "system_addReservedPeer", peer)
+}
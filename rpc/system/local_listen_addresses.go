@@ -0,0 +1,36 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package system
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+// LocalListenAddresses retrieves the multiaddresses the node listens on, including its peer ID, which other nodes
+// can use to connect to it
+func (c *system) LocalListenAddresses() ([]types.Text, error) {
+	return c.LocalListenAddressesContext(context.Background())
+}
+
+// LocalListenAddressesContext retrieves the multiaddresses the node listens on, the call is aborted when ctx is done
+func (c *system) LocalListenAddressesContext(ctx context.Context) ([]types.Text, error) {
+	var a []types.Text
+	err := c.client.CallContext(ctx, &a, "system_localListenAddresses")
+	return a, err
+}
@@ -17,12 +17,19 @@
 package system
 
 import (
+	"context"
+
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
 )
 
 // NetworkState retrieves the current state of the network
 func (c *system) NetworkState() (types.NetworkState, error) {
+	return c.NetworkStateContext(context.Background())
+}
+
+// NetworkStateContext retrieves the current state of the network, the call is aborted when ctx is done
+func (c *system) NetworkStateContext(ctx context.Context) (types.NetworkState, error) {
 	var n types.NetworkState
-	err := c.client.Call(&n, "system_networkState")
+	err := c.client.CallContext(ctx, &n, "system_networkState")
 	return n, err
 }
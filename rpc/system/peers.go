@@ -17,12 +17,19 @@
 package system
 
 import (
+	"context"
+
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
 )
 
 // Peers retrieves the currently connected peers
 func (c *system) Peers() ([]types.PeerInfo, error) {
+	return c.PeersContext(context.Background())
+}
+
+// PeersContext retrieves the currently connected peers, the call is aborted when ctx is done
+func (c *system) PeersContext(ctx context.Context) ([]types.PeerInfo, error) {
 	var p []types.PeerInfo
-	err := c.client.Call(&p, "system_peers")
+	err := c.client.CallContext(ctx, &p, "system_peers")
 	return p, err
 }
@@ -17,12 +17,20 @@
 package system
 
 import (
+	"context"
+
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
 )
 
 // Properties retrieves a custom set of properties as a JSON object, defined in the chain spec
 func (c *system) Properties() (types.ChainProperties, error) {
+	return c.PropertiesContext(context.Background())
+}
+
+// PropertiesContext retrieves a custom set of properties as a JSON object, defined in the chain spec, the call is
+// aborted when ctx is done
+func (c *system) PropertiesContext(ctx context.Context) (types.ChainProperties, error) {
 	var p types.ChainProperties
-	err := c.client.Call(&p, "system_properties")
+	err := c.client.CallContext(ctx, &p, "system_properties")
 	return p, err
 }
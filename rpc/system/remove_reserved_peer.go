@@ -0,0 +1,33 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package system
+
+import (
+	"context"
+)
+
+// RemoveReservedPeer removes the reserved peer with the given peer ID from the node. This is an unsafe RPC that the
+// node only serves if unsafe RPCs are enabled.
+func (c *system) RemoveReservedPeer(peerID string) error {
+	return c.RemoveReservedPeerContext(context.Background(), peerID)
+}
+
+// RemoveReservedPeerContext removes the reserved peer with the given peer ID from the node, the call is aborted when
+// ctx is done
+func (c *system) RemoveReservedPeerContext(ctx context.Context, peerID string) error {
+	return c.client.CallContext(ctx, nil, "system_removeReservedPeer", peerID)
+}
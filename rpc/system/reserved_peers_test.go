@@ -0,0 +1,44 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package system
+
+import (
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSystem_ReservedPeers(t *testing.T) {
+	peerID := "12D3KooWRpzRTivvJ5ySvgbFnPeEE6rDhitQKL1fFJvvBGhnenSk"
+
+	err := testSystem.AddReservedPeer("/ip4/198.51.100.19/tcp/30333/p2p/" + peerID)
+	assert.NoError(t, err)
+
+	err = testSystem.AddReservedPeer("/ip4/198.51.100.19/tcp/30333")
+	assert.Error(t, err)
+
+	p, err := testSystem.ReservedPeers()
+	assert.NoError(t, err)
+	assert.Equal(t, []types.Text{types.Text(peerID)}, p)
+
+	assert.NoError(t, testSystem.RemoveReservedPeer(peerID))
+
+	p, err = testSystem.ReservedPeers()
+	assert.NoError(t, err)
+	assert.Empty(t, p)
+}
@@ -19,18 +19,47 @@
 package system
 
 import (
+	"context"
+
 	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
 )
 
 type System interface {
 	Properties() (types.ChainProperties, error)
+	PropertiesContext(ctx context.Context) (types.ChainProperties, error)
 	Health() (types.Health, error)
+	HealthContext(ctx context.Context) (types.Health, error)
 	Peers() ([]types.PeerInfo, error)
+	PeersContext(ctx context.Context) ([]types.PeerInfo, error)
 	Name() (types.Text, error)
+	NameContext(ctx context.Context) (types.Text, error)
 	Chain() (types.Text, error)
+	ChainContext(ctx context.Context) (types.Text, error)
 	Version() (types.Text, error)
+	VersionContext(ctx context.Context) (types.Text, error)
 	NetworkState() (types.NetworkState, error)
+	NetworkStateContext(ctx context.Context) (types.NetworkState, error)
+	AccountNextIndex(address string) (types.U32, error)
+	AccountNextIndexContext(ctx context.Context, address string) (types.U32, error)
+	SyncState() (types.SyncState, error)
+	SyncStateContext(ctx context.Context) (types.SyncState, error)
+	NodeRoles() ([]types.NodeRole, error)
+	NodeRolesContext(ctx context.Context) ([]types.NodeRole, error)
+	LocalPeerID() (types.Text, error)
+	LocalPeerIDContext(ctx context.Context) (types.Text, error)
+	LocalListenAddresses() ([]types.Text, error)
+	LocalListenAddressesContext(ctx context.Context) ([]types.Text, error)
+	AddReservedPeer(peer string) error
+	AddReservedPeerContext(ctx context.Context, peer string) error
+	RemoveReservedPeer(peerID string) error
+	RemoveReservedPeerContext(ctx context.Context, peerID string) error
+	ReservedPeers() ([]types.Text, error)
+	ReservedPeersContext(ctx context.Context) ([]types.Text, error)
+	AddLogFilter(directives string) error
+	AddLogFilterContext(ctx context.Context, directives string) error
+	ResetLogFilter() error
+	ResetLogFilterContext(ctx context.Context) error
 }
 
 // system exposes methods for retrieval of system data
@@ -17,7 +17,10 @@
 package system
 
 import (
+	"errors"
 	"os"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
@@ -48,13 +51,21 @@ func TestMain(m *testing.M) {
 
 // MockSrv holds data and methods exposed by the RPC Mock Server used in integration tests
 type MockSrv struct {
-	chain        types.Text
-	health       types.Health
-	name         types.Text
-	networkState types.NetworkState
-	peers        []types.PeerInfo
-	properties   types.ChainProperties
-	version      types.Text
+	chain           types.Text
+	health          types.Health
+	name            types.Text
+	networkState    types.NetworkState
+	peers           []types.PeerInfo
+	properties      types.ChainProperties
+	version         types.Text
+	nonces          map[string]types.U32
+	syncState       types.SyncState
+	localPeerID     types.Text
+	listenAddresses []types.Text
+
+	mu            sync.Mutex
+	reservedPeers []types.Text
+	logFilter     string
 }
 
 func (s *MockSrv) Chain() types.Text {
@@ -85,6 +96,78 @@ func (s *MockSrv) Version() types.Text {
 	return mockSrv.version
 }
 
+func (s *MockSrv) AccountNextIndex(address string) (types.U32, error) {
+	n, ok := mockSrv.nonces[address]
+	if !ok {
+		return 0, errors.New("invalid address")
+	}
+	return n, nil
+}
+
+func (s *MockSrv) SyncState() types.SyncState {
+	return mockSrv.syncState
+}
+
+func (s *MockSrv) NodeRoles() []string {
+	return []string{"Full", "Authority"}
+}
+
+func (s *MockSrv) LocalPeerId() types.Text { //nolint:revive,stylecheck
+	return mockSrv.localPeerID
+}
+
+func (s *MockSrv) LocalListenAddresses() []types.Text {
+	return mockSrv.listenAddresses
+}
+
+func (s *MockSrv) AddReservedPeer(peer string) error {
+	i := strings.LastIndex(peer, "/p2p/")
+	if i < 0 {
+		return errors.New("peer id missing")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reservedPeers = append(s.reservedPeers, types.Text(peer[i+len("/p2p/"):]))
+	return nil
+}
+
+func (s *MockSrv) RemoveReservedPeer(peerID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, p := range s.reservedPeers {
+		if string(p) == peerID {
+			s.reservedPeers = append(s.reservedPeers[:i], s.reservedPeers[i+1:]...)
+			return
+		}
+	}
+}
+
+func (s *MockSrv) ReservedPeers() []types.Text {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]types.Text{}, s.reservedPeers...)
+}
+
+func (s *MockSrv) AddLogFilter(directives string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.logFilter != "" {
+		s.logFilter += ","
+	}
+	s.logFilter += directives
+}
+
+func (s *MockSrv) ResetLogFilter() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.logFilter = ""
+}
+
 // mockSrv sets default data used in tests. This data might become stale when substrate is updated – just run the tests
 // against real servers and update the values stored here. To do that, replace s.URL with
 // config.Default().RPCURL
@@ -95,7 +178,12 @@ var mockSrv = MockSrv{
 	networkState: types.NetworkState{PeerID: "my-peer-id"},
 	peers: []types.PeerInfo{{PeerID: "another-peer-id", Roles: "Role", ProtocolVersion: 42,
 		BestHash: types.NewHash(codec.MustHexDecodeString("0xabcd")), BestNumber: 420}},
-	properties: types.ChainProperties{IsTokenDecimals: true, AsTokenDecimals: 18,
-		IsTokenSymbol: true, AsTokenSymbol: "GSRPCCOIN"},
-	version: "My version",
+	properties:  types.ChainProperties{TokenDecimals: 18, TokenSymbol: "GSRPCCOIN"},
+	version:     "My version",
+	nonces:      map[string]types.U32{"5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY": 7},
+	syncState:   types.SyncState{StartingBlock: 0, CurrentBlock: 4200, HighestBlock: 4242},
+	localPeerID: "12D3KooWEyoppNCUx8Yx66oV9fJnriXwCcXwDDUA2kj6vnc6iDEp",
+	listenAddresses: []types.Text{
+		"/ip4/127.0.0.1/tcp/30333/p2p/12D3KooWEyoppNCUx8Yx66oV9fJnriXwCcXwDDUA2kj6vnc6iDEp",
+	},
 }
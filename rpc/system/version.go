@@ -17,12 +17,19 @@
 package system
 
 import (
+	"context"
+
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
 )
 
 // Version retrieves the version of the node
 func (c *system) Version() (types.Text, error) {
+	return c.VersionContext(context.Background())
+}
+
+// VersionContext retrieves the version of the node, the call is aborted when ctx is done
+func (c *system) VersionContext(ctx context.Context) (types.Text, error) {
 	var t types.Text
-	err := c.client.Call(&t, "system_version")
+	err := c.client.CallContext(ctx, &t, "system_version")
 	return t, err
 }
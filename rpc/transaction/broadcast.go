@@ -0,0 +1,50 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transaction
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+// Broadcast makes the node gossip the extrinsic to its peers until it is included in a finalized block or Stop is
+// called, returning the ID of the broadcast operation. It returns ErrBroadcastLimitReached if the node does not accept
+// more broadcasts. Unlike author_submitExtrinsic, the extrinsic is not validated and the outcome is not reported.
+func (t *transaction) Broadcast(xt types.Extrinsic) (string, error) {
+	return t.BroadcastContext(context.Background(), xt)
+}
+
+// BroadcastContext makes the node gossip the extrinsic, see Broadcast. The call is aborted when ctx is done.
+func (t *transaction) BroadcastContext(ctx context.Context, xt types.Extrinsic) (string, error) {
+	enc, err := codec.EncodeToHex(xt)
+	if err != nil {
+		return "", err
+	}
+
+	var res *string
+	if err := t.client.CallContext(ctx, &res, "transaction_v1_broadcast", enc); err != nil {
+		return "", err
+	}
+
+	if res == nil {
+		return "", ErrBroadcastLimitReached
+	}
+
+	return *res, nil
+}
@@ -0,0 +1,31 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transaction
+
+import (
+	"context"
+)
+
+// Stop stops a broadcast operation started by Broadcast
+func (t *transaction) Stop(operationID string) error {
+	return t.StopContext(context.Background(), operationID)
+}
+
+// StopContext stops a broadcast operation, see Stop. The call is aborted when ctx is done.
+func (t *transaction) StopContext(ctx context.Context, operationID string) error {
+	return t.client.CallContext(ctx, nil, "transaction_v1_stop", operationID)
+}
@@ -0,0 +1,47 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate mockery --name Transaction --filename transaction.go
+
+package transaction
+
+import (
+	"context"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
+	libErr "github.com/centrifuge/go-substrate-rpc-client/v4/error"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+const ErrBroadcastLimitReached = libErr.Error("broadcast limit reached")
+
+// Transaction exposes the transaction_v1 methods of the new JSON-RPC interface
+type Transaction interface {
+	Broadcast(xt types.Extrinsic) (string, error)
+	BroadcastContext(ctx context.Context, xt types.Extrinsic) (string, error)
+	Stop(operationID string) error
+	StopContext(ctx context.Context, operationID string) error
+}
+
+// transaction exposes methods for broadcasting transactions
+type transaction struct {
+	client client.Client
+}
+
+// NewTransaction creates a new transaction struct
+func NewTransaction(cl client.Client) Transaction {
+	return &transaction{cl}
+}
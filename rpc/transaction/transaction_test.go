@@ -0,0 +1,111 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transaction
+
+import (
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/rpcmocksrv"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+	"github.com/stretchr/testify/assert"
+)
+
+var testTransaction Transaction
+
+func TestMain(m *testing.M) {
+	s := rpcmocksrv.New()
+	err := s.RegisterName("transaction", &mockSrv)
+	if err != nil {
+		panic(err)
+	}
+
+	cl, err := client.Connect(s.URL)
+	if err != nil {
+		panic(err)
+	}
+	testTransaction = NewTransaction(cl)
+
+	os.Exit(m.Run())
+}
+
+// MockSrv holds data and methods exposed by the RPC Mock Server used in integration tests
+type MockSrv struct {
+	mu sync.Mutex
+
+	limitReached bool
+	broadcasted  []string
+	stopped      []string
+}
+
+func (s *MockSrv) V1_broadcast(xt string) *string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.limitReached {
+		return nil
+	}
+
+	s.broadcasted = append(s.broadcasted, xt)
+	id := "broadcast-1"
+	return &id
+}
+
+func (s *MockSrv) V1_stop(operationID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stopped = append(s.stopped, operationID)
+}
+
+var mockSrv = MockSrv{}
+
+func TestTransaction_Broadcast(t *testing.T) {
+	xt := types.Extrinsic{Version: 0x04, Method: types.Call{CallIndex: types.CallIndex{SectionIndex: 6, MethodIndex: 0}}}
+
+	id, err := testTransaction.Broadcast(xt)
+	assert.NoError(t, err)
+	assert.Equal(t, "broadcast-1", id)
+
+	enc, err := codec.EncodeToHex(xt)
+	assert.NoError(t, err)
+
+	mockSrv.mu.Lock()
+	assert.Equal(t, []string{enc}, mockSrv.broadcasted)
+	mockSrv.limitReached = true
+	mockSrv.mu.Unlock()
+
+	defer func() {
+		mockSrv.mu.Lock()
+		mockSrv.limitReached = false
+		mockSrv.mu.Unlock()
+	}()
+
+	_, err = testTransaction.Broadcast(xt)
+	assert.ErrorIs(t, err, ErrBroadcastLimitReached)
+}
+
+func TestTransaction_Stop(t *testing.T) {
+	assert.NoError(t, testTransaction.Stop("broadcast-1"))
+
+	mockSrv.mu.Lock()
+	defer mockSrv.mu.Unlock()
+	assert.Equal(t, []string{"broadcast-1"}, mockSrv.stopped)
+}
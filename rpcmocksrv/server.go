@@ -18,6 +18,7 @@ package rpcmocksrv
 
 import (
 	"math/rand"
+	"net"
 	"strconv"
 	"time"
 
@@ -30,6 +31,8 @@ type Server struct {
 	Host string
 	// URL consists of protocol, hostname and port
 	URL string
+
+	listener net.Listener
 }
 
 // New creates a new RPC mock server with a random port that allows registration of services
@@ -37,18 +40,31 @@ func New() *Server {
 	port := randomPort()
 	host := "localhost:" + strconv.Itoa(port)
 
-	_, rpcServ, err := gethrpc.StartWSEndpoint(host, []gethrpc.API{}, []string{}, []string{"*"}, true)
+	return NewAt(host)
+}
+
+// NewAt creates a new RPC mock server listening on the given host, which allows to restart a server that has been
+// closed on the same address
+func NewAt(host string) *Server {
+	listener, rpcServ, err := gethrpc.StartWSEndpoint(host, []gethrpc.API{}, []string{}, []string{"*"}, true)
 	if err != nil {
 		panic(err)
 	}
 	s := Server{
-		Server: rpcServ,
-		Host:   host,
-		URL:    "ws://" + host,
+		Server:   rpcServ,
+		Host:     host,
+		URL:      "ws://" + host,
+		listener: listener,
 	}
 	return &s
 }
 
+// Close stops accepting connections and drops all open connections, similar to a node that is shut down
+func (s *Server) Close() {
+	s.listener.Close()
+	s.Server.Stop()
+}
+
 //nolint:gosec
 func randomPort() int {
 	rand.Seed(time.Now().UnixNano())
@@ -0,0 +1,9 @@
+package trie
+
+import libErr "github.com/centrifuge/go-substrate-rpc-client/v4/error"
+
+const (
+	ErrIncompleteProof = libErr.Error("incomplete proof")
+	ErrInvalidNode     = libErr.Error("invalid trie node")
+	ErrDuplicateKey    = libErr.Error("duplicate key")
+)
@@ -0,0 +1,250 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trie
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/scale"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+// hashLength is the length of the blake2b-256 node hashes. Nodes with a shorter encoding are inlined in their parent.
+const hashLength = 32
+
+// The first bits of the encoded node header determine the kind of the node, the remaining bits hold the number of
+// nibbles of the partial key
+const (
+	emptyTrie               = 0x00
+	leafPrefix              = 0b01 << 6
+	branchNoValuePrefix     = 0b10 << 6
+	branchWithValuePrefix   = 0b11 << 6
+	hashedValueLeafPrefix   = 0b001 << 5
+	hashedValueBranchPrefix = 0b0001 << 4
+)
+
+// node is a decoded trie node. Children are either the hash of the child node or, if its encoding is shorter than a
+// hash, the encoded child node itself.
+type node struct {
+	partial   []byte
+	branch    bool
+	hasValue  bool
+	value     []byte
+	valueHash *types.Hash
+	children  [16][]byte
+}
+
+// encode returns the encoding of the node
+func (n *node) encode() []byte {
+	var buf bytes.Buffer
+
+	switch {
+	case !n.branch && n.valueHash != nil:
+		writeHeader(&buf, hashedValueLeafPrefix, 3, len(n.partial))
+	case !n.branch:
+		writeHeader(&buf, leafPrefix, 2, len(n.partial))
+	case n.valueHash != nil:
+		writeHeader(&buf, hashedValueBranchPrefix, 4, len(n.partial))
+	case n.hasValue:
+		writeHeader(&buf, branchWithValuePrefix, 2, len(n.partial))
+	default:
+		writeHeader(&buf, branchNoValuePrefix, 2, len(n.partial))
+	}
+
+	if len(n.partial)%2 == 1 {
+		buf.WriteByte(n.partial[0])
+	}
+	for i := len(n.partial) % 2; i < len(n.partial); i += 2 {
+		buf.WriteByte(n.partial[i]<<4 | n.partial[i+1])
+	}
+
+	if n.branch {
+		var bitmap uint16
+		for i, child := range n.children {
+			if child != nil {
+				bitmap |= 1 << i
+			}
+		}
+		_ = binary.Write(&buf, binary.LittleEndian, bitmap)
+	}
+
+	if n.valueHash != nil {
+		buf.Write(n.valueHash[:])
+	} else if n.hasValue {
+		writeBytes(&buf, n.value)
+	}
+
+	if n.branch {
+		for _, child := range n.children {
+			if child != nil {
+				writeBytes(&buf, child)
+			}
+		}
+	}
+
+	return buf.Bytes()
+}
+
+// writeHeader writes the node header with the given prefix, which occupies the first prefixBits bits, and the number
+// of nibbles of the partial key. Sizes that do not fit into the remaining bits continue in the following bytes.
+func writeHeader(buf *bytes.Buffer, prefix byte, prefixBits uint, size int) {
+	max := 0xff >> prefixBits
+	if size < max {
+		buf.WriteByte(prefix | byte(size))
+		return
+	}
+
+	buf.WriteByte(prefix | byte(max))
+	rem := size - max + 1
+	for rem >= 256 {
+		buf.WriteByte(0xff)
+		rem -= 255
+	}
+	buf.WriteByte(byte(rem - 1))
+}
+
+// writeBytes writes b with a compact length prefix
+func writeBytes(buf *bytes.Buffer, b []byte) {
+	_ = scale.NewEncoder(buf).Encode(b)
+}
+
+// decodeNode decodes an encoded trie node. The empty trie is returned as nil.
+func decodeNode(enc []byte) (*node, error) {
+	r := bytes.NewReader(enc)
+
+	first, err := r.ReadByte()
+	if err != nil {
+		return nil, ErrInvalidNode.Wrap(err)
+	}
+
+	var n node
+	var size int
+	switch {
+	case first == emptyTrie:
+		if r.Len() != 0 {
+			return nil, ErrInvalidNode.WithMsg("trailing bytes after empty trie")
+		}
+		return nil, nil
+	case first&0b1100_0000 == leafPrefix:
+		size, err = readSize(r, first, 2)
+	case first&0b1100_0000 == branchNoValuePrefix:
+		n.branch = true
+		size, err = readSize(r, first, 2)
+	case first&0b1100_0000 == branchWithValuePrefix:
+		n.branch, n.hasValue = true, true
+		size, err = readSize(r, first, 2)
+	case first&0b1110_0000 == hashedValueLeafPrefix:
+		n.hasValue, n.valueHash = true, &types.Hash{}
+		size, err = readSize(r, first, 3)
+	case first&0b1111_0000 == hashedValueBranchPrefix:
+		n.branch, n.hasValue, n.valueHash = true, true, &types.Hash{}
+		size, err = readSize(r, first, 4)
+	default:
+		return nil, ErrInvalidNode.WithMsg("unknown header %#x", first)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	partial := make([]byte, (size+1)/2)
+	if _, err := io.ReadFull(r, partial); err != nil {
+		return nil, ErrInvalidNode.Wrap(err)
+	}
+	if size%2 == 1 && partial[0]&0xf0 != 0 {
+		return nil, ErrInvalidNode.WithMsg("invalid padding of partial key")
+	}
+	n.partial = toNibbles(partial)[size%2:]
+
+	var bitmap uint16
+	if n.branch {
+		if err := binary.Read(r, binary.LittleEndian, &bitmap); err != nil {
+			return nil, ErrInvalidNode.Wrap(err)
+		}
+	}
+
+	switch {
+	case n.valueHash != nil:
+		if _, err := io.ReadFull(r, n.valueHash[:]); err != nil {
+			return nil, ErrInvalidNode.Wrap(err)
+		}
+	case !n.branch:
+		n.hasValue = true
+		fallthrough
+	case n.hasValue:
+		if n.value, err = readBytes(r); err != nil {
+			return nil, err
+		}
+	}
+
+	for i := range n.children {
+		if bitmap&(1<<i) == 0 {
+			continue
+		}
+		child, err := readBytes(r)
+		if err != nil {
+			return nil, err
+		}
+		if len(child) == 0 || len(child) > hashLength {
+			return nil, ErrInvalidNode.WithMsg("child reference of %d bytes", len(child))
+		}
+		n.children[i] = child
+	}
+
+	if r.Len() != 0 {
+		return nil, ErrInvalidNode.WithMsg("%d trailing bytes", r.Len())
+	}
+
+	return &n, nil
+}
+
+// readSize reads the number of nibbles of the partial key, prefixBits is the number of bits of the header prefix
+func readSize(r *bytes.Reader, first byte, prefixBits uint) (int, error) {
+	max := 0xff >> prefixBits
+	size := int(first) & max
+	if size < max {
+		return size, nil
+	}
+
+	size--
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, ErrInvalidNode.Wrap(err)
+		}
+		if b < 0xff {
+			return size + int(b) + 1, nil
+		}
+		size += 255
+	}
+}
+
+// readBytes reads a byte slice with a compact length prefix
+func readBytes(r *bytes.Reader) ([]byte, error) {
+	l, err := scale.NewDecoder(r).DecodeUintCompact()
+	if err != nil {
+		return nil, ErrInvalidNode.Wrap(err)
+	}
+	if !l.IsUint64() || l.Uint64() > uint64(r.Len()) {
+		return nil, ErrInvalidNode.WithMsg("length %s exceeds the node", l)
+	}
+
+	b := make([]byte, l.Uint64())
+	_, _ = r.Read(b)
+	return b, nil
+}
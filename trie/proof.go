@@ -0,0 +1,152 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trie
+
+import (
+	"bytes"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"golang.org/x/crypto/blake2b"
+)
+
+// VerifyProof verifies the proof of the given keys against the state root, which is usually the StateRoot of a block
+// header, and returns their values in the order of the keys. The value of a key that is proven to be absent is nil.
+// ErrIncompleteProof is returned if a node that is required to look up a key is missing from the proof, which is
+// also the case if a node of the proof was tampered with.
+func VerifyProof(root types.Hash, proof []types.Bytes, keys []types.StorageKey) ([]*types.StorageDataRaw, error) {
+	db := newProofDB(proof)
+
+	values := make([]*types.StorageDataRaw, len(keys))
+	for i, key := range keys {
+		value, err := db.lookup(root, key)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+
+	return values, nil
+}
+
+// VerifyChildProof verifies the proof of the given keys of a child storage against the state root, as returned by
+// state_getChildReadProof. The root of the child trie is looked up in the state with the prefixed childStorageKey,
+// the values are returned like with VerifyProof.
+func VerifyChildProof(root types.Hash, proof []types.Bytes, childStorageKey types.StorageKey,
+	keys []types.StorageKey) ([]*types.StorageDataRaw, error) {
+	db := newProofDB(proof)
+
+	childRoot, err := db.lookup(root, childStorageKey)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]*types.StorageDataRaw, len(keys))
+	if childRoot == nil {
+		// The child trie is empty, so none of the keys exist
+		return values, nil
+	}
+	if len(*childRoot) != hashLength {
+		return nil, ErrInvalidNode.WithMsg("child trie root of %d bytes", len(*childRoot))
+	}
+
+	for i, key := range keys {
+		value, err := db.lookup(types.NewHash(*childRoot), key)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+
+	return values, nil
+}
+
+// proofDB holds the nodes of a proof by their hash
+type proofDB map[types.Hash][]byte
+
+func newProofDB(proof []types.Bytes) proofDB {
+	db := make(proofDB, len(proof))
+	for _, node := range proof {
+		db[blake2b.Sum256(node)] = node
+	}
+	return db
+}
+
+func (db proofDB) get(hash types.Hash) ([]byte, error) {
+	enc, ok := db[hash]
+	if !ok {
+		return nil, ErrIncompleteProof.WithMsg("missing node %#x", hash[:])
+	}
+	return enc, nil
+}
+
+// lookup returns the value of the key in the trie with the given root, or nil if the key does not exist
+func (db proofDB) lookup(root types.Hash, key types.StorageKey) (*types.StorageDataRaw, error) {
+	enc, err := db.get(root)
+	if err != nil {
+		return nil, err
+	}
+
+	nibbles := toNibbles(key)
+	for {
+		n, err := decodeNode(enc)
+		if err != nil {
+			return nil, err
+		}
+		if n == nil || !bytes.HasPrefix(nibbles, n.partial) {
+			return nil, nil
+		}
+		nibbles = nibbles[len(n.partial):]
+
+		if len(nibbles) == 0 {
+			return db.value(n)
+		}
+		if !n.branch {
+			return nil, nil
+		}
+
+		child := n.children[nibbles[0]]
+		nibbles = nibbles[1:]
+		switch len(child) {
+		case 0:
+			return nil, nil
+		case hashLength:
+			if enc, err = db.get(types.NewHash(child)); err != nil {
+				return nil, err
+			}
+		default:
+			enc = child
+		}
+	}
+}
+
+// value returns the value of the node, which is looked up in the proof if it is stored in a separate node
+func (db proofDB) value(n *node) (*types.StorageDataRaw, error) {
+	if !n.hasValue {
+		return nil, nil
+	}
+
+	value := n.value
+	if n.valueHash != nil {
+		var err error
+		if value, err = db.get(*n.valueHash); err != nil {
+			return nil, err
+		}
+	}
+
+	raw := types.NewStorageDataRaw(value)
+	return &raw, nil
+}
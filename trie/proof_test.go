@@ -0,0 +1,156 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trie
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// testTrie builds a trie with the given entries and returns its root and all of its hashed nodes
+func testTrie(t *testing.T, entries []Entry, version StateVersion) (types.Hash, []types.Bytes) {
+	var nodes []types.Bytes
+	root, err := build(entries, version, func(_ types.Hash, enc []byte) {
+		nodes = append(nodes, enc)
+	})
+	assert.NoError(t, err)
+	return root, nodes
+}
+
+func testEntries() []Entry {
+	entries := []Entry{
+		{Key: []byte{}, Value: []byte("root")},
+		{Key: []byte(":code"), Value: bytes.Repeat([]byte{0xc0}, 100)},
+		{Key: []byte("a"), Value: []byte{}},
+		{Key: []byte("ab"), Value: []byte("prefix of another key")},
+		{Key: []byte("abc"), Value: bytes.Repeat([]byte{0xab}, 33)},
+	}
+	for i := 0; i < 200; i++ {
+		key := make([]byte, 36)
+		binary.BigEndian.PutUint32(key, uint32(i*7919))
+		copy(key[4:], "System Account ..")
+		entries = append(entries, Entry{Key: key, Value: bytes.Repeat([]byte{byte(i)}, i%70)})
+	}
+	return entries
+}
+
+func TestVerifyProof(t *testing.T) {
+	entries := testEntries()
+
+	for _, version := range []StateVersion{StateVersionV0, StateVersionV1} {
+		root, proof := testTrie(t, entries, version)
+
+		keys := make([]types.StorageKey, len(entries))
+		for i, e := range entries {
+			keys[i] = e.Key
+		}
+		keys = append(keys, []byte("abcd"), []byte("b"), []byte(":cod"))
+
+		values, err := VerifyProof(root, proof, keys)
+		assert.NoError(t, err)
+		for i, e := range entries {
+			if assert.NotNil(t, values[i]) {
+				assert.Equal(t, e.Value, *values[i])
+			}
+		}
+		assert.Equal(t, []*types.StorageDataRaw{nil, nil, nil}, values[len(entries):])
+	}
+}
+
+func TestVerifyProof_Empty(t *testing.T) {
+	root, proof := testTrie(t, nil, StateVersionV1)
+
+	values, err := VerifyProof(root, proof, []types.StorageKey{{0x01}})
+	assert.NoError(t, err)
+	assert.Equal(t, []*types.StorageDataRaw{nil}, values)
+}
+
+func TestVerifyProof_Incomplete(t *testing.T) {
+	entries := testEntries()
+	root, proof := testTrie(t, entries, StateVersionV1)
+
+	// The value of :code is stored in a separate node
+	var withoutCode []types.Bytes
+	for _, node := range proof {
+		if !bytes.Equal(node, entries[1].Value) {
+			withoutCode = append(withoutCode, node)
+		}
+	}
+	assert.Len(t, withoutCode, len(proof)-1)
+
+	values, err := VerifyProof(root, withoutCode, []types.StorageKey{[]byte("a")})
+	assert.NoError(t, err)
+	assert.Equal(t, []*types.StorageDataRaw{&entries[2].Value}, values)
+
+	_, err = VerifyProof(root, withoutCode, []types.StorageKey{[]byte(":code")})
+	assert.ErrorIs(t, err, ErrIncompleteProof)
+
+	// The root node is recorded last
+	_, err = VerifyProof(root, proof[:len(proof)-1], []types.StorageKey{[]byte("a")})
+	assert.ErrorIs(t, err, ErrIncompleteProof)
+
+	_, err = VerifyProof(types.Hash{0x01}, proof, []types.StorageKey{[]byte("a")})
+	assert.ErrorIs(t, err, ErrIncompleteProof)
+}
+
+func TestVerifyProof_Tampered(t *testing.T) {
+	entries := testEntries()
+	root, proof := testTrie(t, entries, StateVersionV0)
+
+	keys := make([]types.StorageKey, len(entries))
+	for i, e := range entries {
+		keys[i] = e.Key
+	}
+
+	for i := range proof {
+		tampered := make([]types.Bytes, len(proof))
+		copy(tampered, proof)
+		tampered[i] = append(types.Bytes{}, proof[i]...)
+		tampered[i][len(tampered[i])-1] ^= 0x01
+
+		_, err := VerifyProof(root, tampered, keys)
+		assert.ErrorIs(t, err, ErrIncompleteProof)
+	}
+}
+
+func TestVerifyChildProof(t *testing.T) {
+	childEntries := testEntries()
+	childRoot, childProof := testTrie(t, childEntries, StateVersionV1)
+
+	childStorageKey := types.StorageKey(":child_storage:default:child")
+	root, proof := testTrie(t, []Entry{
+		{Key: childStorageKey, Value: childRoot[:]},
+		{Key: []byte(":code"), Value: []byte{0x01}},
+	}, StateVersionV1)
+	proof = append(proof, childProof...)
+
+	values, err := VerifyChildProof(root, proof, childStorageKey, []types.StorageKey{childEntries[3].Key, {0x02}})
+	assert.NoError(t, err)
+	assert.Equal(t, []*types.StorageDataRaw{&childEntries[3].Value, nil}, values)
+
+	values, err = VerifyChildProof(root, proof, types.StorageKey(":child_storage:default:other"),
+		[]types.StorageKey{childEntries[3].Key})
+	assert.NoError(t, err)
+	assert.Equal(t, []*types.StorageDataRaw{nil}, values)
+
+	_, err = VerifyChildProof(root, proof, types.StorageKey(":code"), []types.StorageKey{childEntries[3].Key})
+	assert.ErrorIs(t, err, ErrInvalidNode)
+}
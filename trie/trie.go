@@ -0,0 +1,162 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trie
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"golang.org/x/crypto/blake2b"
+)
+
+// StateVersion is the layout of the Substrate base-16 Patricia trie, as announced by the state version of the runtime
+type StateVersion uint8
+
+const (
+	// StateVersionV0 stores all values inline in the trie nodes
+	StateVersionV0 StateVersion = iota
+	// StateVersionV1 stores values that are longer than 32 bytes in separate nodes, which are referenced by their hash
+	StateVersionV1
+)
+
+// maxInlineValue is the maximum length of a value that is stored inline with StateVersionV1
+const maxInlineValue = 32
+
+// EmptyRoot is the root of a trie without any entries
+var EmptyRoot = types.Hash(blake2b.Sum256([]byte{emptyTrie}))
+
+// Entry is a key/value pair of a trie
+type Entry struct {
+	Key   types.StorageKey
+	Value types.StorageDataRaw
+}
+
+// Root computes the root of the trie that contains the given entries with the given layout
+func Root(entries []Entry, version StateVersion) (types.Hash, error) {
+	return build(entries, version, func(types.Hash, []byte) {})
+}
+
+// nibbleEntry is an entry with its key split into nibbles
+type nibbleEntry struct {
+	raw   types.StorageKey
+	key   []byte
+	value []byte
+}
+
+// build computes the root of the trie that contains the given entries. Record is called for each node that is
+// referenced by its hash, including the root node and values that are stored in separate nodes.
+func build(entries []Entry, version StateVersion, record func(types.Hash, []byte)) (types.Hash, error) {
+	sorted := make([]nibbleEntry, len(entries))
+	for i, e := range entries {
+		sorted[i] = nibbleEntry{raw: e.Key, key: toNibbles(e.Key), value: e.Value}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].key, sorted[j].key) < 0
+	})
+
+	for i := 1; i < len(sorted); i++ {
+		if bytes.Equal(sorted[i-1].key, sorted[i].key) {
+			return types.Hash{}, ErrDuplicateKey.WithMsg("%#x", []byte(sorted[i].raw))
+		}
+	}
+
+	b := builder{version: version, record: record}
+
+	var enc []byte
+	if len(sorted) == 0 {
+		enc = []byte{emptyTrie}
+	} else {
+		enc = b.node(sorted, 0)
+	}
+
+	root := types.Hash(blake2b.Sum256(enc))
+	record(root, enc)
+
+	return root, nil
+}
+
+type builder struct {
+	version StateVersion
+	record  func(types.Hash, []byte)
+}
+
+// node returns the encoded node for the given entries, which are sorted and share the first depth nibbles of their
+// keys
+func (b *builder) node(entries []nibbleEntry, depth int) []byte {
+	if len(entries) == 1 {
+		n := node{partial: entries[0].key[depth:]}
+		b.setValue(&n, entries[0].value)
+		return n.encode()
+	}
+
+	// The entries are sorted, so the common prefix of the first and the last key is shared by all keys
+	first, last := entries[0].key, entries[len(entries)-1].key
+	end := depth
+	for end < len(first) && end < len(last) && first[end] == last[end] {
+		end++
+	}
+
+	n := node{partial: first[depth:end], branch: true}
+	if len(first) == end {
+		b.setValue(&n, entries[0].value)
+		entries = entries[1:]
+	}
+
+	for len(entries) > 0 {
+		nibble := entries[0].key[end]
+		i := 1
+		for i < len(entries) && entries[i].key[end] == nibble {
+			i++
+		}
+
+		child := b.node(entries[:i], end+1)
+		if len(child) >= hashLength {
+			h := types.Hash(blake2b.Sum256(child))
+			b.record(h, child)
+			child = h[:]
+		}
+		n.children[nibble] = child
+
+		entries = entries[i:]
+	}
+
+	return n.encode()
+}
+
+func (b *builder) setValue(n *node, value []byte) {
+	n.hasValue = true
+
+	if b.version == StateVersionV1 && len(value) > maxInlineValue {
+		h := types.Hash(blake2b.Sum256(value))
+		b.record(h, value)
+		n.valueHash = &h
+		return
+	}
+
+	n.value = value
+}
+
+// toNibbles splits the key into nibbles, high nibble first
+func toNibbles(key []byte) []byte {
+	nibbles := make([]byte, 2*len(key))
+	for i, b := range key {
+		nibbles[2*i] = b >> 4
+		nibbles[2*i+1] = b & 0x0f
+	}
+	return nibbles
+}
@@ -0,0 +1,149 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trie
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/blake2b"
+)
+
+func TestRoot_Empty(t *testing.T) {
+	root, err := Root(nil, StateVersionV1)
+	assert.NoError(t, err)
+	assert.Equal(t, EmptyRoot, root)
+	assert.Equal(t, "0x03170a2e7597b7b7e3d84c05391d139a62b157e78786d8c082f29dcf4c111314", root.Hex())
+}
+
+func TestRoot_Leaf(t *testing.T) {
+	root, err := Root([]Entry{{Key: []byte{0x01}, Value: []byte{0x02}}}, StateVersionV0)
+	assert.NoError(t, err)
+	assert.Equal(t, types.Hash(blake2b.Sum256([]byte{0x42, 0x01, 0x04, 0x02})), root)
+}
+
+func TestRoot_Branch(t *testing.T) {
+	root, err := Root([]Entry{
+		{Key: []byte{0x01, 0x03}, Value: []byte("b")},
+		{Key: []byte{0x01, 0x02}, Value: []byte("a")},
+	}, StateVersionV0)
+	assert.NoError(t, err)
+
+	// A branch with the partial key 0x010 and two inlined leaves at the nibbles 2 and 3
+	enc := []byte{0x83, 0x00, 0x10, 0x0c, 0x00, 0x0c, 0x40, 0x04, 'a', 0x0c, 0x40, 0x04, 'b'}
+	assert.Equal(t, types.Hash(blake2b.Sum256(enc)), root)
+}
+
+func TestRoot_BranchWithValue(t *testing.T) {
+	root, err := Root([]Entry{
+		{Key: []byte{0x01}, Value: []byte("a")},
+		{Key: []byte{0x01, 0x02}, Value: []byte("b")},
+	}, StateVersionV0)
+	assert.NoError(t, err)
+
+	enc := []byte{0xc2, 0x01, 0x01, 0x00, 0x04, 'a', 0x10, 0x41, 0x02, 0x04, 'b'}
+	assert.Equal(t, types.Hash(blake2b.Sum256(enc)), root)
+}
+
+func TestRoot_StateVersions(t *testing.T) {
+	short := []Entry{{Key: []byte("key"), Value: bytes.Repeat([]byte{0x01}, maxInlineValue)}}
+	rootV0, err := Root(short, StateVersionV0)
+	assert.NoError(t, err)
+	rootV1, err := Root(short, StateVersionV1)
+	assert.NoError(t, err)
+	assert.Equal(t, rootV0, rootV1)
+
+	long := []Entry{{Key: []byte("key"), Value: bytes.Repeat([]byte{0x01}, maxInlineValue+1)}}
+	rootV0, err = Root(long, StateVersionV0)
+	assert.NoError(t, err)
+	rootV1, err = Root(long, StateVersionV1)
+	assert.NoError(t, err)
+	assert.NotEqual(t, rootV0, rootV1)
+
+	valueHash := blake2b.Sum256(long[0].Value)
+	enc := append([]byte{0x26, 'k', 'e', 'y'}, valueHash[:]...)
+	assert.Equal(t, types.Hash(blake2b.Sum256(enc)), rootV1)
+}
+
+func TestRoot_DuplicateKey(t *testing.T) {
+	_, err := Root([]Entry{
+		{Key: []byte{0x01}, Value: []byte("a")},
+		{Key: []byte{0x01}, Value: []byte("b")},
+	}, StateVersionV0)
+	assert.ErrorIs(t, err, ErrDuplicateKey)
+}
+
+func TestNode_EncodeDecode(t *testing.T) {
+	valueHash := types.NewHash(bytes.Repeat([]byte{0xaa}, 32))
+
+	for _, n := range []*node{
+		{partial: []byte{}, hasValue: true, value: []byte{}},
+		{partial: []byte{0x1, 0x2, 0x3}, hasValue: true, value: []byte("value")},
+		{partial: bytes.Repeat([]byte{0xf}, 62), hasValue: true, value: []byte("value")},
+		{partial: bytes.Repeat([]byte{0xf}, 63), hasValue: true, value: []byte("value")},
+		{partial: bytes.Repeat([]byte{0xe}, 1000), hasValue: true, value: []byte("value")},
+		{partial: []byte{0x5}, hasValue: true, valueHash: &valueHash},
+		{partial: []byte{}, branch: true, children: [16][]byte{1: {0x40, 0x00}, 15: valueHash[:]}},
+		{partial: []byte{0x1}, branch: true, hasValue: true, value: []byte{0x01},
+			children: [16][]byte{0: valueHash[:]}},
+		{partial: bytes.Repeat([]byte{0x3}, 20), branch: true, hasValue: true, valueHash: &valueHash,
+			children: [16][]byte{7: valueHash[:]}},
+	} {
+		dec, err := decodeNode(n.encode())
+		assert.NoError(t, err)
+		assert.Equal(t, n, dec)
+	}
+}
+
+func TestNode_Header(t *testing.T) {
+	var buf bytes.Buffer
+	writeHeader(&buf, leafPrefix, 2, 80)
+	assert.Equal(t, []byte{0x7f, 0x11}, buf.Bytes())
+
+	buf.Reset()
+	writeHeader(&buf, hashedValueBranchPrefix, 4, 14)
+	assert.Equal(t, []byte{0x1e}, buf.Bytes())
+
+	buf.Reset()
+	writeHeader(&buf, branchNoValuePrefix, 2, 62+255)
+	assert.Equal(t, []byte{0xbf, 0xfe}, buf.Bytes())
+
+	buf.Reset()
+	writeHeader(&buf, branchNoValuePrefix, 2, 62+256)
+	assert.Equal(t, []byte{0xbf, 0xff, 0x00}, buf.Bytes())
+}
+
+func TestDecodeNode_Invalid(t *testing.T) {
+	for _, enc := range []string{
+		"0x",
+		"0x0000",
+		"0x08",
+		"0x4110",
+		"0x4101",
+		"0x410104",
+		"0x41010402ff",
+		"0x80",
+		"0x800100",
+		"0x80010000",
+	} {
+		_, err := decodeNode(codec.MustHexDecodeString(enc))
+		assert.ErrorIs(t, err, ErrInvalidNode, enc)
+	}
+}
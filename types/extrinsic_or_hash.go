@@ -0,0 +1,81 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+// ExtrinsicOrHash identifies an extrinsic in the transaction pool, either by its hash or by the full extrinsic
+type ExtrinsicOrHash struct {
+	IsHash      bool
+	AsHash      Hash
+	IsExtrinsic bool
+	AsExtrinsic Extrinsic
+}
+
+// NewExtrinsicOrHashFromHash creates a new ExtrinsicOrHash type for the extrinsic with the given hash
+func NewExtrinsicOrHashFromHash(hash Hash) ExtrinsicOrHash {
+	return ExtrinsicOrHash{IsHash: true, AsHash: hash}
+}
+
+// NewExtrinsicOrHashFromExtrinsic creates a new ExtrinsicOrHash type for the given extrinsic
+func NewExtrinsicOrHashFromExtrinsic(xt Extrinsic) ExtrinsicOrHash {
+	return ExtrinsicOrHash{IsExtrinsic: true, AsExtrinsic: xt}
+}
+
+// MarshalJSON returns a JSON encoded byte array of e
+func (e ExtrinsicOrHash) MarshalJSON() ([]byte, error) {
+	switch {
+	case e.IsHash:
+		return json.Marshal(map[string]Hash{"hash": e.AsHash})
+	case e.IsExtrinsic:
+		enc, err := codec.EncodeToHex(e.AsExtrinsic)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(map[string]string{"extrinsic": enc})
+	default:
+		return nil, fmt.Errorf("ExtrinsicOrHash is neither a hash nor an extrinsic")
+	}
+}
+
+// UnmarshalJSON fills e with the JSON encoded byte array given by b
+func (e *ExtrinsicOrHash) UnmarshalJSON(b []byte) error {
+	var tmp struct {
+		Hash      *Hash   `json:"hash"`
+		Extrinsic *string `json:"extrinsic"`
+	}
+	if err := json.Unmarshal(b, &tmp); err != nil {
+		return err
+	}
+
+	switch {
+	case tmp.Hash != nil:
+		e.IsHash = true
+		e.AsHash = *tmp.Hash
+		return nil
+	case tmp.Extrinsic != nil:
+		e.IsExtrinsic = true
+		return codec.DecodeFromHex(*tmp.Extrinsic, &e.AsExtrinsic)
+	default:
+		return fmt.Errorf("expected hash or extrinsic, got %s", b)
+	}
+}
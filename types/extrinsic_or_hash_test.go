@@ -0,0 +1,46 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtrinsicOrHash_MarshalUnmarshalJSON(t *testing.T) {
+	for _, e := range []ExtrinsicOrHash{
+		NewExtrinsicOrHashFromHash(Hash{0x01, 0x02}),
+		NewExtrinsicOrHashFromExtrinsic(ExamplaryExtrinsic),
+	} {
+		enc, err := json.Marshal(e)
+		assert.NoError(t, err)
+
+		var dec ExtrinsicOrHash
+		assert.NoError(t, json.Unmarshal(enc, &dec))
+		assert.Equal(t, e, dec)
+	}
+
+	enc, err := json.Marshal(NewExtrinsicOrHashFromHash(Hash{0x01}))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"hash":"0x0100000000000000000000000000000000000000000000000000000000000000"}`, string(enc))
+
+	_, err = json.Marshal(ExtrinsicOrHash{})
+	assert.Error(t, err)
+}
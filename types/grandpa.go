@@ -0,0 +1,131 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"github.com/centrifuge/go-substrate-rpc-client/v4/scale"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+// GrandpaJustification is a GRANDPA justification for block finality, as streamed by grandpa_subscribeJustifications
+// and contained in the finality proofs returned by grandpa_proveFinality
+type GrandpaJustification struct {
+	// Round is the GRANDPA round in which the block was finalized
+	Round U64
+	// Commit is the commit message of the round, it holds the precommits for the finalized block
+	Commit GrandpaCommit
+	// VotesAncestries are the headers needed to prove that the precommit targets descend from the finalized block
+	VotesAncestries []Header
+}
+
+// UnmarshalText deserializes hex string into a GrandpaJustification.
+// Used for decoding JSON-RPC subscription messages (grandpa_subscribeJustifications)
+func (j *GrandpaJustification) UnmarshalText(text []byte) error {
+	return codec.DecodeFromHex(string(text), j)
+}
+
+// GrandpaCommit is a commit message of a GRANDPA round, which is an aggregate of signed precommits for a target block
+type GrandpaCommit struct {
+	TargetHash   Hash
+	TargetNumber U32
+	Precommits   []GrandpaSignedPrecommit
+}
+
+// GrandpaPrecommit is a precommit for a block and its ancestors
+type GrandpaPrecommit struct {
+	TargetHash   Hash
+	TargetNumber U32
+}
+
+// GrandpaSignedPrecommit is a precommit signed by a GRANDPA authority
+type GrandpaSignedPrecommit struct {
+	Precommit GrandpaPrecommit
+	// Signature is the ed25519 signature of the authority
+	Signature Signature
+	// ID is the ed25519 public key of the authority
+	ID AuthorityID
+}
+
+// GrandpaFinalityProof proves the finality of a block, as returned by grandpa_proveFinality
+type GrandpaFinalityProof struct {
+	// Block is the hash of the block finalized by the justification
+	Block Hash
+	// Justification finalizes Block, it is SCALE encoded as bytes in the proof
+	Justification GrandpaJustification
+	// UnknownHeaders are the headers from the requested block up to Block, the requested block is included if it is
+	// not the one finalized by the justification
+	UnknownHeaders []Header
+}
+
+func (p *GrandpaFinalityProof) Decode(decoder scale.Decoder) error {
+	if err := decoder.Decode(&p.Block); err != nil {
+		return err
+	}
+
+	var justification Bytes
+	if err := decoder.Decode(&justification); err != nil {
+		return err
+	}
+
+	if err := codec.Decode(justification, &p.Justification); err != nil {
+		return err
+	}
+
+	return decoder.Decode(&p.UnknownHeaders)
+}
+
+func (p GrandpaFinalityProof) Encode(encoder scale.Encoder) error {
+	if err := encoder.Encode(p.Block); err != nil {
+		return err
+	}
+
+	justification, err := codec.Encode(p.Justification)
+	if err != nil {
+		return err
+	}
+
+	if err := encoder.Encode(Bytes(justification)); err != nil {
+		return err
+	}
+
+	return encoder.Encode(p.UnknownHeaders)
+}
+
+// GrandpaReportedRoundStates is the state of the GRANDPA rounds of the node, as returned by grandpa_roundState
+type GrandpaReportedRoundStates struct {
+	SetID U32 `json:"setId"`
+	// Best is the state of the current round
+	Best GrandpaRoundState `json:"best"`
+	// Background are the states of the previous rounds that are still tracked
+	Background []GrandpaRoundState `json:"background"`
+}
+
+// GrandpaRoundState is the voting state of a GRANDPA round
+type GrandpaRoundState struct {
+	Round           U32          `json:"round"`
+	TotalWeight     U32          `json:"totalWeight"`
+	ThresholdWeight U32          `json:"thresholdWeight"`
+	Prevotes        GrandpaVotes `json:"prevotes"`
+	Precommits      GrandpaVotes `json:"precommits"`
+}
+
+// GrandpaVotes is the weight of the votes of one kind received in a round
+type GrandpaVotes struct {
+	CurrentWeight U32 `json:"currentWeight"`
+	// Missing are the SS58 encoded IDs of the authorities that did not vote yet
+	Missing []string `json:"missing"`
+}
@@ -17,12 +17,14 @@
 package types
 
 import (
+	"bytes"
 	"encoding/json"
 	"math/big"
 	"strconv"
 	"strings"
 
 	"github.com/centrifuge/go-substrate-rpc-client/v4/scale"
+	"golang.org/x/crypto/blake2b"
 )
 
 type DataLookupIndexItem struct {
@@ -67,6 +69,15 @@ type Header struct {
 	Extension      HeaderExtensionEnum `json:"extension"`
 }
 
+// Hash returns the hash of the block with this header, the blake2_256 hash of the SCALE encoded header
+func (h Header) Hash() (Hash, error) {
+	var buf bytes.Buffer
+	if err := scale.NewEncoder(&buf).Encode(h); err != nil {
+		return Hash{}, err
+	}
+	return blake2b.Sum256(buf.Bytes()), nil
+}
+
 type BlockNumber U32
 
 // UnmarshalJSON fills BlockNumber with the JSON encoded byte array given by bz
@@ -17,6 +17,7 @@
 package types_test
 
 import (
+	"bytes"
 	"fmt"
 	"testing"
 
@@ -91,6 +92,17 @@ func TestHeader_Hex(t *testing.T) {
 	})
 }
 
+func TestHeader_Hash(t *testing.T) {
+	hash, err := exampleHeader.Hash()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := MustHexDecodeString("0x5681d4a709cb37623088b0412a7797abcb5b5b8be61eaae1fa18251455fe872c")
+	if !bytes.Equal(hash[:], expected) {
+		t.Errorf("Fail, expected %#x, result %#x", expected, hash)
+	}
+}
+
 func TestHeader_Eq(t *testing.T) {
 	AssertEq(t, []EqAssert{
 		{Input: exampleHeader, Other: exampleHeader, Expected: true},
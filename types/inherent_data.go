@@ -0,0 +1,103 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/scale"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+// InherentIdentifier identifies an inherent, e.g. "timstap0" for the timestamp
+type InherentIdentifier [8]byte
+
+// NewInherentIdentifier creates an InherentIdentifier from its string form, which must not be longer than 8 bytes
+func NewInherentIdentifier(id string) InherentIdentifier {
+	var i InherentIdentifier
+	copy(i[:], id)
+	return i
+}
+
+// InherentData is the data the runtime creates the inherent extrinsics of a block from. It maps the identifiers of the
+// inherents to their SCALE encoded data.
+type InherentData map[InherentIdentifier]Bytes
+
+// Put stores the SCALE encoded value under the identifier
+func (d InherentData) Put(id InherentIdentifier, value interface{}) error {
+	enc, err := codec.Encode(value)
+	if err != nil {
+		return err
+	}
+
+	d[id] = enc
+	return nil
+}
+
+func (d *InherentData) Decode(decoder scale.Decoder) error {
+	n, err := decoder.DecodeUintCompact()
+	if err != nil {
+		return err
+	}
+
+	// The length is not trusted, the map grows with the entries that are actually decoded
+	*d = make(InherentData)
+	for i := uint64(0); i < n.Uint64(); i++ {
+		var id InherentIdentifier
+		if err := decoder.Decode(&id); err != nil {
+			return err
+		}
+
+		var data Bytes
+		if err := decoder.Decode(&data); err != nil {
+			return err
+		}
+
+		(*d)[id] = data
+	}
+
+	return nil
+}
+
+// Encode encodes the data ordered by identifier, like the BTreeMap the runtime expects
+func (d InherentData) Encode(encoder scale.Encoder) error {
+	ids := make([]InherentIdentifier, 0, len(d))
+	for id := range d {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return bytes.Compare(ids[i][:], ids[j][:]) < 0
+	})
+
+	if err := encoder.EncodeUintCompact(*new(big.Int).SetUint64(uint64(len(ids)))); err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if err := encoder.Encode(id); err != nil {
+			return err
+		}
+
+		if err := encoder.Encode(d[id]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
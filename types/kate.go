@@ -0,0 +1,210 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+// Cell is the position of a cell in the data matrix of a block
+type Cell struct {
+	Row U32 `json:"row"`
+	Col U32 `json:"col"`
+}
+
+// NewCell creates a new Cell type
+func NewCell(row, col uint32) Cell {
+	return Cell{Row: U32(row), Col: U32(col)}
+}
+
+// KateScalar is a scalar of the data matrix, a BLS12-381 field element stored as 32 big-endian bytes. The node
+// encodes it as a U256 in JSON.
+type KateScalar [32]byte
+
+// UnmarshalJSON fills s with the JSON encoded U256 hex string given by bz
+func (s *KateScalar) UnmarshalJSON(bz []byte) error {
+	var tmp string
+	if err := json.Unmarshal(bz, &tmp); err != nil {
+		return err
+	}
+
+	// U256 values are encoded without leading zeros and might have an odd number of digits
+	i, ok := new(big.Int).SetString(strings.TrimPrefix(tmp, "0x"), 16)
+	if !ok || i.Sign() < 0 {
+		return fmt.Errorf("invalid kate scalar %q", tmp)
+	}
+
+	b := i.Bytes()
+	if len(b) > len(s) {
+		return fmt.Errorf("kate scalar too long: %d bytes", len(b))
+	}
+
+	*s = KateScalar{}
+	copy(s[len(s)-len(b):], b)
+	return nil
+}
+
+// MarshalJSON returns a JSON encoded byte array of s, as a U256 hex string
+func (s KateScalar) MarshalJSON() ([]byte, error) {
+	return json.Marshal("0x" + new(big.Int).SetBytes(s[:]).Text(16))
+}
+
+// KateRow is a row of the data matrix
+type KateRow []KateScalar
+
+// KateProof is a KZG proof of a cell, a compressed BLS12-381 G1 point
+type KateProof [48]byte
+
+// UnmarshalJSON fills p with the JSON encoded byte array given by bz, which is either an array of bytes or a hex
+// string
+func (p *KateProof) UnmarshalJSON(bz []byte) error {
+	var hex string
+	if err := json.Unmarshal(bz, &hex); err == nil {
+		b, err := codec.HexDecodeString(hex)
+		if err != nil {
+			return err
+		}
+		if len(b) != len(p) {
+			return fmt.Errorf("expected kate proof of %d bytes, got %d", len(p), len(b))
+		}
+		copy(p[:], b)
+		return nil
+	}
+
+	var tmp [48]byte
+	if err := json.Unmarshal(bz, &tmp); err != nil {
+		return err
+	}
+	*p = tmp
+	return nil
+}
+
+// CellProof is the content of a cell of the data matrix together with the proof of the cell against the commitment of
+// its row
+type CellProof struct {
+	Scalar KateScalar
+	Proof  KateProof
+}
+
+// UnmarshalJSON fills p with the JSON encoded [scalar, proof] tuple given by bz
+func (p *CellProof) UnmarshalJSON(bz []byte) error {
+	var tmp []json.RawMessage
+	if err := json.Unmarshal(bz, &tmp); err != nil {
+		return err
+	}
+
+	if len(tmp) != 2 {
+		return fmt.Errorf("expected cell proof tuple of 2 elements, got %d", len(tmp))
+	}
+
+	if err := json.Unmarshal(tmp[0], &p.Scalar); err != nil {
+		return err
+	}
+
+	return json.Unmarshal(tmp[1], &p.Proof)
+}
+
+// MarshalJSON returns a JSON encoded byte array of p, as a [scalar, proof] tuple
+func (p CellProof) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]interface{}{p.Scalar, p.Proof})
+}
+
+// PerDispatchClassU32 holds a value for each dispatch class
+type PerDispatchClassU32 struct {
+	Normal      U32 `json:"normal"`
+	Operational U32 `json:"operational"`
+	Mandatory   U32 `json:"mandatory"`
+}
+
+// BlockLength is the length limit of a block and the dimensions of its data matrix
+type BlockLength struct {
+	// Max is the maximum length of a block per dispatch class, in bytes
+	Max PerDispatchClassU32
+	// Cols is the number of columns of the data matrix
+	Cols U32
+	// Rows is the number of rows of the data matrix
+	Rows U32
+	// ChunkSize is the size of a cell of the data matrix, in bytes
+	ChunkSize U32
+}
+
+// UnmarshalJSON fills l with the JSON encoded byte array given by bz
+func (l *BlockLength) UnmarshalJSON(bz []byte) error {
+	var tmp struct {
+		Max            PerDispatchClassU32 `json:"max"`
+		Cols           U32                 `json:"cols"`
+		Rows           U32                 `json:"rows"`
+		ChunkSize      *U32                `json:"chunkSize"`
+		ChunkSizeSnake *U32                `json:"chunk_size"`
+	}
+	if err := json.Unmarshal(bz, &tmp); err != nil {
+		return err
+	}
+
+	l.Max = tmp.Max
+	l.Cols = tmp.Cols
+	l.Rows = tmp.Rows
+	switch {
+	case tmp.ChunkSize != nil:
+		l.ChunkSize = *tmp.ChunkSize
+	case tmp.ChunkSizeSnake != nil:
+		l.ChunkSize = *tmp.ChunkSizeSnake
+	default:
+		l.ChunkSize = 0
+	}
+
+	return nil
+}
+
+// MarshalJSON returns a JSON encoded byte array of l
+func (l BlockLength) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Max       PerDispatchClassU32 `json:"max"`
+		Cols      U32                 `json:"cols"`
+		Rows      U32                 `json:"rows"`
+		ChunkSize U32                 `json:"chunkSize"`
+	}{l.Max, l.Cols, l.Rows, l.ChunkSize})
+}
+
+// TxDataRoots are the roots that make up the data root of a block
+type TxDataRoots struct {
+	DataRoot   Hash `json:"dataRoot"`
+	BlobRoot   Hash `json:"blobRoot"`
+	BridgeRoot Hash `json:"bridgeRoot"`
+}
+
+// DataProof is a merkle proof of the data of a transaction against the data root of its block
+type DataProof struct {
+	Roots TxDataRoots `json:"roots"`
+	// Proof are the sibling hashes on the path from the leaf to the root
+	Proof          []Hash `json:"proof"`
+	NumberOfLeaves U32    `json:"numberOfLeaves"`
+	LeafIndex      U32    `json:"leafIndex"`
+	Leaf           Hash   `json:"leaf"`
+}
+
+// DataProofResponse is the response of kate_queryDataProof
+type DataProofResponse struct {
+	DataProof DataProof `json:"dataProof"`
+	// Message is the bridge message sent by the transaction, if any. It is kept in its JSON form.
+	Message json.RawMessage `json:"message,omitempty"`
+}
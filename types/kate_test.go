@@ -0,0 +1,86 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	. "github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKateScalar_JSON(t *testing.T) {
+	var s KateScalar
+	assert.NoError(t, json.Unmarshal([]byte(`"0x1ab"`), &s))
+	assert.Equal(t, KateScalar{30: 0x01, 31: 0xab}, s)
+
+	bz, err := json.Marshal(s)
+	assert.NoError(t, err)
+	assert.Equal(t, `"0x1ab"`, string(bz))
+
+	bz, err = json.Marshal(KateScalar{})
+	assert.NoError(t, err)
+	assert.Equal(t, `"0x0"`, string(bz))
+
+	assert.NoError(t, json.Unmarshal([]byte(`"0x`+strings.Repeat("ff", 32)+`"`), &s))
+	assert.Error(t, json.Unmarshal([]byte(`"0x1`+strings.Repeat("00", 32)+`"`), &s))
+	assert.Error(t, json.Unmarshal([]byte(`"0xzz"`), &s))
+}
+
+func TestCellProof_JSON(t *testing.T) {
+	var p CellProof
+	proof := `[1` + strings.Repeat(",0", 46) + `,2]`
+	assert.NoError(t, json.Unmarshal([]byte(`["0x2a", `+proof+`]`), &p))
+	assert.Equal(t, CellProof{Scalar: KateScalar{31: 0x2a}, Proof: KateProof{0: 1, 47: 2}}, p)
+
+	bz, err := json.Marshal(p)
+	assert.NoError(t, err)
+	var decoded CellProof
+	assert.NoError(t, json.Unmarshal(bz, &decoded))
+	assert.Equal(t, p, decoded)
+
+	assert.NoError(t, json.Unmarshal([]byte(`["0x2a", "0x01`+strings.Repeat("00", 46)+`02"]`), &decoded))
+	assert.Equal(t, p, decoded)
+
+	assert.Error(t, json.Unmarshal([]byte(`["0x2a"]`), &p))
+	assert.Error(t, json.Unmarshal([]byte(`["0x2a", "0x01"]`), &p))
+}
+
+func TestBlockLength_JSON(t *testing.T) {
+	expected := BlockLength{
+		Max:       PerDispatchClassU32{Normal: 1, Operational: 2, Mandatory: 3},
+		Cols:      256,
+		Rows:      128,
+		ChunkSize: 32,
+	}
+
+	var l BlockLength
+	assert.NoError(t, json.Unmarshal([]byte(`{"max":{"normal":1,"operational":2,"mandatory":3},"cols":256,`+
+		`"rows":128,"chunk_size":32}`), &l))
+	assert.Equal(t, expected, l)
+
+	bz, err := json.Marshal(expected)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"max":{"normal":1,"operational":2,"mandatory":3},"cols":256,"rows":128,"chunkSize":32}`,
+		string(bz))
+
+	l = BlockLength{}
+	assert.NoError(t, json.Unmarshal(bz, &l))
+	assert.Equal(t, expected, l)
+}
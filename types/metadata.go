@@ -319,6 +319,27 @@ func (m *Metadata) FindStorageEntryMetadata(module string, fn string) (StorageEn
 	}
 }
 
+// FindStorageNamesForKey returns the pallet prefix and the name of the storage item that the key belongs to. This is
+// only supported for metadata V9 and later, which prefix the keys with the hashed names.
+func (m *Metadata) FindStorageNamesForKey(key StorageKey) (Text, Text, error) {
+	switch m.Version {
+	case 9:
+		return m.AsMetadataV9.FindStorageNamesForKey(key)
+	case 10:
+		return m.AsMetadataV10.FindStorageNamesForKey(key)
+	case 11:
+		return m.AsMetadataV11.FindStorageNamesForKey(key)
+	case 12:
+		return m.AsMetadataV12.FindStorageNamesForKey(key)
+	case 13:
+		return m.AsMetadataV13.FindStorageNamesForKey(key)
+	case 14:
+		return m.AsMetadataV14.FindStorageNamesForKey(key)
+	default:
+		return "", "", fmt.Errorf("unsupported metadata version")
+	}
+}
+
 func (m *Metadata) ExistsModuleMetadata(module string) bool {
 	switch m.Version {
 	case 4:
@@ -99,6 +99,20 @@ func (m *MetadataV13) FindStorageEntryMetadata(module string, fn string) (Storag
 	return nil, fmt.Errorf("module %v not found in metadata", module)
 }
 
+func (m *MetadataV13) FindStorageNamesForKey(key StorageKey) (Text, Text, error) {
+	for _, mod := range m.Modules {
+		if !mod.HasStorage {
+			continue
+		}
+		for _, s := range mod.Storage.Items {
+			if isKeyOfStorage(key, mod.Storage.Prefix, s.Name) {
+				return mod.Storage.Prefix, s.Name, nil
+			}
+		}
+	}
+	return "", "", fmt.Errorf("storage for key %#x not found in metadata", []byte(key))
+}
+
 func (m *MetadataV13) FindConstantValue(module Text, constant Text) ([]byte, error) {
 	for _, mod := range m.Modules {
 		if mod.Name == module {
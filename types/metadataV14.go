@@ -144,6 +144,20 @@ func (m *MetadataV14) FindStorageEntryMetadata(module string, fn string) (Storag
 	return nil, fmt.Errorf("module %v not found in metadata", module)
 }
 
+func (m *MetadataV14) FindStorageNamesForKey(key StorageKey) (Text, Text, error) {
+	for _, mod := range m.Pallets {
+		if !mod.HasStorage {
+			continue
+		}
+		for _, s := range mod.Storage.Items {
+			if isKeyOfStorage(key, mod.Storage.Prefix, s.Name) {
+				return mod.Storage.Prefix, s.Name, nil
+			}
+		}
+	}
+	return "", "", fmt.Errorf("storage for key %#x not found in metadata", []byte(key))
+}
+
 func (m *MetadataV14) FindError(moduleIndex U8, errorIndex [4]U8) (*MetadataError, error) {
 	for _, mod := range m.Pallets {
 		if int(mod.Index) == int(moduleIndex) {
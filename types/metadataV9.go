@@ -115,6 +115,20 @@ func (m *MetadataV9) FindStorageEntryMetadata(module string, fn string) (Storage
 	return nil, fmt.Errorf("module %v not found in metadata", module)
 }
 
+func (m *MetadataV9) FindStorageNamesForKey(key StorageKey) (Text, Text, error) {
+	for _, mod := range m.Modules {
+		if !mod.HasStorage {
+			continue
+		}
+		for _, s := range mod.Storage.Items {
+			if isKeyOfStorage(key, mod.Storage.Prefix, s.Name) {
+				return mod.Storage.Prefix, s.Name, nil
+			}
+		}
+	}
+	return "", "", fmt.Errorf("storage for key %#x not found in metadata", []byte(key))
+}
+
 func (m *MetadataV9) ExistsModuleMetadata(module string) bool {
 	for _, mod := range m.Modules {
 		if string(mod.Name) == module {
@@ -32,7 +32,7 @@ func (d *GenerateMMRProofResponse) UnmarshalJSON(bz []byte) error {
 	if err != nil {
 		return err
 	}
-	err = codec.Decode(encodedLeaf, &d.Leaf)
+	d.Leaf, err = encodedLeaf.DecodeLeaf()
 	if err != nil {
 		return err
 	}
@@ -43,8 +43,84 @@ func (d *GenerateMMRProofResponse) UnmarshalJSON(bz []byte) error {
 	return nil
 }
 
+// MMRLeavesProof contains the leaves and the proof returned by mmr_generateProof for a set of block numbers
+type MMRLeavesProof struct {
+	// The block hash at which the proof was generated
+	BlockHash H256
+	// The SCALE encoded leaves, in the order of the leaf indices of the proof
+	Leaves []MMREncodableOpaqueLeaf
+	// The proof for the leaves
+	Proof MMRBatchProof
+}
+
+// UnmarshalJSON fills d with the JSON encoded byte array given by bz
+func (d *MMRLeavesProof) UnmarshalJSON(bz []byte) error {
+	var tmp struct {
+		BlockHash string `json:"blockHash"`
+		Leaves    string `json:"leaves"`
+		Proof     string `json:"proof"`
+	}
+	if err := json.Unmarshal(bz, &tmp); err != nil {
+		return err
+	}
+	err := codec.DecodeFromHex(tmp.BlockHash, &d.BlockHash)
+	if err != nil {
+		return err
+	}
+	err = codec.DecodeFromHex(tmp.Leaves, &d.Leaves)
+	if err != nil {
+		return err
+	}
+	return codec.DecodeFromHex(tmp.Proof, &d.Proof)
+}
+
+// MarshalJSON returns a JSON encoded byte array of d, in the format expected by mmr_verifyProof
+func (d MMRLeavesProof) MarshalJSON() ([]byte, error) {
+	leaves, err := codec.EncodeToHex(d.Leaves)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := codec.EncodeToHex(d.Proof)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(struct {
+		BlockHash string `json:"blockHash"`
+		Leaves    string `json:"leaves"`
+		Proof     string `json:"proof"`
+	}{
+		BlockHash: d.BlockHash.Hex(),
+		Leaves:    leaves,
+		Proof:     proof,
+	})
+}
+
+// DecodeLeaves decodes all leaves of the proof into MMRLeaf
+func (d MMRLeavesProof) DecodeLeaves() ([]MMRLeaf, error) {
+	leaves := make([]MMRLeaf, len(d.Leaves))
+	for i, l := range d.Leaves {
+		leaf, err := l.DecodeLeaf()
+		if err != nil {
+			return nil, err
+		}
+		leaves[i] = leaf
+	}
+	return leaves, nil
+}
+
+// MMREncodableOpaqueLeaf is a SCALE encoded MMR leaf, as stored in the MMR
 type MMREncodableOpaqueLeaf Bytes
 
+// DecodeLeaf decodes l into a MMRLeaf
+func (l MMREncodableOpaqueLeaf) DecodeLeaf() (MMRLeaf, error) {
+	var leaf MMRLeaf
+	err := codec.Decode(l, &leaf)
+	if err != nil {
+		return MMRLeaf{}, err
+	}
+	return leaf, nil
+}
+
 // MMRProof is a MMR proof
 type MMRProof struct {
 	// The index of the leaf the proof is for.
@@ -55,6 +131,16 @@ type MMRProof struct {
 	Items []H256
 }
 
+// MMRBatchProof is a MMR proof for multiple leaves
+type MMRBatchProof struct {
+	// The indices of the leaves the proof is for.
+	LeafIndices []U64
+	// Number of leaves in MMR, when the proof was generated.
+	LeafCount U64
+	// Proof elements (hashes of siblings of inner nodes on the path to the leaves, and peaks).
+	Items []H256
+}
+
 type MMRLeaf struct {
 	Version               MMRLeafVersion
 	ParentNumberAndHash   ParentNumberAndHash
@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	. "github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
 	. "github.com/centrifuge/go-substrate-rpc-client/v4/types/test_utils"
 )
 
@@ -39,3 +40,47 @@ func TestGenerateMMRProofResponse_Unmarshal(t *testing.T) {
 
 	AssertEqual(t, unmarshalled, expected)
 }
+
+func TestMMRLeavesProof_JSON(t *testing.T) {
+	leaf := MMRLeaf{
+		Version:             0,
+		ParentNumberAndHash: ParentNumberAndHash{ParentNumber: 0x7d0, Hash: Hash{0x24, 0x72}},
+		BeefyNextAuthoritySet: BeefyNextAuthoritySet{
+			ID:   1,
+			Len:  3,
+			Root: H256{0x42, 0xb6},
+		},
+	}
+	encodedLeaf, err := codec.Encode(leaf)
+	if err != nil {
+		panic(err)
+	}
+
+	proof := MMRLeavesProof{
+		BlockHash: H256{0x52, 0xd9},
+		Leaves:    []MMREncodableOpaqueLeaf{encodedLeaf, encodedLeaf},
+		Proof: MMRBatchProof{
+			LeafIndices: []U64{0x7d0, 0x7d1},
+			LeafCount:   0x8d9,
+			Items:       []H256{{0xef, 0x3c}, {0xc7, 0x1a}},
+		},
+	}
+
+	marshalled, err := json.Marshal(proof)
+	if err != nil {
+		panic(err)
+	}
+
+	var unmarshalled MMRLeavesProof
+	err = json.Unmarshal(marshalled, &unmarshalled)
+	if err != nil {
+		panic(err)
+	}
+	AssertEqual(t, unmarshalled, proof)
+
+	leaves, err := unmarshalled.DecodeLeaves()
+	if err != nil {
+		panic(err)
+	}
+	AssertEqual(t, leaves, []MMRLeaf{leaf, leaf})
+}
@@ -0,0 +1,67 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// NodeRole is a role of a node in the network
+type NodeRole struct {
+	// The node is a full node
+	IsFull bool
+	// The node is a light client
+	IsLightClient bool
+	// The node is an authority
+	IsAuthority bool
+}
+
+// UnmarshalJSON fills r with the JSON encoded byte array given by b
+func (r *NodeRole) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	switch s {
+	case "Full":
+		*r = NodeRole{IsFull: true}
+	case "LightClient":
+		*r = NodeRole{IsLightClient: true}
+	case "Authority":
+		*r = NodeRole{IsAuthority: true}
+	default:
+		return fmt.Errorf("unknown NodeRole %q", s)
+	}
+
+	return nil
+}
+
+// MarshalJSON returns a JSON encoded byte array of r
+func (r NodeRole) MarshalJSON() ([]byte, error) {
+	switch {
+	case r.IsFull:
+		return json.Marshal("Full")
+	case r.IsLightClient:
+		return json.Marshal("LightClient")
+	case r.IsAuthority:
+		return json.Marshal("Authority")
+	}
+
+	return nil, fmt.Errorf("empty NodeRole")
+}
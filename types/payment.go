@@ -0,0 +1,254 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/scale"
+)
+
+// RuntimeDispatchInfo is the information about the dispatch of an extrinsic that is used to estimate its fee, as
+// returned by payment_queryInfo and TransactionPaymentApi_query_info
+type RuntimeDispatchInfo struct {
+	// Weight of the extrinsic
+	Weight Weight
+	// Class of the extrinsic
+	Class DispatchClass
+	// PartialFee is the inclusion fee of the extrinsic, it does not include the tip or any other fees charged during
+	// dispatch
+	PartialFee U128
+}
+
+// UnmarshalJSON fills d with the JSON encoded byte array given by b. The weight can be given as a single number, as
+// returned by nodes that still use Weight V1, in which case only its RefTime is set.
+func (d *RuntimeDispatchInfo) UnmarshalJSON(b []byte) error {
+	var tmp struct {
+		Weight     json.RawMessage `json:"weight"`
+		Class      string          `json:"class"`
+		PartialFee json.RawMessage `json:"partialFee"`
+	}
+	if err := json.Unmarshal(b, &tmp); err != nil {
+		return err
+	}
+
+	weight, err := weightFromJSON(tmp.Weight)
+	if err != nil {
+		return err
+	}
+
+	class, err := dispatchClassFromJSON(tmp.Class)
+	if err != nil {
+		return err
+	}
+
+	fee, err := balanceFromJSON(tmp.PartialFee)
+	if err != nil {
+		return err
+	}
+
+	*d = RuntimeDispatchInfo{Weight: weight, Class: class, PartialFee: fee}
+	return nil
+}
+
+// InclusionFee is the fee that is charged for including an extrinsic in a block
+type InclusionFee struct {
+	// BaseFee is the minimum amount an extrinsic has to pay to be included
+	BaseFee U128
+	// LenFee is the fee for the length of the encoded extrinsic
+	LenFee U128
+	// AdjustedWeightFee is the fee for the weight of the extrinsic, multiplied by the fee multiplier
+	AdjustedWeightFee U128
+}
+
+// UnmarshalJSON fills f with the JSON encoded byte array given by b
+func (f *InclusionFee) UnmarshalJSON(b []byte) error {
+	var tmp struct {
+		BaseFee           json.RawMessage `json:"baseFee"`
+		LenFee            json.RawMessage `json:"lenFee"`
+		AdjustedWeightFee json.RawMessage `json:"adjustedWeightFee"`
+	}
+	if err := json.Unmarshal(b, &tmp); err != nil {
+		return err
+	}
+
+	var err error
+	if f.BaseFee, err = balanceFromJSON(tmp.BaseFee); err != nil {
+		return err
+	}
+	if f.LenFee, err = balanceFromJSON(tmp.LenFee); err != nil {
+		return err
+	}
+	if f.AdjustedWeightFee, err = balanceFromJSON(tmp.AdjustedWeightFee); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Total returns the sum of the base fee, the length fee and the adjusted weight fee
+func (f InclusionFee) Total() U128 {
+	total := new(big.Int)
+	for _, fee := range []U128{f.BaseFee, f.LenFee, f.AdjustedWeightFee} {
+		if fee.Int != nil {
+			total.Add(total, fee.Int)
+		}
+	}
+
+	return NewU128(*total)
+}
+
+// OptionInclusionFee is a structure that can store an InclusionFee or a missing value
+type OptionInclusionFee struct {
+	option
+	value InclusionFee
+}
+
+// NewOptionInclusionFee creates an OptionInclusionFee with a value
+func NewOptionInclusionFee(value InclusionFee) OptionInclusionFee {
+	return OptionInclusionFee{option{true}, value}
+}
+
+// NewOptionInclusionFeeEmpty creates an OptionInclusionFee without a value
+func NewOptionInclusionFeeEmpty() OptionInclusionFee {
+	return OptionInclusionFee{option: option{false}}
+}
+
+func (o OptionInclusionFee) Encode(encoder scale.Encoder) error {
+	return encoder.EncodeOption(o.hasValue, o.value)
+}
+
+func (o *OptionInclusionFee) Decode(decoder scale.Decoder) error {
+	return decoder.DecodeOption(&o.hasValue, &o.value)
+}
+
+// SetSome sets a value
+func (o *OptionInclusionFee) SetSome(value InclusionFee) {
+	o.hasValue = true
+	o.value = value
+}
+
+// SetNone removes a value and marks it as missing
+func (o *OptionInclusionFee) SetNone() {
+	o.hasValue = false
+	o.value = InclusionFee{}
+}
+
+// Unwrap returns a flag that indicates whether a value is present and the stored value
+func (o OptionInclusionFee) Unwrap() (ok bool, value InclusionFee) {
+	return o.hasValue, o.value
+}
+
+// FeeDetails is the breakdown of the fee of an extrinsic, as returned by payment_queryFeeDetails and
+// TransactionPaymentApi_query_fee_details
+type FeeDetails struct {
+	// InclusionFee is missing for unsigned extrinsics, which do not pay an inclusion fee
+	InclusionFee OptionInclusionFee
+	// Tip is not returned by payment_queryFeeDetails, it is only set when queried through the runtime API
+	Tip U128
+}
+
+// UnmarshalJSON fills d with the JSON encoded byte array given by b
+func (d *FeeDetails) UnmarshalJSON(b []byte) error {
+	var tmp struct {
+		InclusionFee *InclusionFee   `json:"inclusionFee"`
+		Tip          json.RawMessage `json:"tip"`
+	}
+	if err := json.Unmarshal(b, &tmp); err != nil {
+		return err
+	}
+
+	*d = FeeDetails{InclusionFee: NewOptionInclusionFeeEmpty(), Tip: NewU128(*big.NewInt(0))}
+	if tmp.InclusionFee != nil {
+		d.InclusionFee.SetSome(*tmp.InclusionFee)
+	}
+
+	if len(tmp.Tip) > 0 {
+		tip, err := balanceFromJSON(tmp.Tip)
+		if err != nil {
+			return err
+		}
+		d.Tip = tip
+	}
+
+	return nil
+}
+
+// balanceFromJSON decodes a balance given as a JSON number, a decimal string or a hex string
+func balanceFromJSON(b json.RawMessage) (U128, error) {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		s = string(b)
+	}
+
+	i, ok := new(big.Int).SetString(s, 0)
+	if !ok || i.Sign() < 0 {
+		return U128{}, fmt.Errorf("invalid balance %s", b)
+	}
+
+	return NewU128(*i), nil
+}
+
+// weightFromJSON decodes a Weight V2 object, with snake or camel case fields, or a Weight V1 number
+func weightFromJSON(b json.RawMessage) (Weight, error) {
+	var v1 UCompact
+	if err := json.Unmarshal(b, &v1); err == nil {
+		return NewWeight(v1, NewUCompactFromUInt(0)), nil
+	}
+
+	var v2 struct {
+		RefTime        *UCompact `json:"ref_time"`
+		ProofSize      *UCompact `json:"proof_size"`
+		RefTimeCamel   *UCompact `json:"refTime"`
+		ProofSizeCamel *UCompact `json:"proofSize"`
+	}
+	if err := json.Unmarshal(b, &v2); err != nil {
+		return Weight{}, err
+	}
+
+	w := NewWeight(NewUCompactFromUInt(0), NewUCompactFromUInt(0))
+	switch {
+	case v2.RefTime != nil:
+		w.RefTime = *v2.RefTime
+	case v2.RefTimeCamel != nil:
+		w.RefTime = *v2.RefTimeCamel
+	}
+	switch {
+	case v2.ProofSize != nil:
+		w.ProofSize = *v2.ProofSize
+	case v2.ProofSizeCamel != nil:
+		w.ProofSize = *v2.ProofSizeCamel
+	}
+
+	return w, nil
+}
+
+func dispatchClassFromJSON(s string) (DispatchClass, error) {
+	switch strings.ToLower(s) {
+	case "normal":
+		return DispatchClass{IsNormal: true}, nil
+	case "operational":
+		return DispatchClass{IsOperational: true}, nil
+	case "mandatory":
+		return DispatchClass{IsMandatory: true}, nil
+	}
+
+	return DispatchClass{}, fmt.Errorf("invalid dispatch class %q", s)
+}
@@ -0,0 +1,64 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+// ReadProof is a proof of storage entries at a block, as returned by state_getReadProof. Proof contains the encoded
+// trie nodes that are required to look up the entries from the state root of the block.
+type ReadProof struct {
+	At    Hash    `json:"at"`
+	Proof []Bytes `json:"proof"`
+}
+
+// UnmarshalJSON fills p with the JSON encoded read proof given by b
+func (p *ReadProof) UnmarshalJSON(b []byte) error {
+	var tmp struct {
+		At    Hash     `json:"at"`
+		Proof []string `json:"proof"`
+	}
+	if err := json.Unmarshal(b, &tmp); err != nil {
+		return err
+	}
+
+	p.At = tmp.At
+	p.Proof = make([]Bytes, len(tmp.Proof))
+	for i, node := range tmp.Proof {
+		b, err := codec.HexDecodeString(node)
+		if err != nil {
+			return err
+		}
+		p.Proof[i] = b
+	}
+	return nil
+}
+
+// MarshalJSON returns a JSON encoded byte array of p
+func (p ReadProof) MarshalJSON() ([]byte, error) {
+	proof := make([]string, len(p.Proof))
+	for i, node := range p.Proof {
+		proof[i] = codec.HexEncodeToString(node)
+	}
+	return json.Marshal(struct {
+		At    Hash     `json:"at"`
+		Proof []string `json:"proof"`
+	}{p.At, proof})
+}
@@ -0,0 +1,37 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadProof_UnmarshalMarshalJSON(t *testing.T) {
+	s := []byte(`{"at":"0x0102030000000000000000000000000000000000000000000000000000000000","proof":["0x42010402","0x"]}`)
+
+	var p ReadProof
+	assert.NoError(t, json.Unmarshal(s, &p))
+	assert.Equal(t, ReadProof{At: Hash{1, 2, 3}, Proof: []Bytes{{0x42, 0x01, 0x04, 0x02}, {}}}, p)
+
+	enc, err := json.Marshal(p)
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(s), string(enc))
+}
@@ -17,6 +17,7 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 
@@ -173,3 +174,8 @@ func createKey(
 func createPrefixedKey(method, prefix string) []byte {
 	return append(xxhash.New128([]byte(prefix)).Sum(nil), xxhash.New128([]byte(method)).Sum(nil)...)
 }
+
+// isKeyOfStorage returns true if the key starts with the prefix of the storage item with the given names
+func isKeyOfStorage(key StorageKey, prefix, method Text) bool {
+	return bytes.HasPrefix(key, createPrefixedKey(string(method), string(prefix)))
+}
@@ -0,0 +1,188 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+// TraceBlockResponse is the result of state_traceBlock, it is either a trace of the block execution or an error
+type TraceBlockResponse struct {
+	IsTraceError bool
+	AsTraceError TraceError
+	IsBlockTrace bool
+	AsBlockTrace BlockTrace
+}
+
+// UnmarshalJSON fills r with the JSON encoded trace response given by b
+func (r *TraceBlockResponse) UnmarshalJSON(b []byte) error {
+	var tmp struct {
+		TraceError *TraceError `json:"traceError"`
+		BlockTrace *BlockTrace `json:"blockTrace"`
+	}
+	if err := json.Unmarshal(b, &tmp); err != nil {
+		return err
+	}
+
+	switch {
+	case tmp.TraceError != nil:
+		r.IsTraceError = true
+		r.AsTraceError = *tmp.TraceError
+	case tmp.BlockTrace != nil:
+		r.IsBlockTrace = true
+		r.AsBlockTrace = *tmp.BlockTrace
+	default:
+		return fmt.Errorf("unexpected trace block response %s", b)
+	}
+	return nil
+}
+
+// MarshalJSON returns a JSON encoded byte array of r
+func (r TraceBlockResponse) MarshalJSON() ([]byte, error) {
+	if r.IsTraceError {
+		return json.Marshal(struct {
+			TraceError TraceError `json:"traceError"`
+		}{r.AsTraceError})
+	}
+	return json.Marshal(struct {
+		BlockTrace BlockTrace `json:"blockTrace"`
+	}{r.AsBlockTrace})
+}
+
+// TraceError is returned instead of a block trace if the node failed to trace the block
+type TraceError struct {
+	Error string `json:"error"`
+}
+
+// BlockTrace holds the spans and events that have been recorded while executing a block. The tracing targets,
+// storage keys and methods are the filters the trace was requested with.
+type BlockTrace struct {
+	BlockHash      string       `json:"blockHash"`
+	ParentHash     string       `json:"parentHash"`
+	TracingTargets string       `json:"tracingTargets"`
+	StorageKeys    string       `json:"storageKeys"`
+	Methods        string       `json:"methods"`
+	Spans          []TraceSpan  `json:"spans"`
+	Events         []TraceEvent `json:"events"`
+}
+
+// TraceSpan is a period of time during the block execution, e.g. the execution of a runtime function
+type TraceSpan struct {
+	ID       uint64  `json:"id"`
+	ParentID *uint64 `json:"parentId"`
+	Name     string  `json:"name"`
+	Target   string  `json:"target"`
+	Wasm     bool    `json:"wasm"`
+}
+
+// TraceEvent is an event that has been recorded within a span. Storage accesses are recorded as events with the
+// target "state", see StorageAccess.
+type TraceEvent struct {
+	Target   string         `json:"target"`
+	Data     TraceEventData `json:"data"`
+	ParentID *uint64        `json:"parentId"`
+}
+
+// TraceEventData holds the fields of a trace event, formatted as strings
+type TraceEventData struct {
+	StringValues map[string]string `json:"stringValues"`
+}
+
+// TraceStorageAccess is a storage read or write that has been recorded in a block trace
+type TraceStorageAccess struct {
+	// Method is the kind of access, e.g. Get, Put, Exists or ClearPrefix
+	Method string
+	Key    StorageKey
+	// HasValue is true if a value has been read or written, Value is only set then
+	HasValue bool
+	Value    StorageDataRaw
+	// ParentID is the ID of the span that accessed the storage
+	ParentID *uint64
+}
+
+// StorageAccess returns the storage access that has been recorded by the event. Ok is false if the event is not a
+// storage access.
+func (e TraceEvent) StorageAccess() (access TraceStorageAccess, ok bool, err error) {
+	key, hasKey := e.Data.StringValues["key"]
+	if e.Target != "state" || !hasKey {
+		return TraceStorageAccess{}, false, nil
+	}
+
+	access.Method = e.Data.StringValues["method"]
+	access.ParentID = e.ParentID
+
+	access.Key, err = codec.HexDecodeString(key)
+	if err != nil {
+		return TraceStorageAccess{}, false, fmt.Errorf("invalid key in storage trace event: %w", err)
+	}
+
+	// Reads record the result, writes the value
+	value, hasValue := e.Data.StringValues["result"]
+	if !hasValue {
+		value, hasValue = e.Data.StringValues["value"]
+	}
+	if hasValue {
+		access.HasValue, access.Value, err = parseTraceOption(value)
+		if err != nil {
+			return TraceStorageAccess{}, false, fmt.Errorf("invalid value in storage trace event: %w", err)
+		}
+	}
+
+	return access, true, nil
+}
+
+// StorageNames returns the pallet prefix and the name of the storage item that was accessed, see
+// Metadata.FindStorageNamesForKey
+func (a TraceStorageAccess) StorageNames(meta *Metadata) (Text, Text, error) {
+	return meta.FindStorageNamesForKey(a.Key)
+}
+
+// StorageAccesses returns the storage accesses that have been recorded in the block trace, in their order
+func (t BlockTrace) StorageAccesses() ([]TraceStorageAccess, error) {
+	var accesses []TraceStorageAccess
+	for _, e := range t.Events {
+		access, ok, err := e.StorageAccess()
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			accesses = append(accesses, access)
+		}
+	}
+	return accesses, nil
+}
+
+// parseTraceOption parses an optional value that the node formatted as Some(0a0b) or None
+func parseTraceOption(s string) (bool, StorageDataRaw, error) {
+	s = strings.TrimSpace(s)
+	if s == "None" {
+		return false, nil, nil
+	}
+	if strings.HasPrefix(s, "Some(") && strings.HasSuffix(s, ")") {
+		s = s[len("Some(") : len(s)-1]
+	}
+
+	value, err := codec.HexDecodeString(s)
+	if err != nil {
+		return false, nil, err
+	}
+	return true, value, nil
+}
@@ -0,0 +1,70 @@
+// Go Substrate RPC Client (GSRPC) provides APIs and types around Polkadot and any Substrate-based chain RPC calls
+//
+// Copyright 2019 Centrifuge GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTraceBlockResponse_UnmarshalMarshalJSON(t *testing.T) {
+	s := []byte(`{"blockTrace":{"blockHash":"0x01","parentHash":"0x00","tracingTargets":"state","storageKeys":"",` +
+		`"methods":"","spans":[{"id":1,"parentId":null,"name":"execute_block","target":"executive","wasm":true}],` +
+		`"events":[{"target":"state","data":{"stringValues":{"key":"3a636f6465","method":"Get",` +
+		`"result":"Some(0x0a0b)"}},"parentId":1}]}}`)
+
+	var res TraceBlockResponse
+	assert.NoError(t, json.Unmarshal(s, &res))
+	assert.True(t, res.IsBlockTrace)
+	assert.Equal(t, "state", res.AsBlockTrace.TracingTargets)
+	assert.Equal(t, TraceSpan{ID: 1, Name: "execute_block", Target: "executive", Wasm: true},
+		res.AsBlockTrace.Spans[0])
+
+	access, ok, err := res.AsBlockTrace.Events[0].StorageAccess()
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, StorageKey(":code"), access.Key)
+	assert.Equal(t, StorageDataRaw{0x0a, 0x0b}, access.Value)
+
+	enc, err := json.Marshal(res)
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(s), string(enc))
+}
+
+func TestTraceBlockResponse_UnmarshalJSON_TraceError(t *testing.T) {
+	var res TraceBlockResponse
+	assert.NoError(t, json.Unmarshal([]byte(`{"traceError":{"error":"Invalid block hash"}}`), &res))
+	assert.Equal(t, TraceBlockResponse{IsTraceError: true, AsTraceError: TraceError{Error: "Invalid block hash"}}, res)
+
+	assert.Error(t, json.Unmarshal([]byte(`{}`), &res))
+}
+
+func TestTraceEvent_StorageAccess(t *testing.T) {
+	_, ok, err := TraceEvent{Target: "runtime", Data: TraceEventData{StringValues: map[string]string{
+		"key": "00",
+	}}}.StorageAccess()
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	_, _, err = TraceEvent{Target: "state", Data: TraceEventData{StringValues: map[string]string{
+		"key": "00", "result": "Some(xyz)",
+	}}}.StorageAccess()
+	assert.Error(t, err)
+}